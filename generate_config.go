@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+)
+
+// generateConfigCommand is the subcommand which writes the configuration of existing VPC resources instead of serving
+// the provider
+const generateConfigCommand = "generate-config"
+
+// generateConfig runs the generate-config subcommand with its arguments. The credentials, visibility and endpoints
+// are taken from the environment variables the provider reads them from.
+func generateConfig(args []string) error {
+	flags := flag.NewFlagSet(generateConfigCommand, flag.ContinueOnError)
+	region := flags.String("region", conns.EnvFallBack([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south"), "the region to walk the VPC resources of")
+	resourceGroup := flags.String("resource-group", conns.EnvFallBack([]string{"IC_RESOURCE_GROUP", "IBMCLOUD_RESOURCE_GROUP"}, ""), "the ID of the resource group to limit the resources to, all the resource groups are walked when empty")
+	out := flags.String("out", "", "the file to write the configuration to, it is written to the standard output when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	config := conns.Config{
+		BluemixAPIKey:  conns.EnvFallBack([]string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY"}, ""),
+		IAMToken:       conns.EnvFallBack([]string{"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN"}, ""),
+		Region:         *region,
+		ResourceGroup:  *resourceGroup,
+		BluemixTimeout: 60 * time.Second,
+		RetryCount:     10,
+		RetryDelay:     conns.RetryAPIDelay,
+		Visibility:     conns.EnvFallBack([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
+	}
+	session, err := config.ClientSession()
+	if err != nil {
+		return err
+	}
+	sess, err := session.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	options := vpc.GenerateConfigOptions{
+		Region:          *region,
+		ResourceGroupID: *resourceGroup,
+	}
+	if err := vpc.GenerateConfig(context.Background(), sess, options, w); err != nil {
+		return fmt.Errorf("[ERROR] Error generating the configuration: %s", err)
+	}
+	return nil
+}
@@ -134,6 +134,7 @@ var Pi_spp_placement_group_id string
 var PiStoragePool string
 var PiStorageType string
 var Pi_shared_processor_pool_id string
+var Pi_workspace_datacenter string
 
 var Pi_capture_storage_image_path string
 var Pi_capture_cloud_storage_access_key string
@@ -157,6 +158,10 @@ var Tg_cross_network_id string
 // Enterprise Management
 var Account_to_be_imported string
 
+// Context Based Restrictions
+var CbrAtRegion string
+var CbrAtServiceKey string
+
 // Secuity and Complinace Center, Governance
 var Scc_gov_account_id string
 var Scc_resource_group_id string
@@ -787,6 +792,11 @@ func init() {
 		Pi_shared_processor_pool_id = "tf-pi-shared-processor-pool"
 		fmt.Println("[WARN] Set the environment variable PI_SHARED_PROCESSOR_POOL_ID for testing ibm_pi_shared_processor_pool resource else it is set to default value 'tf-pi-shared-processor-pool'")
 	}
+	Pi_workspace_datacenter = os.Getenv("PI_WORKSPACE_DATACENTER")
+	if Pi_workspace_datacenter == "" {
+		Pi_workspace_datacenter = "dal12"
+		fmt.Println("[INFO] Set the environment variable PI_WORKSPACE_DATACENTER for testing ibm_pi_workspace resource else it is set to default value 'dal12'")
+	}
 
 	WorkspaceID = os.Getenv("SCHEMATICS_WORKSPACE_ID")
 	if WorkspaceID == "" {
@@ -893,6 +903,14 @@ func init() {
 	if Account_to_be_imported == "" {
 		fmt.Println("[INFO] Set the environment variable ACCOUNT_TO_BE_IMPORTED for testing import enterprise account resource else  tests will fail if this is not set correctly")
 	}
+	CbrAtRegion = os.Getenv("IBM_CBR_AT_REGION")
+	if CbrAtRegion == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CBR_AT_REGION for testing ibm_cbr_rule_report datasource else tests will fail if this is not set correctly")
+	}
+	CbrAtServiceKey = os.Getenv("IBM_CBR_AT_SERVICE_KEY")
+	if CbrAtServiceKey == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CBR_AT_SERVICE_KEY for testing ibm_cbr_rule_report datasource else tests will fail if this is not set correctly")
+	}
 	HpcsAdmin1 = os.Getenv("IBM_HPCS_ADMIN1")
 	if HpcsAdmin1 == "" {
 		fmt.Println("[WARN] Set the environment variable IBM_HPCS_ADMIN1 with a VALID HPCS Admin Key1 Path")
@@ -1308,3 +1326,15 @@ func TestAccPreCheckEncryptedImage(t *testing.T) {
 		t.Fatal("IS_IMAGE_ENCRYPTION_KEY must be set for acceptance tests")
 	}
 }
+
+func TestAccPreCheckCbrRuleReport(t *testing.T) {
+	if v := os.Getenv("IC_API_KEY"); v == "" {
+		t.Fatal("IC_API_KEY must be set for acceptance tests")
+	}
+	if CbrAtRegion == "" {
+		t.Fatal("IBM_CBR_AT_REGION must be set for acceptance tests")
+	}
+	if CbrAtServiceKey == "" {
+		t.Fatal("IBM_CBR_AT_SERVICE_KEY must be set for acceptance tests")
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	jwt "github.com/golang-jwt/jwt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FakeEndpointsRegion is the region the endpoints file of FakeEndpoints is written for
+const FakeEndpointsRegion = "us-south"
+
+// OfflineTestsEnv enables the offline tests of the resources which poll their status with the delays of the live
+// resource, which take tens of seconds each
+const OfflineTestsEnv = "IBMCLOUD_OFFLINE_TESTS"
+
+// fakeEndpointPaths maps the keys of the endpoints file which point at the fake endpoints to the base path of the
+// service API, the base path of the live endpoint is kept so that recorded fixtures replay unchanged
+var fakeEndpointPaths = map[string]string{
+	"IBMCLOUD_IAM_API_ENDPOINT":                 "",
+	"IBMCLOUD_UAA_ENDPOINT":                     "",
+	"IBMCLOUD_IS_NG_API_ENDPOINT":               "/v1",
+	"IBMCLOUD_GT_API_ENDPOINT":                  "",
+	"IBMCLOUD_GS_API_ENDPOINT":                  "",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT": "",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT": "",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":    "",
+	"IBMCLOUD_ICD_API_ENDPOINT":                 "",
+	"IBMCLOUD_DATABASES_API_ENDPOINT":           "/v5/ibm",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT":              "/v1",
+}
+
+// FakeEndpoints is an httptest server standing in for the IBM Cloud service endpoints in offline tests. It issues IAM
+// tokens for any API key and replays the interactions of a fixtures file, see conns.Recorder, for the other requests.
+// Fixtures are recorded by running the acceptance test of the resource with IBMCLOUD_HTTP_FIXTURES_FILE set and
+// IBMCLOUD_HTTP_FIXTURES_MODE=record.
+type FakeEndpoints struct {
+	Server        *httptest.Server
+	Recorder      *conns.Recorder
+	EndpointsFile string
+}
+
+// NewFakeEndpoints starts the fake endpoints for the fixtures file and writes an endpoints file which points the
+// service clients at them. The server is closed when the test completes.
+func NewFakeEndpoints(t *testing.T, fixturesFile string) *FakeEndpoints {
+	recorder, err := conns.NewRecorder(fixturesFile, conns.RecorderModeReplay, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	f := &FakeEndpoints{
+		Recorder: recorder,
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		f.serveHTTP(t, w, req)
+	}))
+	t.Cleanup(f.Server.Close)
+
+	endpoints := make(map[string]interface{}, len(fakeEndpointPaths))
+	for key, path := range fakeEndpointPaths {
+		endpoints[key] = map[string]interface{}{
+			"public": map[string]interface{}{
+				FakeEndpointsRegion: f.Server.URL + path,
+			},
+		}
+	}
+	data, err := json.Marshal(endpoints)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	f.EndpointsFile = filepath.Join(t.TempDir(), "endpoints.json")
+	if err = ioutil.WriteFile(f.EndpointsFile, data, 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return f
+}
+
+// ClientSession returns the client session of a provider configured with the fake endpoints, to pass as meta to the
+// CRUD functions of a resource. The environment variables which would override the endpoints file are unset for the
+// duration of the test.
+func (f *FakeEndpoints) ClientSession(t *testing.T) interface{} {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", f.EndpointsFile)
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	t.Setenv(conns.HTTPFixturesFileEnv, "")
+	for key := range fakeEndpointPaths {
+		t.Setenv(key, "")
+	}
+
+	config := conns.Config{
+		BluemixAPIKey:  "fake-api-key",
+		Region:         FakeEndpointsRegion,
+		Visibility:     "public",
+		EndpointsFile:  f.EndpointsFile,
+		Generation:     2,
+		RetryCount:     0,
+		RetryDelay:     10 * time.Millisecond,
+		BluemixTimeout: 30 * time.Second,
+	}
+	meta, err := config.ClientSession()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return meta
+}
+
+func (f *FakeEndpoints) serveHTTP(t *testing.T, w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodPost && (strings.HasSuffix(req.URL.Path, "/identity/token") || strings.HasSuffix(req.URL.Path, "/oauth/token")) {
+		writeFakeToken(t, w)
+		return
+	}
+
+	req.RequestURI = ""
+	resp, err := f.Recorder.RoundTrip(req)
+	if err != nil {
+		t.Logf("[WARN] fake endpoints: %s", err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"not_found","message":"no interaction recorded for the request"}],"status_code":404}`))
+		return
+	}
+	defer resp.Body.Close()
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.Header().Del("Content-Length")
+	w.WriteHeader(resp.StatusCode)
+	body, _ := ioutil.ReadAll(resp.Body)
+	_, _ = w.Write(body)
+}
+
+// writeFakeToken writes an IAM token response with an unsigned access token holding the claims the provider reads
+func writeFakeToken(t *testing.T, w http.ResponseWriter) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"id":      "IBMid-fake",
+		"iam_id":  "IBMid-fake",
+		"sub":     "fake@ibm.com",
+		"email":   "fake@ibm.com",
+		"account": map[string]interface{}{"bss": "fakeaccount"},
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"iat":     now.Unix(),
+		"exp":     now.Add(time.Hour).Unix(),
+	}
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("fake"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": "fake-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    now.Add(time.Hour).Unix(),
+	})
+}
+
+// OfflineCRUDTest is a create and delete test of a resource against the FakeEndpoints of a fixtures file
+type OfflineCRUDTest struct {
+	Resource *schema.Resource
+	// Fixtures is the fixtures file the FakeEndpoints replay
+	Fixtures string
+	// Attributes are set on the resource data before the create
+	Attributes map[string]interface{}
+	// ID and Expected are the ID and the state attributes the create must result in
+	ID       string
+	Expected map[string]string
+	// Polls is set when the resource waits for its status with the delays of the live resource, the test then only
+	// runs with IBMCLOUD_OFFLINE_TESTS set
+	Polls bool
+	// Setup runs once the FakeEndpoints are started, for the settings which depend on their URL
+	Setup func(t *testing.T, endpoints *FakeEndpoints)
+	// Check runs after the create for the checks Expected does not cover
+	Check func(t *testing.T, d *schema.ResourceData)
+}
+
+// RunOfflineCRUDTest creates the resource of the test, checks its state and deletes it. A request the fixtures do
+// not expect fails the test.
+func RunOfflineCRUDTest(t *testing.T, test OfflineCRUDTest) {
+	if test.Polls && os.Getenv(OfflineTestsEnv) == "" {
+		t.Skipf("set %s to run the offline CRUD test, the resource polls its status like the live resource", OfflineTestsEnv)
+	}
+	endpoints := NewFakeEndpoints(t, test.Fixtures)
+	if test.Setup != nil {
+		test.Setup(t, endpoints)
+	}
+	meta := endpoints.ClientSession(t)
+
+	r := test.Resource
+	d := r.TestResourceData()
+	for key, value := range test.Attributes {
+		if err := d.Set(key, value); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	var diags diag.Diagnostics
+	if r.CreateContext != nil {
+		diags = r.CreateContext(context.Background(), d, meta)
+	} else {
+		diags = diag.FromErr(r.Create(d, meta))
+	}
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if d.Id() != test.ID {
+		t.Fatalf("bad ID: %s", d.Id())
+	}
+	state := d.State()
+	for key, value := range test.Expected {
+		if state.Attributes[key] != value {
+			t.Fatalf("bad %s: %q, expected %q", key, state.Attributes[key], value)
+		}
+	}
+	if test.Check != nil {
+		test.Check(t, d)
+	}
+
+	if r.DeleteContext != nil {
+		diags = r.DeleteContext(context.Background(), d, meta)
+	} else {
+		diags = diag.FromErr(r.Delete(d, meta))
+	}
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+}
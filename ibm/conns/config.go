@@ -5,14 +5,13 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net"
 	gohttp "net/http"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -192,6 +191,16 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Tags applied to every taggable resource
+	DefaultTags []string
+	// Tag keys and key prefixes ignored when reading and updating resource tags
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+
+	// HTTPTransport sends the requests of the service clients, it defaults to the fixture Recorder configured with
+	// IBMCLOUD_HTTP_FIXTURES_FILE, or to the transport of each client when no fixtures file is set
+	HTTPTransport gohttp.RoundTripper
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -229,6 +238,7 @@ type ClientSession interface {
 	ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error)
 	SoftLayerSession() *slsession.Session
 	IBMPISession() (*ibmpisession.IBMPISession, error)
+	IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error)
 	UserManagementAPI() (usermanagementv2.UserManagementAPI, error)
 	PushServiceV1() (*pushservicev1.PushServiceV1, error)
 	EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error)
@@ -237,6 +247,8 @@ type ClientSession interface {
 	KeyProtectAPI() (*kp.Client, error)
 	KeyManagementAPI() (*kp.Client, error)
 	VpcV1API() (*vpc.VpcV1, error)
+	VpcV1APIForRegion(region string) (*vpc.VpcV1, error)
+	ResolveEndpoint(service, region string) (string, error)
 	APIGateway() (*apigateway.ApiGatewayControllerApiV1, error)
 	PrivateDNSClientSession() (*dns.DnsSvcsV1, error)
 	CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error)
@@ -295,11 +307,14 @@ type ClientSession interface {
 	PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error)
 	CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error)
 	CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error)
+	TagsConfig() *TagsConfig
 }
 
 type clientSession struct {
 	session *Session
 
+	tagsConfig *TagsConfig
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -374,6 +389,7 @@ type clientSession struct {
 
 	ibmpiConfigErr error
 	ibmpiSession   *ibmpisession.IBMPISession
+	ibmpiOptions   *ibmpisession.IBMPIOptions
 
 	kpErr error
 	kpAPI *kp.API
@@ -404,6 +420,9 @@ type clientSession struct {
 	vpcErr error
 	vpcAPI *vpc.VpcV1
 
+	// endpoints resolves the endpoints of the clients built for another region than the one of the provider
+	endpoints *EndpointResolver
+
 	directlinkAPI *dl.DirectLinkV1
 	directlinkErr error
 	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
@@ -792,21 +811,25 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:  EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:  sess.kmsAPI.Config.BaseURL,
 				APIKey:   sess.kmsAPI.Config.APIKey, //pragma: allowlist secret
 				Verbose:  kp.VerboseFailOnly,
 				TokenURL: sess.kmsAPI.Config.TokenURL,
 			}
 		} else {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:       sess.kmsAPI.Config.BaseURL,
 				Authorization: sess.session.BluemixSession.Config.IAMAccessToken, //pragma: allowlist secret
 				Verbose:       kp.VerboseFailOnly,
 				TokenURL:      sess.kmsAPI.Config.TokenURL,
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		transport := DefaultTransport()
+		if httpClient := sess.session.BluemixSession.Config.HTTPClient; httpClient != nil {
+			transport = httpClient.Transport
+		}
+		kpClient, err := kp.New(*clientConfig, transport)
 		if err != nil {
 			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
@@ -819,6 +842,51 @@ func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
 	return sess.vpcAPI, sess.vpcErr
 }
 
+// VpcV1APIForRegion returns a VPC client for the region, built from the credentials, endpoint settings and HTTP
+// configuration of the client returned by VpcV1API. The client of VpcV1API is returned for the region of the provider.
+func (sess clientSession) VpcV1APIForRegion(region string) (*vpc.VpcV1, error) {
+	if sess.vpcErr != nil {
+		return nil, sess.vpcErr
+	}
+	if region == "" || sess.endpoints == nil || region == sess.endpoints.Region {
+		return sess.vpcAPI, nil
+	}
+	// The endpoint set in the environment is the one of the region of the provider, the endpoints file has an endpoint
+	// per region
+	if key := serviceEndpoints["vpc"].Key; os.Getenv(key) != "" {
+		return nil, fmt.Errorf("[ERROR] The VPC endpoint set in %s is used for region %s, set the endpoint of region %s in the endpoints file instead", key, sess.endpoints.Region, region)
+	}
+	vpcurl, err := sess.ResolveEndpoint("vpc", region)
+	if err != nil {
+		return nil, err
+	}
+	vpcclient, err := vpc.NewVpcV1(&vpc.VpcV1Options{
+		URL:           vpcurl,
+		Authenticator: sess.vpcAPI.Service.Options.Authenticator,
+		Version:       sess.vpcAPI.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while configuring vpc service for region %s: %q", region, err)
+	}
+	// The HTTP client is shared so that the retries and the transport of the provider apply to the regional client
+	vpcclient.Service.Client = sess.vpcAPI.Service.Client
+	vpcclient.SetDefaultHeaders(sess.vpcAPI.Service.DefaultHeaders)
+	return vpcclient, nil
+}
+
+// ResolveEndpoint returns the endpoint of the service in the region with the endpoint settings of the provider, the
+// region of the provider is used if region is empty. The services are the ones of the EndpointResolver.
+func (sess clientSession) ResolveEndpoint(service, region string) (string, error) {
+	if sess.endpoints == nil {
+		return "", errEmptyBluemixCredentials
+	}
+	resolver := *sess.endpoints
+	if region != "" {
+		resolver.Region = region
+	}
+	return resolver.Resolve(service)
+}
+
 func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
 	return sess.directlinkAPI, sess.directlinkErr
 }
@@ -839,6 +907,48 @@ func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
+// IBMPISessionForZone returns a Power Systems session for the zone, built from the credentials and endpoint settings
+// of the provider. It does not need the zone of the provider to be set, the session of IBMPISession is returned for
+// the zone of the provider.
+func (sess clientSession) IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error) {
+	if sess.ibmpiOptions == nil {
+		return nil, sess.ibmpiConfigErr
+	}
+	if sess.ibmpiSession != nil && zone == sess.ibmpiOptions.Zone {
+		return sess.ibmpiSession, nil
+	}
+	options := *sess.ibmpiOptions
+	options.Zone = zone
+	options.Region = ibmpiRegionFromZone(zone)
+	if sess.endpoints != nil {
+		resolver := *sess.endpoints
+		resolver.Region = options.Region
+		piURL, err := resolver.Resolve("power")
+		if err != nil {
+			return nil, err
+		}
+		options.URL = piURL
+	}
+	piSession, err := ibmpisession.NewIBMPISession(&options)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while configuring ibmpisession for zone %s: %q", zone, err)
+	}
+	return piSession, nil
+}
+
+var (
+	ibmpiZoneNumberRegexp = regexp.MustCompile("[0-9]+$")
+	ibmpiZoneSuffixRegexp = regexp.MustCompile("-[0-9]+$")
+)
+
+// ibmpiRegionFromZone returns the Power Systems region of a datacenter (dal12) or availability zone (eu-de-1)
+func ibmpiRegionFromZone(zone string) string {
+	if strings.Contains(zone, "-") {
+		return ibmpiZoneSuffixRegexp.ReplaceAllString(zone, "")
+	}
+	return ibmpiZoneNumberRegexp.ReplaceAllString(zone, "")
+}
+
 // Private DNS Service
 
 func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
@@ -1172,8 +1282,22 @@ func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonP
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
+// TagsConfig provides the provider level default_tags and ignore_tags settings
+func (session clientSession) TagsConfig() *TagsConfig {
+	return session.tagsConfig
+}
+
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
+	if c.HTTPTransport == nil {
+		recorder, err := RecorderFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		if recorder != nil {
+			c.HTTPTransport = recorder
+		}
+	}
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
@@ -1181,6 +1305,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
 		session: sess,
+		tagsConfig: &TagsConfig{
+			DefaultTags:       c.DefaultTags,
+			IgnoreKeys:        c.IgnoreTagKeys,
+			IgnoreKeyPrefixes: c.IgnoreTagKeyPrefixes,
+		},
 	}
 
 	if sess.BluemixSession == nil {
@@ -1329,25 +1458,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
+	endpoints, err := NewEndpointResolver(c.Region, c.Visibility, EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile))
+	if err != nil {
+		return nil, err
+	}
+	if unsupported := endpoints.UnsupportedServices(); len(unsupported) > 0 {
+		log.Printf("[WARN] The following services do not support private endpoints in region %s, their resources cannot be managed with the private visibility: %s", c.Region, strings.Join(unsupported, ", "))
+	}
+	sess.BluemixSession.Config.EndpointLocator = endpointLocator{
+		EndpointLocator: sess.BluemixSession.Config.EndpointLocator,
+		resolver:        endpoints,
+	}
+
 	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
 
 	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
-		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
-		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
-		}
-	}
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
@@ -1384,80 +1509,68 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.hpcsEndpointAPI = hpcsAPI
 
-	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
-	var options kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
-		}
-
-	} else {
-		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
-		}
-	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpurl, err := endpoints.Resolve("kms")
 	if err != nil {
-		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
+		session.kpErr = err
+		session.kmsErr = err
+	} else {
+		var options kp.ClientConfig
+		if c.BluemixAPIKey != "" {
+			options = kp.ClientConfig{
+				BaseURL: kpurl,
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
 		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+			options = kp.ClientConfig{
+				BaseURL:       kpurl,
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
+		}
+		kpAPIclient, err := kp.New(options, c.transport())
+		if err != nil {
+			session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
+		session.kpAPI = kpAPIclient
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+
+	iamURL, err := endpoints.Resolve("iam")
+	if err != nil {
+		return nil, err
 	}
 
 	// KEY MANAGEMENT Service
-	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
-	var kmsOptions kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
-		}
+	if session.kmsErr == nil {
+		kmsurl := kpurl
+		var kmsOptions kp.ClientConfig
+		if c.BluemixAPIKey != "" {
+			kmsOptions = kp.ClientConfig{
+				BaseURL: kmsurl,
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: iamURL + "/identity/token",
+			}
 
-	} else {
-		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		} else {
+			kmsOptions = kp.ClientConfig{
+				BaseURL:       kmsurl,
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: iamURL + "/identity/token",
+			}
 		}
+		kmsAPIclient, err := kp.New(kmsOptions, c.transport())
+		if err != nil {
+			session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
+		}
+		session.kmsAPI = kmsAPIclient
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
 
 	var authenticator core.Authenticator
 
@@ -1465,7 +1578,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		if c.BluemixAPIKey != "" {
 			authenticator = &core.IamAuthenticator{
 				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				URL:    iamURL,
+				Client: c.httpClient(),
 			}
 		} else {
 			// Construct the IamAuthenticator with the IAM refresh token.
@@ -1473,7 +1587,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
 				ClientId:     "bx",
 				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				URL:          iamURL,
+				Client:       c.httpClient(),
 			}
 		}
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
@@ -1486,377 +1601,324 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 
-	// Construct an "options" struct for creating the service client.
-	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ukoClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+	// The endpoint of the UKO instance is looked up by the resources unless it is overridden
+	ukoURL, err := endpoints.Resolve("hpcs_uko")
+	if err != nil {
+		session.ukoClientErr = err
 	} else {
-		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
+		// Construct an "options" struct for creating the service client.
+		ukoClientOptions := &ukov4.UkoV4Options{
+			Authenticator: authenticator,
+			URL:           ukoURL,
+		}
+
+		// Construct the service client.
+		session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService(session.ukoClient.Service)
+			// Add custom header for analytics
+			session.ukoClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
+		}
 	}
 
 	// APPID Service
-	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
-	if c.Visibility == "private" {
-		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
-	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
-	}
-	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
+	appIDEndpoint, err := endpoints.Resolve("appid")
 	if err != nil {
-		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
-	}
-	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		appIDClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.appidErr = err
+	} else {
+		appIDClientOptions := &appid.AppIDManagementV4Options{
+			Authenticator: authenticator,
+			URL:           appIDEndpoint,
+		}
+		appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
+		if err != nil {
+			session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
+		}
+		if appIDClient != nil && appIDClient.Service != nil {
+			c.configureService(appIDClient.Service)
+			appIDClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.appidAPI = appIDClient
 	}
-	session.appidAPI = appIDClient
 
 	// Construct an "options" struct for creating Context Based Restrictions service client.
-	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("Context Based Restrictions Service API does not support private endpoints") //return this error if private endpoints are not supported
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
-	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
-	}
-
-	// Construct the service client.
-	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
-	if err == nil && session.contextBasedRestrictionsClient != nil {
-		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+	cbrURL, err := endpoints.Resolve("context_based_restrictions")
+	if err != nil {
+		session.contextBasedRestrictionsClientErr = err
 	} else {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
+		contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			Authenticator: authenticator,
+			URL:           cbrURL,
+		}
+
+		// Construct the service client.
+		session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
+		if err == nil && session.contextBasedRestrictionsClient != nil {
+			// Enable retries for API calls
+			c.configureService(session.contextBasedRestrictionsClient.Service)
+			// Add custom header for analytics
+			session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
+		}
 	}
 
 	// CATALOG MANAGEMENT Service
-	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
-	if c.Visibility == "private" {
-		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
-	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+	catalogManagementURL, err := endpoints.Resolve("catalog_management")
 	if err != nil {
-		session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
-	}
-	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// ATRACKER Service
-	var atrackerClientURL string
-	var atrackerURLErr error
-
-	atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion(c.Region)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion(c.Region)
+		session.catalogManagementClientErr = err
+	} else {
+		catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
+			URL:           catalogManagementURL,
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+		if err != nil {
+			session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
+		}
+		if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.catalogManagementClient.Service)
+			// Add custom header for analytics
+			session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		}
 	}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientURL)
-	}
-	atrackerClientOptions := &atrackerv1.AtrackerV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url
-	if atrackerURLErr != nil && len(atrackerClientOptions.URL) == 0 {
-		session.atrackerClientErr = atrackerURLErr
-	}
-	// Construct the service client.
-	session.atrackerClient, err = atrackerv1.NewAtrackerV1(atrackerClientOptions)
+	// ATRACKER Service
+	atrackerClientURL, err := endpoints.Resolve("atracker")
 	if err != nil {
-		session.atrackerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Activity Tracker API service: %q", err)
-	}
-	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
-		// Enable retries for API calls
-		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// Version 2 Atracker
-	var atrackerClientV2URL string
-	var atrackerURLV2Err error
-
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
-		}
+		session.atrackerClientErr = err
 	} else {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
-	}
-	if atrackerURLV2Err != nil {
-		atrackerClientV2URL = atrackerv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
-	}
-	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
-	// This should technically never happen as we default this for v2
-	if atrackerURLV2Err != nil && len(atrackerClientOptions.URL) == 0 {
-		session.atrackerClientErr = atrackerURLErr
+		atrackerClientOptions := &atrackerv1.AtrackerV1Options{
+			Authenticator: authenticator,
+			URL:           atrackerClientURL,
+		}
+		// Construct the service client.
+		session.atrackerClient, err = atrackerv1.NewAtrackerV1(atrackerClientOptions)
+		if err != nil {
+			session.atrackerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Activity Tracker API service: %q", err)
+		}
+		if session.atrackerClient != nil && session.atrackerClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.atrackerClient.Service)
+			// Add custom header for analytics
+			session.atrackerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
-	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
-	if err == nil {
-		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+	// Version 2 Atracker
+	atrackerClientV2URL, err := endpoints.Resolve("atracker_v2")
+	if err != nil {
+		session.atrackerClientV2Err = err
 	} else {
-		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
+		atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
+			Authenticator: authenticator,
+			URL:           atrackerClientV2URL,
+		}
+		session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService(session.atrackerClientV2.Service)
+			// Add custom header for analytics
+			session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
+		}
 	}
 
 	// SCC ADMIN Service
-	var adminServiceApiClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion(c.Region)
-	}
+	adminServiceApiClientURL, err := endpoints.Resolve("scc_admin")
 	if err != nil {
-		adminServiceApiClientURL = adminserviceapiv1.DefaultServiceURL
-	}
-	adminServiceApiClientOptions := &adminserviceapiv1.AdminServiceApiV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_ADMIN_API_ENDPOINT"}, adminServiceApiClientURL),
-	}
-
-	// Construct the service client.
-	session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.adminServiceApiClientErr = err
 	} else {
-		session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
+		adminServiceApiClientOptions := &adminserviceapiv1.AdminServiceApiV1Options{
+			Authenticator: authenticator,
+			URL:           adminServiceApiClientURL,
+		}
+
+		// Construct the service client.
+		session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService(session.adminServiceApiClient.Service)
+			// Add custom header for analytics
+			session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
+		}
 	}
 
 	// SCHEMATICS Service
-	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
-	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
-	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
-	}
-	// Construct the service client.
-	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
+	schematicsEndpoint, err := endpoints.Resolve("schematics")
 	if err != nil {
-		session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
-	}
-	// Enable retries for API calls
-	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		schematicsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.schematicsClientErr = err
+	} else {
+		schematicsClientOptions := &schematicsv1.SchematicsV1Options{
+			Authenticator: authenticator,
+			URL:           schematicsEndpoint,
+		}
+		// Construct the service client.
+		schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
+		if err != nil {
+			session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
+		}
+		// Enable retries for API calls
+		if schematicsClient != nil && schematicsClient.Service != nil {
+			c.configureService(schematicsClient.Service)
+			schematicsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.schematicsClient = schematicsClient
 	}
-	session.schematicsClient = schematicsClient
 
 	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
+	vpcurl, err := endpoints.Resolve("vpc")
 	if err != nil {
-		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
-	}
-	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.vpcErr = err
+	} else {
+		vpcoptions := &vpc.VpcV1Options{
+			URL:           vpcurl,
+			Authenticator: authenticator,
+		}
+		vpcclient, err := vpc.NewVpcV1(vpcoptions)
+		if err != nil {
+			session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
+		}
+		if vpcclient != nil && vpcclient.Service != nil {
+			c.configureService(vpcclient.Service)
+			vpcclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcAPI = vpcclient
 	}
-	session.vpcAPI = vpcclient
+	session.endpoints = endpoints
 
 	// PUSH NOTIFICATIONS Service
-	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
-	if c.Visibility == "private" {
-		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
-	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
-	}
-	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
+	pnurl, err := endpoints.Resolve("push_notifications")
 	if err != nil {
-		session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
-	}
-	if pnclient != nil && pnclient.Service != nil {
-		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		pnclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.pushServiceClientErr = err
+	} else {
+		pushNotificationOptions := &pushservicev1.PushServiceV1Options{
+			URL:           pnurl,
+			Authenticator: authenticator,
+		}
+		pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
+		if err != nil {
+			session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
+		}
+		if pnclient != nil && pnclient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(pnclient.Service)
+			pnclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.pushServiceClient = pnclient
 	}
-	session.pushServiceClient = pnclient
 	// event notifications
-	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
-	if c.Visibility == "private" {
-		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
-	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
-	}
-	// Construct the service client.
-	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
+	enurl, err := endpoints.Resolve("event_notifications")
 	if err != nil {
-		// Enable {
-		session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
-	}
-	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
-		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.eventNotificationsApiClientErr = err
+	} else {
+		enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
+			Authenticator: authenticator,
+			URL:           enurl,
+		}
+		// Construct the service client.
+		session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
+		if err != nil {
+			// Enable {
+			session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
+		}
+		if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.eventNotificationsApiClient.Service)
+			session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// APP CONFIGURATION Service
-	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
-	}
-	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
-		Authenticator: authenticator,
-	}
-
-	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
-	if appConfigClient != nil {
-		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.appConfigurationClient = appConfigClient
+	appconfigurl, err := endpoints.Resolve("app_configuration")
+	if err != nil {
+		session.appConfigurationClientErr = err
 	} else {
-		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
+		appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
+			URL:           appconfigurl,
+			Authenticator: authenticator,
+		}
+
+		appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
+		if appConfigClient != nil {
+			// Enable retries for API calls
+			c.configureService(appConfigClient.Service)
+			session.appConfigurationClient = appConfigClient
+		} else {
+			session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
+		}
 	}
 
 	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
-	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
+	containerRegistryClientURL, err := endpoints.Resolve("container_registry")
 	if err != nil {
-		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerRegistryClientURL, err = GetPrivateServiceURLForRegion(c.Region)
+		session.containerRegistryClientErr = err
+	} else {
+		containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
+			Authenticator: authenticator,
+			URL:           containerRegistryClientURL,
+			Account:       core.StringPtr(userConfig.UserAccount),
+		}
+		// Construct the service client.
+		session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
 		if err != nil {
-			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
+			session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
+		}
+		if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.containerRegistryClient.Service)
+			// Add custom header for analytics
+			session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
-	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
-	}
-	// Construct the service client.
-	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
-	if err != nil {
-		session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
-	}
-	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
-		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
 	}
 
 	// OBJECT STORAGE Service
-	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+	cosconfigurl, err := endpoints.Resolve("cos_config")
 	if err != nil {
-		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
+		session.cosConfigErr = err
+	} else {
+		cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
+			Authenticator: authenticator,
+			URL:           cosconfigurl,
+		}
+		cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+		if err != nil {
+			session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
+		}
+		session.cosConfigAPI = cosconfigclient
 	}
-	session.cosConfigAPI = cosconfigclient
 
 	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
 	if err != nil {
@@ -1871,56 +1933,46 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.globalTaggingServiceAPI = globalTaggingAPI
 
 	// GLOBAL TAGGING Service
-	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		var globalTaggingRegion string
-		if c.Region != "us-south" && c.Region != "us-east" {
-			globalTaggingRegion = "us-south"
-		} else {
-			globalTaggingRegion = c.Region
-		}
-		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
-	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
-	}
-	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
+	globalTaggingEndpoint, err := endpoints.Resolve("global_tagging")
 	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
-		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// GLOBAL TAGGING Service
-	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		globalSearchEndpoint = ContructEndpoint("api.private", fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
-	}
-	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
-		Authenticator: authenticator,
+		session.globalTaggingConfigErrV1 = err
+	} else {
+		globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
+			URL:           globalTaggingEndpoint,
+			Authenticator: authenticator,
+		}
+		globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
+		if err != nil {
+			session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
+			session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+			c.configureService(session.globalTaggingServiceAPIV1.Service)
+			session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
-	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
+	// GLOBAL SEARCH Service
+	globalSearchEndpoint, err := endpoints.Resolve("global_search")
 	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
-		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.globalSearchConfigErrV2 = err
+	} else {
+		globalSearchV2Options := &searchv2.GlobalSearchV2Options{
+			URL:           globalSearchEndpoint,
+			Authenticator: authenticator,
+		}
+		globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
+		if err != nil {
+			session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
+			session.globalSearchServiceAPIV2 = *globalSearchAPIV2
+			c.configureService(session.globalSearchServiceAPIV2.Service)
+			session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	icdAPI, err := icdv4.New(sess.BluemixSession)
@@ -1929,31 +1981,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.icdServiceAPI = icdAPI
 
-	var cloudDatabasesEndpoint string
-
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
+	cloudDatabasesEndpoint, err := endpoints.Resolve("databases")
+	if err != nil {
+		session.cloudDatabasesClientErr = err
 	} else {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
-	}
-
-	// Construct an "options" struct for creating the service client.
-	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
-	}
+		// Construct an "options" struct for creating the service client.
+		cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           cloudDatabasesEndpoint,
+			Authenticator: authenticator,
+		}
 
-	// Construct the service client.
-	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
+		// Construct the service client.
+		session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService(session.cloudDatabasesClient.Service)
+			// Add custom header for analytics
+			session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
+		}
 	}
 
 	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
@@ -1999,169 +2048,133 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.functionIAMNamespaceAPI = namespaceFunction
 
 	//  API GATEWAY service
-	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
-	}
-	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
-		Authenticator: &core.NoAuthAuthenticator{},
-	}
-	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
+	apicurl, err := endpoints.Resolve("api_gateway")
 	if err != nil {
-		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
+		session.apigatewayErr = err
+	} else {
+		APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
+			URL:           apicurl,
+			Authenticator: &core.NoAuthAuthenticator{},
+		}
+		apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
+		if err != nil {
+			session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
+		}
+		session.apigatewayAPI = apigatewayAPI
 	}
-	session.apigatewayAPI = apigatewayAPI
 
 	// POWER SYSTEMS Service
-	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+	piURL, err := endpoints.Resolve("power")
+	if err != nil {
+		session.ibmpiConfigErr = err
+	}
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
 		Debug:         os.Getenv("TF_LOG") != "",
 		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
+		URL:           piURL,
 		UserAccount:   userConfig.UserAccount,
 		Zone:          c.Zone,
 	}
-	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
-	if err != nil {
-		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+	if session.ibmpiConfigErr == nil {
+		ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
+		if err != nil {
+			session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+		}
+		session.ibmpiSession = ibmpisession
 	}
-	session.ibmpiSession = ibmpisession
+	session.ibmpiOptions = ibmPIOptions
 
 	// PRIVATE DNS Service
-	pdnsURL := dns.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
-	}
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+	pdnsURL, err := endpoints.Resolve("private_dns")
+	if err != nil {
+		session.pDNSErr = err
+	} else {
+		dnsOptions := &dns.DnsSvcsV1Options{
+			URL:           pdnsURL,
+			Authenticator: authenticator,
+		}
+		session.pDNSClient, err = dns.NewDnsSvcsV1(dnsOptions)
+		if err != nil {
+			session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", err)
+		}
+		if session.pDNSClient != nil && session.pDNSClient.Service != nil {
+			c.configureService(session.pDNSClient.Service)
+			session.pDNSClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// DIRECT LINK Service
 	ver := time.Now().Format("2006-01-02")
-	dlURL := dl.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+	dlURL, err := endpoints.Resolve("direct_link")
+	if err != nil {
+		session.directlinkErr = err
+	} else {
+		directlinkOptions := &dl.DirectLinkV1Options{
+			URL:           dlURL,
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.directlinkAPI, err = dl.NewDirectLinkV1(directlinkOptions)
+		if err != nil {
+			session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", err)
+		}
+		if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
+			c.configureService(session.directlinkAPI.Service)
+			session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// DIRECT LINK PROVIDER Service
-	dlproviderURL := dlProviderV2.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+	dlproviderURL, err := endpoints.Resolve("direct_link_provider")
+	if err != nil {
+		session.dlProviderErr = err
+	} else {
+		directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
+			URL:           dlproviderURL,
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.dlProviderAPI, err = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
+		if err != nil {
+			session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", err)
+		}
+		if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
+			c.configureService(session.dlProviderAPI.Service)
+			session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// TRANSIT GATEWAY Service
-	tgURL := tg.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
-		Version:       CreateVersionDate(),
-	}
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
-	}
-	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
-		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		// })
+	tgURL, err := endpoints.Resolve("transit_gateway")
+	if err != nil {
+		session.transitgatewayErr = err
+	} else {
+		transitgatewayOptions := &tg.TransitGatewayApisV1Options{
+			URL:           tgURL,
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		session.transitgatewayAPI, err = tg.NewTransitGatewayApisV1(transitgatewayOptions)
+		if err != nil {
+			session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", err)
+		}
+		if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
+			c.configureService(session.transitgatewayAPI.Service)
+			// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
+			// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			// })
+		}
 	}
 
 	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+	cisEndPoint, cisURLErr := endpoints.Resolve("cis")
 
 	// IBM Network CIS Zones service
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
@@ -2176,7 +2189,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2194,7 +2207,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2214,7 +2227,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2234,7 +2247,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2254,7 +2267,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2274,7 +2287,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2291,7 +2304,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2311,7 +2324,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2329,7 +2342,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2349,7 +2362,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2370,7 +2383,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2391,7 +2404,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2412,7 +2425,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2433,7 +2446,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2454,7 +2467,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2475,7 +2488,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2496,7 +2509,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2518,7 +2531,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2539,7 +2552,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2560,7 +2573,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2581,7 +2594,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2602,7 +2615,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2623,7 +2636,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2644,7 +2657,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2663,7 +2676,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisMtlsClient.Service)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2682,7 +2695,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2699,7 +2712,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2717,7 +2730,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2739,35 +2752,56 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(session.cisOriginAuthClient.Service)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 
-	// IAM IDENTITY Service
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdenityURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
+	// The CIS clients are built with the public endpoint when CIS has no endpoint for the visibility
+	if cisURLErr != nil {
+		session.cisZonesErr = cisURLErr
+		session.cisAlertsErr = cisURLErr
+		session.cisOriginAuthPullErr = cisURLErr
+		session.cisDNSErr = cisURLErr
+		session.cisDNSBulkErr = cisURLErr
+		session.cisGLBPoolErr = cisURLErr
+		session.cisGLBErr = cisURLErr
+		session.cisGLBHealthCheckErr = cisURLErr
+		session.cisIPErr = cisURLErr
+		session.cisRLErr = cisURLErr
+		session.cisPageRuleErr = cisURLErr
+		session.cisEdgeFunctionErr = cisURLErr
+		session.cisSSLErr = cisURLErr
+		session.cisWAFPackageErr = cisURLErr
+		session.cisDomainSettingsErr = cisURLErr
+		session.cisRoutingErr = cisURLErr
+		session.cisWAFGroupErr = cisURLErr
+		session.cisCacheErr = cisURLErr
+		session.cisCustomPageErr = cisURLErr
+		session.cisAccessRuleErr = cisURLErr
+		session.cisUARuleErr = cisURLErr
+		session.cisLockdownErr = cisURLErr
+		session.cisLogpushJobsErr = cisURLErr
+		session.cisRangeAppErr = cisURLErr
+		session.cisWAFRuleErr = cisURLErr
+		session.cisMtlsErr = cisURLErr
+		session.cisWebhooksErr = cisURLErr
+		session.cisFiltersErr = cisURLErr
+		session.cisFirewallRulesErr = cisURLErr
 	}
+
+	// IAM IDENTITY Service
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		URL:           iamURL,
 	}
 	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
 	if err != nil {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2775,27 +2809,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.iamIdentityAPI = iamIdentityClient
 
 	// IAM POLICY MANAGEMENT Service
-	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		URL:           iamURL,
 	}
 	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
 	if err != nil {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2803,27 +2826,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
 
 	// IAM ACCESS GROUP
-	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		URL:           iamURL,
 	}
 	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
 	if err != nil {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.configureService(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2831,378 +2843,305 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
 
 	// RESOURCE MANAGEMENT Service
-	rmURL := resourcemanager.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
+	rmURL, err := endpoints.Resolve("resource_manager")
+	if err != nil {
+		session.resourceManagerErr = err
+	} else {
+		resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
+			Authenticator: authenticator,
+			URL:           rmURL,
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			rmURL = resourcemanager.DefaultServiceURL
+		resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
+		if err != nil {
+			session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 		}
+		if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+			c.configureService(resourceManagerClient.Service)
+			resourceManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceManagerAPI = resourceManagerClient
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
-	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
-	}
-	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
-	if err != nil {
-		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
-	}
-	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceManagerAPI = resourceManagerClient
 
 	//CLOUD SHELL Service
-	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
-	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
-	}
-	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
+	cloudShellUrl, err := endpoints.Resolve("cloud_shell")
 	if err != nil {
-		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
-	}
-	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.ibmCloudShellClientErr = err
+	} else {
+		ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
+			Authenticator: authenticator,
+			URL:           cloudShellUrl,
+		}
+		session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
+		if err != nil {
+			session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
+		}
+		if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
+			c.configureService(session.ibmCloudShellClient.Service)
+			session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// ENTERPRISE Service
-	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+	enterpriseURL, err := endpoints.Resolve("enterprise")
+	if err != nil {
+		session.enterpriseManagementClientErr = err
+	} else {
+		enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
+			Authenticator: authenticator,
+			URL:           enterpriseURL,
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
-				fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
+		enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
+		if err != nil {
+			session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 		}
+		if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+			c.configureService(enterpriseManagementClient.Service)
+			enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.enterpriseManagementClient = enterpriseManagementClient
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
-	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
-	}
-	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
-	if err != nil {
-		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
-	}
-	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.enterpriseManagementClient = enterpriseManagementClient
 
 	// RESOURCE CONTROLLER Service
-	rcURL := resourcecontroller.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+	rcURL, err := endpoints.Resolve("resource_controller")
+	if err != nil {
+		session.resourceControllerErr = err
+	} else {
+		resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
+			Authenticator: authenticator,
+			URL:           rcURL,
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
-		} else {
-			rcURL = resourcecontroller.DefaultServiceURL
+		resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
+		if err != nil {
+			session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 		}
+		if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+			c.configureService(resourceControllerClient.Service)
+			resourceControllerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceControllerAPI = resourceControllerClient
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
-	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
-	}
-	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
-	if err != nil {
-		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceControllerAPI = resourceControllerClient
 
 	// SECRETS MANAGER Service
-	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
-		Authenticator: authenticator,
-	}
-	/// Construct the service client.
-	session.secretsManagerClient, err = secretsmanagerv1.NewSecretsManagerV1(secretsManagerClientOptions)
+	// The endpoint of the instance is set by the resources unless it is overridden
+	secretsManagerURL, err := endpoints.Resolve("secrets_manager")
 	if err != nil {
-		session.secretsManagerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Secrets Manager API service: %q", err)
-	}
-	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
-		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.secretsManagerClientErr = err
+	} else {
+		secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
+			Authenticator: authenticator,
+			URL:           secretsManagerURL,
+		}
+		/// Construct the service client.
+		session.secretsManagerClient, err = secretsmanagerv1.NewSecretsManagerV1(secretsManagerClientOptions)
+		if err != nil {
+			session.secretsManagerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Secrets Manager API service: %q", err)
+		}
+		if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.secretsManagerClient.Service)
+			// Add custom header for analytics
+			session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// SATELLITE Service
-	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
-	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
+	containerEndpoint, err := endpoints.Resolve("satellite")
 	if err != nil {
-		session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
-	}
+		session.satelliteClientErr = err
+	} else {
+		kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           containerEndpoint,
+			Authenticator: authenticator,
+		}
+		session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
+		if err != nil {
+			session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
+		}
 
-	// Enable retries for API calls
-	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		// Enable retries for API calls
+		if session.satelliteClient != nil && session.satelliteClient.Service != nil {
+			c.configureService(session.satelliteClient.Service)
+			session.satelliteClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
-	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
-	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
+	satelliteLinkEndpoint, err := endpoints.Resolve("satellite_link")
 	if err != nil {
-		session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
-	}
-	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
-		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.satelliteLinkClientErr = err
+	} else {
+		satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
+			URL:           satelliteLinkEndpoint,
+			Authenticator: authenticator,
+		}
+		session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
+		if err != nil {
+			session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
+		}
+		if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.satelliteLinkClient.Service)
+			// Add custom header for analytics
+			session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
-	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
-	}
-	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
+	// The endpoint of the instance is set by the resources unless it is overridden
+	esSchemaRegistryURL, err := endpoints.Resolve("event_streams")
 	if err != nil {
-		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
-	}
-	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.esSchemaRegistryErr = err
+	} else {
+		esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
+			Authenticator: authenticator,
+			URL:           esSchemaRegistryURL,
+		}
+		session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
+		if err != nil {
+			session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
+		}
+		if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
+			c.configureService(session.esSchemaRegistryClient.Service)
+			session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// Governance Service
-	var configServiceApiClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
-	}
+	configServiceApiClientURL, err := endpoints.Resolve("configuration_governance")
 	if err != nil {
-		configServiceApiClientURL = configurationgovernancev1.DefaultServiceURL
-	}
-	configServiceApiClientOptions := &configurationgovernancev1.ConfigurationGovernanceV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT"}, configServiceApiClientURL),
-	}
-	session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.configServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.configServiceApiClientErr = err
 	} else {
-		session.configServiceApiClientErr = fmt.Errorf("Error occurred while configuring Config Service API service: %q", err)
+		configServiceApiClientOptions := &configurationgovernancev1.ConfigurationGovernanceV1Options{
+			Authenticator: authenticator,
+			URL:           configServiceApiClientURL,
+		}
+		session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService(session.configServiceApiClient.Service)
+			// Add custom header for analytics
+			session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.configServiceApiClientErr = fmt.Errorf("Error occurred while configuring Config Service API service: %q", err)
+		}
 	}
 
 	//COMPLIANCE Service
 	// Construct an "options" struct for creating the service client.
-	var postureManagementClientURL string
-	if c.Visibility == "public" || c.Visibility == "public-and-private" {
-		postureManagementClientURL, err = posturemanagementv1.GetServiceURLForRegion(c.Region)
-	} else {
-		session.postureManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Security Insights Findings API service: `%v` visibility not supported", c.Visibility)
-	}
+	postureManagementClientURL, err := endpoints.Resolve("posture_management")
 	if err != nil {
-		postureManagementClientURL = posturemanagementv1.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURL)
-	}
-	postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
-	}
+		session.postureManagementClientErr = err
+	} else {
+		postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
+			Authenticator: authenticator,
+			URL:           postureManagementClientURL,
+			AccountID:     core.StringPtr(userConfig.UserAccount),
+		}
 
-	// Construct the service client.
-	session.postureManagementClient, err = posturemanagementv1.NewPostureManagementV1(postureManagementClientOptions)
-	if err != nil {
-		session.postureManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management service: %q", err)
-	}
-	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		// Construct the service client.
+		session.postureManagementClient, err = posturemanagementv1.NewPostureManagementV1(postureManagementClientOptions)
+		if err != nil {
+			session.postureManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management service: %q", err)
+		}
+		if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.postureManagementClient.Service)
+			// Add custom header for analytics
+			session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	//COMPLIANCE Service v2 version
 	// Construct an "options" struct for creating the service client.
-	var postureManagementClientURLv2 string
-	if c.Visibility == "public" || c.Visibility == "public-and-private" {
-		postureManagementClientURLv2, err = posturemanagementv2.GetServiceURLForRegion(c.Region)
-	} else {
-		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Compliance Centre API service: `%v` visibility not supported", c.Visibility)
-	}
+	postureManagementClientURLv2, err := endpoints.Resolve("posture_management_v2")
 	if err != nil {
-		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Posture Management API service:  `%s` region not supported", c.Region)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURLv2 = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURLv2)
-	}
-	postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
-	}
+		session.postureManagementClientErrv2 = err
+	} else {
+		postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
+			Authenticator: authenticator,
+			URL:           postureManagementClientURLv2,
+		}
 
-	// Construct the service client.
-	session.postureManagementClientv2, err = posturemanagementv2.NewPostureManagementV2(postureManagementClientOptionsv2)
-	if err != nil {
-		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management v2 service: %q", err)
-	}
-	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
-		// Enable retries for API calls
-		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		// Construct the service client.
+		session.postureManagementClientv2, err = posturemanagementv2.NewPostureManagementV2(postureManagementClientOptionsv2)
+		if err != nil {
+			session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management v2 service: %q", err)
+		}
+		if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
+			// Enable retries for API calls
+			c.configureService(session.postureManagementClientv2.Service)
+			// Add custom header for analytics
+			session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 	}
 
 	// Construct an "options" struct for creating the service client.
-	var cdToolchainClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-	}
+	cdToolchainClientURL, err := endpoints.Resolve("toolchain")
 	if err != nil {
-		cdToolchainClientURL = cdtoolchainv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	}
-	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
-	}
-
-	// Construct the service client.
-	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.cdToolchainClientErr = err
 	} else {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
+		cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
+			Authenticator: authenticator,
+			URL:           cdToolchainClientURL,
+		}
 
-	// Construct an "options" struct for creating the tekton pipeline service client.
-	var cdTektonPipelineClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+		// Construct the service client.
+		session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService(session.cdToolchainClient.Service)
+			// Add custom header for analytics
+			session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 		}
-	} else {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
 	}
+
+	// Construct an "options" struct for creating the tekton pipeline service client.
+	cdTektonPipelineClientURL, err := endpoints.Resolve("tekton_pipeline")
 	if err != nil {
-		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	}
-	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
-	}
-	// Construct the service client.
-	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		session.cdTektonPipelineClientErr = err
 	} else {
-		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
+		cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
+			Authenticator: authenticator,
+			URL:           cdTektonPipelineClientURL,
+		}
+		// Construct the service client.
+		session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService(session.cdTektonPipelineClient.Service)
+			// Add custom header for analytics
+			session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
+		}
 	}
 
 	if os.Getenv("TF_LOG") != "" {
@@ -3245,6 +3184,7 @@ func newSession(c *Config) (*Session, error) {
 		softlayerSession.APIKey = c.SoftLayerAPIKey
 		softlayerSession.UserName = c.SoftLayerUserName
 	}
+	softlayerSession.HTTPClient = c.httpClient()
 	softlayerSession.AppendUserAgent(fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
 	ibmSession.SoftLayerSession = softlayerSession
 
@@ -3271,6 +3211,7 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    c.httpClient(),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3294,6 +3235,7 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    c.httpClient(),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3312,6 +3254,7 @@ func authenticateAPIKey(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3326,6 +3269,7 @@ func authenticateCF(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3384,6 +3328,7 @@ func RefreshToken(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3411,6 +3356,35 @@ func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defau
 	return defaultValue
 }
 
+// transport returns the transport of the clients which are built with one, such as the Key Protect clients
+func (c *Config) transport() gohttp.RoundTripper {
+	if c.HTTPTransport != nil {
+		return c.HTTPTransport
+	}
+	return DefaultTransport()
+}
+
+// httpClient returns the HTTP client of the clients built by ClientSession, or nil to leave the default client of
+// each SDK in place when no HTTPTransport is configured
+func (c *Config) httpClient() *gohttp.Client {
+	if c.HTTPTransport == nil {
+		return nil
+	}
+	return &gohttp.Client{
+		Transport: c.HTTPTransport,
+		Timeout:   c.BluemixTimeout,
+	}
+}
+
+// configureService enables the retries of a client built with the IBM Go SDK core and makes it send its requests
+// with the HTTPTransport of the configuration
+func (c *Config) configureService(service *core.BaseService) {
+	service.EnableRetries(c.RetryCount, c.RetryDelay)
+	if client := c.httpClient(); client != nil {
+		service.SetHTTPClient(client)
+	}
+}
+
 // DefaultTransport ...
 func DefaultTransport() gohttp.RoundTripper {
 	transport := &gohttp.Transport{
@@ -16,12 +16,12 @@ import (
 	"github.com/apache/openwhisk-client-go/whisk"
 )
 
-// DefaultServiceURL is the default URL to make service requests to.
-const DefaultServiceURL = "https://us-south.functions.cloud.ibm.com"
-
 // FunctionClient ...
 func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
-	baseEndpoint := getBaseURL(c.Region)
+	baseEndpoint, err := c.EndpointLocator.FunctionsEndpoint()
+	if err != nil {
+		return nil, err
+	}
 	u, err := url.Parse(fmt.Sprintf("%s/api", baseEndpoint))
 	if err != nil {
 		return nil, err
@@ -35,16 +35,6 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 	return functionsClient, err
 }
 
-// getBaseURL ..
-func getBaseURL(region string) string {
-	baseEndpoint := fmt.Sprintf(DefaultServiceURL)
-	if region != "us-south" {
-		baseEndpoint = fmt.Sprintf("https://%s.functions.cloud.ibm.com", region)
-	}
-
-	return baseEndpoint
-}
-
 /*
  *
  * Configure a HTTP client using the OpenWhisk properties (i.e. host, auth, iamtoken)
@@ -53,11 +43,10 @@ func getBaseURL(region string) string {
  *
  */
 func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, functionNamespace functions.FunctionServiceAPI) (*whisk.Client, error) {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.functions.cloud.ibm.com/api", sess.Config.Region))
-	wskClient, _ := whisk.NewClient(http.DefaultClient, &whisk.Config{
-		Host:    u.Host,
-		Version: "v1",
-	})
+	wskClient, err := FunctionClient(sess.Config)
+	if err != nil {
+		return nil, err
+	}
 
 	nsList, err := functionNamespace.Namespaces().GetNamespaces()
 	if err != nil {
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestIBMPISessionForZone(t *testing.T) {
+	clearEndpointOverrides(t)
+	t.Setenv("IBMCLOUD_POWER_API_ENDPOINT", "")
+
+	options := &ibmpisession.IBMPIOptions{
+		Authenticator: &core.NoAuthAuthenticator{},
+		Region:        "us-south",
+		URL:           "https://us-south.power-iaas.cloud.ibm.com",
+		UserAccount:   "1234",
+		Zone:          "dal12",
+	}
+	providerSession, err := ibmpisession.NewIBMPISession(options)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	sess := clientSession{
+		ibmpiSession: providerSession,
+		ibmpiOptions: options,
+		endpoints:    &EndpointResolver{Region: "us-south", Visibility: "public"},
+	}
+
+	piSession, err := sess.IBMPISessionForZone("dal12")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if piSession != providerSession {
+		t.Errorf("expected the session of the provider for the zone of the provider")
+	}
+
+	cases := []struct {
+		zone      string
+		crnFormat string
+	}{
+		{"lon04", "crn:v1:bluemix:public:power-iaas:lon04:a/1234:%s::"},
+		{"eu-de-1", "crn:v1:bluemix:public:power-iaas:eu-de-1:a/1234:%s::"},
+	}
+	for _, tc := range cases {
+		piSession, err := sess.IBMPISessionForZone(tc.zone)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.zone, err)
+		}
+		if piSession.CRNFormat != tc.crnFormat {
+			t.Errorf("%s: got CRN format %q, expected %q", tc.zone, piSession.CRNFormat, tc.crnFormat)
+		}
+	}
+
+	if region := ibmpiRegionFromZone("lon04"); region != "lon" {
+		t.Errorf("got region %q for lon04, expected lon", region)
+	}
+	if region := ibmpiRegionFromZone("eu-de-1"); region != "eu-de" {
+		t.Errorf("got region %q for eu-de-1, expected eu-de", region)
+	}
+}
+
+func TestIBMPISessionForZoneWithoutCredentials(t *testing.T) {
+	sess := clientSession{
+		ibmpiConfigErr: errEmptyBluemixCredentials,
+	}
+	if _, err := sess.IBMPISessionForZone("dal12"); err != errEmptyBluemixCredentials {
+		t.Errorf("got %v, expected the configuration error of the provider", err)
+	}
+}
+
+func TestVpcV1APIForRegion(t *testing.T) {
+	clearEndpointOverrides(t)
+
+	vpcAPI, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           "https://us-south.iaas.cloud.ibm.com/v1",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	sess := clientSession{
+		vpcAPI:    vpcAPI,
+		endpoints: &EndpointResolver{Region: "us-south", Visibility: "public"},
+	}
+
+	if client, err := sess.VpcV1APIForRegion("us-south"); err != nil || client != vpcAPI {
+		t.Errorf("expected the client of the provider for the region of the provider, got %v", err)
+	}
+	client, err := sess.VpcV1APIForRegion("eu-de")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if url := client.Service.GetServiceURL(); url != "https://eu-de.iaas.cloud.ibm.com/v1" {
+		t.Errorf("got URL %q for eu-de", url)
+	}
+
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://vpc.example.com/v1")
+	if _, err := sess.VpcV1APIForRegion("eu-de"); err == nil || !strings.Contains(err.Error(), "IBMCLOUD_IS_NG_API_ENDPOINT") {
+		t.Errorf("expected an error for the endpoint set in the environment, got %v", err)
+	}
+	if client, err := sess.VpcV1APIForRegion("us-south"); err != nil || client != vpcAPI {
+		t.Errorf("expected the client of the provider for the region of the provider, got %v", err)
+	}
+}
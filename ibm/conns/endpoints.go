@@ -0,0 +1,539 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	bxendpoints "github.com/IBM-Cloud/bluemix-go/endpoints"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+	dlProviderV2 "github.com/IBM/networking-go-sdk/directlinkproviderv2"
+	dl "github.com/IBM/networking-go-sdk/directlinkv1"
+	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	tg "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/atrackerv1"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	ibmcloudshellv1 "github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
+	resourcecontroller "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	resourcemanager "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/scc-go-sdk/v3/adminserviceapiv1"
+	"github.com/IBM/scc-go-sdk/v3/configurationgovernancev1"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv1"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+)
+
+// endpointURLFunc returns the URL of a service endpoint in a region, or an error if the service has no such endpoint
+// in the region
+type endpointURLFunc func(region string) (string, error)
+
+// serviceEndpoint describes the endpoints of a service a client of ClientSession is built for
+type serviceEndpoint struct {
+	// Name of the service in the errors
+	Name string
+	// Key of the service in the endpoints file, the environment variable of the same name overrides the endpoint
+	Key string
+	// Public returns the public endpoint of the service
+	Public endpointURLFunc
+	// Private returns the private endpoint of the service, nil if the service has no private endpoint
+	Private endpointURLFunc
+	// PerInstance is set for the services which have an endpoint per service instance, only the endpoint set in the
+	// environment or in the endpoints file is resolved for these services, the clients use the endpoint of the instance
+	// otherwise
+	PerInstance bool
+}
+
+// serviceEndpoints are the endpoints of the clients built by ClientSession, by the name the clients resolve them with
+var serviceEndpoints = map[string]serviceEndpoint{
+	"api_gateway": {
+		Name:    "API Gateway",
+		Key:     "IBMCLOUD_API_GATEWAY_ENDPOINT",
+		Public:  regionalEndpoint("api.%s.apigw", "controller"),
+		Private: regionalEndpoint("api.private.%s.apigw", "controller"),
+	},
+	"app_configuration": {
+		Name:    "App Configuration",
+		Key:     "IBMCLOUD_APP_CONFIG_ENDPOINT",
+		Public:  regionalEndpoint("%s.apprapp", ""),
+		Private: regionalEndpoint("%s.private.apprapp", ""),
+	},
+	"appid": {
+		Name:   "App ID",
+		Key:    "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+		Public: regionalEndpoint("%s.appid", ""),
+	},
+	"atracker": {
+		Name:    "Activity Tracker",
+		Key:     "IBMCLOUD_ATRACKER_API_ENDPOINT",
+		Public:  atrackerv1.GetServiceURLForRegion,
+		Private: privateServiceURLForRegion(atrackerv1.GetServiceURLForRegion),
+	},
+	"atracker_v2": {
+		Name:    "Activity Tracker v2",
+		Key:     "IBMCLOUD_ATRACKER_API_ENDPOINT",
+		Public:  serviceURLForRegion(atrackerv2.GetServiceURLForRegion, atrackerv2.DefaultServiceURL),
+		Private: privateServiceURLForRegion(atrackerv2.GetServiceURLForRegion),
+	},
+	"catalog_management": {
+		Name:   "Catalog Management",
+		Key:    "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+		Public: globalEndpoint("https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"),
+	},
+	"cis": {
+		Name:   "CIS",
+		Key:    "IBMCLOUD_CIS_API_ENDPOINT",
+		Public: globalEndpoint(ContructEndpoint("api.cis", cloudEndpoint)),
+	},
+	"cloud_shell": {
+		Name:   "Cloud Shell",
+		Key:    "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+		Public: globalEndpoint(ibmcloudshellv1.DefaultServiceURL),
+	},
+	"configuration_governance": {
+		Name:    "Configuration Governance",
+		Key:     "IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT",
+		Public:  serviceURLForRegion(configurationgovernancev1.GetServiceURLForRegion, configurationgovernancev1.DefaultServiceURL),
+		Private: privateServiceURLForRegion(configurationgovernancev1.GetServiceURLForRegion),
+	},
+	"container_registry": {
+		Name:    "Container Registry",
+		Key:     "IBMCLOUD_CR_API_ENDPOINT",
+		Public:  serviceURLForRegion(containerregistryv1.GetServiceURLForRegion, containerregistryv1.DefaultServiceURL),
+		Private: privateContainerRegistryURL,
+	},
+	"container": {
+		Name:    "Kubernetes Service",
+		Key:     "IBMCLOUD_CS_API_ENDPOINT",
+		Public:  globalEndpoint(ContructEndpoint("containers", fmt.Sprintf("%s/global", cloudEndpoint))),
+		Private: regionalEndpoint("private.%s.containers", "global"),
+	},
+	"context_based_restrictions": {
+		Name:   "Context Based Restrictions",
+		Key:    "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+		Public: globalEndpoint(contextbasedrestrictionsv1.DefaultServiceURL),
+	},
+	"cos_config": {
+		Name:    "Cloud Object Storage configuration",
+		Key:     "IBMCLOUD_COS_CONFIG_ENDPOINT",
+		Public:  globalEndpoint("https://config.cloud-object-storage.cloud.ibm.com/v1"),
+		Private: globalEndpoint("https://config.private.cloud-object-storage.cloud.ibm.com/v1"),
+	},
+	"databases": {
+		Name:    "Cloud Databases",
+		Key:     "IBMCLOUD_DATABASES_API_ENDPOINT",
+		Public:  regionalEndpoint("api.%s.databases", "v5/ibm"),
+		Private: regionalEndpoint("api.%s.private.databases", "v5/ibm"),
+	},
+	"direct_link": {
+		Name:    "Direct Link",
+		Key:     "IBMCLOUD_DL_API_ENDPOINT",
+		Public:  globalEndpoint(dl.DefaultServiceURL),
+		Private: globalEndpoint(ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))),
+	},
+	"direct_link_provider": {
+		Name:    "Direct Link Provider",
+		Key:     "IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+		Public:  globalEndpoint(dlProviderV2.DefaultServiceURL),
+		Private: globalEndpoint(ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))),
+	},
+	"enterprise": {
+		Name:    "Enterprise Management",
+		Key:     "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+		Public:  globalEndpoint(enterprisemanagementv1.DefaultServiceURL),
+		Private: privateRegionalEndpoint("private.%s.enterprise", "v1", "us-south", "us-south", "us-east", "eu-fr"),
+	},
+	"event_notifications": {
+		Name:   "Event Notifications",
+		Key:    "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+		Public: regionalEndpoint("%s.event-notifications", "event-notifications"),
+	},
+	"event_streams": {
+		Name:        "Event Streams",
+		Key:         "IBMCLOUD_EVENT_STREAMS_API_ENDPOINT",
+		PerInstance: true,
+	},
+	"functions": {
+		Name:   "Cloud Functions",
+		Key:    "IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+		Public: regionalEndpoint("%s.functions", ""),
+	},
+	"global_search": {
+		Name:    "Global Search",
+		Key:     "IBMCLOUD_GS_API_ENDPOINT",
+		Public:  globalEndpoint(ContructEndpoint("api", fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))),
+		Private: globalEndpoint(ContructEndpoint("api.private", fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))),
+	},
+	"global_tagging": {
+		Name:    "Global Tagging",
+		Key:     "IBMCLOUD_GT_API_ENDPOINT",
+		Public:  globalEndpoint(ContructEndpoint("tags", fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))),
+		Private: privateRegionalEndpoint("tags.private.%s.global-search-tagging", "", "us-south", "us-south", "us-east"),
+	},
+	"hpcs": {
+		Name:   "Hyper Protect Crypto Services",
+		Key:    "IBMCLOUD_HPCS_API_ENDPOINT",
+		Public: regionalEndpoint("%s.broker.hs-crypto", "crypto_v2/"),
+	},
+	"hpcs_uko": {
+		Name:        "Hyper Protect Crypto Services UKO",
+		Key:         "IBMCLOUD_HPCS_UKO_URL",
+		PerInstance: true,
+	},
+	"iam": {
+		Name:    "IAM",
+		Key:     "IBMCLOUD_IAM_API_ENDPOINT",
+		Public:  globalEndpoint(iamidentity.DefaultServiceURL),
+		Private: privateIAMURL,
+	},
+	"icd": {
+		Name:    "Cloud Databases v4",
+		Key:     "IBMCLOUD_ICD_API_ENDPOINT",
+		Public:  regionalEndpoint("api.%s.databases", ""),
+		Private: regionalEndpoint("api.%s.private.databases", ""),
+	},
+	"kms": {
+		Name:    "Key Protect",
+		Key:     "IBMCLOUD_KP_API_ENDPOINT",
+		Public:  regionalEndpoint("%s.kms", ""),
+		Private: regionalEndpoint("private.%s.kms", ""),
+	},
+	"logging": {
+		Name:    "Log Analysis and Activity Tracker export",
+		Key:     "IBMCLOUD_LOGGING_API_ENDPOINT",
+		Public:  regionalEndpoint("api.%s.logging", ""),
+		Private: regionalEndpoint("api.private.%s.logging", ""),
+	},
+	"posture_management": {
+		Name:   "Posture Management",
+		Key:    "IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+		Public: serviceURLForRegion(posturemanagementv1.GetServiceURLForRegion, posturemanagementv1.DefaultServiceURL),
+	},
+	"posture_management_v2": {
+		Name:   "Posture Management v2",
+		Key:    "IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+		Public: posturemanagementv2.GetServiceURLForRegion,
+	},
+	"power": {
+		Name:    "Power Systems",
+		Key:     "IBMCLOUD_PI_API_ENDPOINT",
+		Public:  regionalEndpoint("%s.power-iaas", ""),
+		Private: regionalEndpoint("private.%s.power-iaas", ""),
+	},
+	"private_dns": {
+		Name:    "Private DNS",
+		Key:     "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+		Public:  globalEndpoint(dns.DefaultServiceURL),
+		Private: globalEndpoint(ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))),
+	},
+	"push_notifications": {
+		Name:   "Push Notifications",
+		Key:    "IBMCLOUD_PUSH_API_ENDPOINT",
+		Public: regionalEndpoint("%s.imfpush", "imfpush/v1"),
+	},
+	"resource_controller": {
+		Name:    "Resource Controller",
+		Key:     "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+		Public:  globalEndpoint(resourcecontroller.DefaultServiceURL),
+		Private: privateRegionalEndpoint("private.%s.resource-controller", "", "us-south", "us-south", "us-east"),
+	},
+	"resource_manager": {
+		Name:    "Resource Manager",
+		Key:     "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+		Public:  globalEndpoint(resourcemanager.DefaultServiceURL),
+		Private: privateRegionalEndpoint("private.%s.resource-controller", "", "us-south", "us-south", "us-east"),
+	},
+	"satellite": {
+		Name:    "Satellite",
+		Key:     "IBMCLOUD_SATELLITE_API_ENDPOINT",
+		Public:  globalEndpoint(kubernetesserviceapiv1.DefaultServiceURL),
+		Private: regionalEndpoint("private.%s.containers", "global"),
+	},
+	"satellite_link": {
+		Name:    "Satellite Link",
+		Key:     "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+		Public:  globalEndpoint(satellitelinkv1.DefaultServiceURL),
+		Private: globalEndpoint(ContructEndpoint("private.api.link.satellite", cloudEndpoint)),
+	},
+	"scc_admin": {
+		Name:    "Security and Compliance Center admin",
+		Key:     "IBMCLOUD_SCC_ADMIN_API_ENDPOINT",
+		Public:  serviceURLForRegion(adminserviceapiv1.GetServiceURLForRegion, adminserviceapiv1.DefaultServiceURL),
+		Private: privateServiceURLForRegion(adminserviceapiv1.GetServiceURLForRegion),
+	},
+	"schematics": {
+		Name:    "Schematics",
+		Key:     "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+		Public:  regionalEndpoint("%s.schematics", ""),
+		Private: regionalEndpoint("private-%s.schematics", ""),
+	},
+	"secrets_manager": {
+		Name:        "Secrets Manager",
+		Key:         "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT",
+		PerInstance: true,
+	},
+	"tekton_pipeline": {
+		Name:    "Tekton Pipeline",
+		Key:     "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+		Public:  serviceURLForRegion(cdtektonpipelinev2.GetServiceURLForRegion, cdtektonpipelinev2.DefaultServiceURL),
+		Private: privateServiceURLForRegion(cdtektonpipelinev2.GetServiceURLForRegion),
+	},
+	"toolchain": {
+		Name:    "Toolchain",
+		Key:     "IBMCLOUD_TOOLCHAIN_ENDPOINT",
+		Public:  serviceURLForRegion(cdtoolchainv2.GetServiceURLForRegion, cdtoolchainv2.DefaultServiceURL),
+		Private: privateServiceURLForRegion(cdtoolchainv2.GetServiceURLForRegion),
+	},
+	"transit_gateway": {
+		Name:    "Transit Gateway",
+		Key:     "IBMCLOUD_TG_API_ENDPOINT",
+		Public:  globalEndpoint(tg.DefaultServiceURL),
+		Private: globalEndpoint(ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))),
+	},
+	"vpc": {
+		Name:    "VPC",
+		Key:     "IBMCLOUD_IS_NG_API_ENDPOINT",
+		Public:  regionalEndpoint("%s.iaas", "v1"),
+		Private: regionalEndpoint("%s.private.iaas", "v1"),
+	},
+}
+
+// globalEndpoint returns the endpoint of a service which is the same in every region
+func globalEndpoint(url string) endpointURLFunc {
+	return func(region string) (string, error) {
+		return url, nil
+	}
+}
+
+// regionalEndpoint returns the endpoint of a service under cloud.ibm.com, subdomain is formatted with the region
+func regionalEndpoint(subdomain, path string) endpointURLFunc {
+	return func(region string) (string, error) {
+		url := ContructEndpoint(fmt.Sprintf(subdomain, region), cloudEndpoint)
+		if path != "" {
+			url = fmt.Sprintf("%s/%s", url, path)
+		}
+		return url, nil
+	}
+}
+
+// privateRegionalEndpoint returns the private endpoint of a service which is only regional in some regions, the
+// endpoint of defaultRegion is used in the other regions
+func privateRegionalEndpoint(subdomain, path, defaultRegion string, regions ...string) endpointURLFunc {
+	return func(region string) (string, error) {
+		for _, r := range regions {
+			if r == region {
+				return regionalEndpoint(subdomain, path)(region)
+			}
+		}
+		return regionalEndpoint(subdomain, path)(defaultRegion)
+	}
+}
+
+// serviceURLForRegion returns the endpoint of the region from the SDK of a service, or defaultURL if the SDK does not
+// know the region
+func serviceURLForRegion(getServiceURLForRegion endpointURLFunc, defaultURL string) endpointURLFunc {
+	return func(region string) (string, error) {
+		if url, err := getServiceURLForRegion(region); err == nil {
+			return url, nil
+		}
+		return defaultURL, nil
+	}
+}
+
+// privateServiceURLForRegion returns the private endpoint of the region from the SDK of a service
+func privateServiceURLForRegion(getServiceURLForRegion endpointURLFunc) endpointURLFunc {
+	return func(region string) (string, error) {
+		return getServiceURLForRegion("private." + region)
+	}
+}
+
+func privateIAMURL(region string) (string, error) {
+	if region == "us-south" || region == "us-east" {
+		return ContructEndpoint(fmt.Sprintf("private.%s.iam", region), cloudEndpoint), nil
+	}
+	return ContructEndpoint("private.iam", cloudEndpoint), nil
+}
+
+func privateContainerRegistryURL(region string) (string, error) {
+	if url, err := GetPrivateServiceURLForRegion(region); err == nil {
+		return url, nil
+	}
+	return GetPrivateServiceURLForRegion("global")
+}
+
+// EndpointResolver resolves the endpoints of the clients built by ClientSession for a region and visibility. The
+// endpoint of a service is, in order of precedence, the environment variable named after the key of the service, the
+// endpoint of the service in the endpoints file and the default endpoint of the service for the visibility.
+type EndpointResolver struct {
+	Region string
+	// public, private or public-and-private, public-and-private uses the private endpoint of the services which have one
+	Visibility string
+
+	fileMap map[string]interface{}
+}
+
+// NewEndpointResolver returns the resolver of the endpoints for the region and visibility, endpointsFile is the path
+// of the endpoints file, if any
+func NewEndpointResolver(region, visibility, endpointsFile string) (*EndpointResolver, error) {
+	r := &EndpointResolver{
+		Region:     region,
+		Visibility: visibility,
+	}
+	if endpointsFile != "" {
+		fileMap, err := loadEndpointsFile(endpointsFile)
+		if err != nil {
+			return nil, err
+		}
+		r.fileMap = fileMap
+	}
+	return r, nil
+}
+
+func loadEndpointsFile(path string) (map[string]interface{}, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read the endpoints file %s: %s", path, err)
+	}
+	var fileMap map[string]interface{}
+	if err := json.Unmarshal(bytes, &fileMap); err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to unmarshal the endpoints file %s: %s", path, err)
+	}
+	for key, val := range fileMap {
+		visibilities, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s must map visibilities to regions", path, key)
+		}
+		for visibility, v := range visibilities {
+			regions, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s.%s must map regions to endpoints", path, key, visibility)
+			}
+			for region, url := range regions {
+				if _, ok := url.(string); !ok {
+					return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s.%s.%s must be a string", path, key, visibility, region)
+				}
+			}
+		}
+	}
+	return fileMap, nil
+}
+
+// Resolve returns the endpoint of the service. If the visibility is private and the service has no private endpoint
+// in the region, an error is returned along with the public endpoint so that the client can still be built, the error
+// is returned when the client is used. An empty endpoint is returned for the services which have an endpoint per
+// instance unless it is set in the environment or in the endpoints file.
+func (r *EndpointResolver) Resolve(service string) (string, error) {
+	e, ok := serviceEndpoints[service]
+	if !ok {
+		return "", fmt.Errorf("[ERROR] Unknown service %s", service)
+	}
+	if url, ok := r.override(e); ok {
+		return url, nil
+	}
+	if e.PerInstance {
+		return "", nil
+	}
+	switch r.Visibility {
+	case "private":
+		if url, ok := r.private(e); ok {
+			return url, nil
+		}
+		url, _ := e.Public(r.Region)
+		return url, r.unsupportedError(e)
+	case "public-and-private":
+		if url, ok := r.private(e); ok {
+			return url, nil
+		}
+	}
+	url, err := e.Public(r.Region)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] %s has no endpoint in region %s, set %s to the endpoint of the service: %s", e.Name, r.Region, e.Key, err)
+	}
+	return url, nil
+}
+
+// UnsupportedServices returns the names of the services which have no private endpoint in the region when the
+// visibility is private, the clients of these services return an error when they are used
+func (r *EndpointResolver) UnsupportedServices() []string {
+	if r.Visibility != "private" {
+		return nil
+	}
+	var names []string
+	for _, e := range serviceEndpoints {
+		if _, ok := r.override(e); ok || e.PerInstance {
+			continue
+		}
+		if _, ok := r.private(e); !ok {
+			names = append(names, e.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *EndpointResolver) unsupportedError(e serviceEndpoint) error {
+	return fmt.Errorf("[ERROR] %s does not support private endpoints in region %s, set %s in the endpoints file or in the environment to use it with the private visibility. The services without a private endpoint are: %s",
+		e.Name, r.Region, e.Key, strings.Join(r.UnsupportedServices(), ", "))
+}
+
+// override returns the endpoint of the service set in the environment or in the endpoints file
+func (r *EndpointResolver) override(e serviceEndpoint) (string, bool) {
+	if url := os.Getenv(e.Key); url != "" {
+		return url, true
+	}
+	if r.fileMap == nil {
+		return "", false
+	}
+	visibilities := []string{r.Visibility}
+	if r.Visibility == "public-and-private" {
+		visibilities = []string{"private", "public"}
+	}
+	for _, visibility := range visibilities {
+		if url := fileFallBack(r.fileMap, visibility, e.Key, r.Region, ""); url != "" {
+			return url, true
+		}
+	}
+	return "", false
+}
+
+func (r *EndpointResolver) private(e serviceEndpoint) (string, bool) {
+	if e.Private == nil {
+		return "", false
+	}
+	url, err := e.Private(r.Region)
+	return url, err == nil && url != ""
+}
+
+// endpointLocator resolves the endpoints of the bluemix-go clients built by ClientSession which have a service in
+// serviceEndpoints with the resolver of the provider, the other endpoints are located by the bluemix-go locator
+type endpointLocator struct {
+	bxendpoints.EndpointLocator
+	resolver *EndpointResolver
+}
+
+func (l endpointLocator) ContainerEndpoint() (string, error) {
+	return l.resolver.Resolve("container")
+}
+
+func (l endpointLocator) FunctionsEndpoint() (string, error) {
+	return l.resolver.Resolve("functions")
+}
+
+func (l endpointLocator) HpcsEndpoint() (string, error) {
+	return l.resolver.Resolve("hpcs")
+}
+
+func (l endpointLocator) ICDEndpoint() (string, error) {
+	return l.resolver.Resolve("icd")
+}
@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// clearEndpointOverrides unsets the environment variables overriding the endpoints for the duration of the test
+func clearEndpointOverrides(t *testing.T) {
+	for _, e := range serviceEndpoints {
+		t.Setenv(e.Key, "")
+	}
+}
+
+func TestEndpointResolverResolve(t *testing.T) {
+	clearEndpointOverrides(t)
+
+	cases := []struct {
+		service string
+		public  string
+		// empty when the service has no private endpoint
+		private string
+	}{
+		{"api_gateway", "https://api.us-south.apigw.cloud.ibm.com/controller", "https://api.private.us-south.apigw.cloud.ibm.com/controller"},
+		{"app_configuration", "https://us-south.apprapp.cloud.ibm.com", "https://us-south.private.apprapp.cloud.ibm.com"},
+		{"appid", "https://us-south.appid.cloud.ibm.com", ""},
+		{"atracker", "https://us-south.atracker.cloud.ibm.com", "https://private.us-south.atracker.cloud.ibm.com"},
+		{"atracker_v2", "https://us-south.atracker.cloud.ibm.com", "https://private.us-south.atracker.cloud.ibm.com"},
+		{"catalog_management", "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta", ""},
+		{"cis", "https://api.cis.cloud.ibm.com", ""},
+		{"cloud_shell", "https://api.shell.cloud.ibm.com", ""},
+		{"configuration_governance", "https://us.compliance.cloud.ibm.com", ""},
+		{"container", "https://containers.cloud.ibm.com/global", "https://private.us-south.containers.cloud.ibm.com/global"},
+		{"container_registry", "https://us.icr.io", "https://private.us.icr.io"},
+		{"context_based_restrictions", "https://cbr.cloud.ibm.com", ""},
+		{"cos_config", "https://config.cloud-object-storage.cloud.ibm.com/v1", "https://config.private.cloud-object-storage.cloud.ibm.com/v1"},
+		{"databases", "https://api.us-south.databases.cloud.ibm.com/v5/ibm", "https://api.us-south.private.databases.cloud.ibm.com/v5/ibm"},
+		{"direct_link", "https://directlink.cloud.ibm.com/v1", "https://private.directlink.cloud.ibm.com/v1"},
+		{"direct_link_provider", "https://directlink.cloud.ibm.com/provider/v2", "https://private.directlink.cloud.ibm.com/provider/v2"},
+		{"enterprise", "https://enterprise.cloud.ibm.com/v1", "https://private.us-south.enterprise.cloud.ibm.com/v1"},
+		{"event_notifications", "https://us-south.event-notifications.cloud.ibm.com/event-notifications", ""},
+		{"functions", "https://us-south.functions.cloud.ibm.com", ""},
+		{"global_search", "https://api.global-search-tagging.cloud.ibm.com", "https://api.private.global-search-tagging.cloud.ibm.com"},
+		{"global_tagging", "https://tags.global-search-tagging.cloud.ibm.com", "https://tags.private.us-south.global-search-tagging.cloud.ibm.com"},
+		{"hpcs", "https://us-south.broker.hs-crypto.cloud.ibm.com/crypto_v2/", ""},
+		{"iam", "https://iam.cloud.ibm.com", "https://private.us-south.iam.cloud.ibm.com"},
+		{"icd", "https://api.us-south.databases.cloud.ibm.com", "https://api.us-south.private.databases.cloud.ibm.com"},
+		{"kms", "https://us-south.kms.cloud.ibm.com", "https://private.us-south.kms.cloud.ibm.com"},
+		{"logging", "https://api.us-south.logging.cloud.ibm.com", "https://api.private.us-south.logging.cloud.ibm.com"},
+		{"posture_management", "https://us.compliance.cloud.ibm.com", ""},
+		{"posture_management_v2", "https://us.compliance.cloud.ibm.com", ""},
+		{"power", "https://us-south.power-iaas.cloud.ibm.com", "https://private.us-south.power-iaas.cloud.ibm.com"},
+		{"private_dns", "https://api.dns-svcs.cloud.ibm.com/v1", "https://api.private.dns-svcs.cloud.ibm.com/v1"},
+		{"push_notifications", "https://us-south.imfpush.cloud.ibm.com/imfpush/v1", ""},
+		{"resource_controller", "https://resource-controller.cloud.ibm.com", "https://private.us-south.resource-controller.cloud.ibm.com"},
+		{"resource_manager", "https://resource-controller.cloud.ibm.com", "https://private.us-south.resource-controller.cloud.ibm.com"},
+		{"satellite", "https://containers.cloud.ibm.com/global", "https://private.us-south.containers.cloud.ibm.com/global"},
+		{"satellite_link", "https://api.link.satellite.cloud.ibm.com", "https://private.api.link.satellite.cloud.ibm.com"},
+		{"scc_admin", "https://us.compliance.cloud.ibm.com", ""},
+		{"schematics", "https://us-south.schematics.cloud.ibm.com", "https://private-us-south.schematics.cloud.ibm.com"},
+		{"tekton_pipeline", "https://api.us-south.devops.cloud.ibm.com/pipeline/v2", ""},
+		{"toolchain", "https://api.us-south.devops.cloud.ibm.com/toolchain/v2", ""},
+		{"transit_gateway", "https://transit.cloud.ibm.com/v1", "https://private.transit.cloud.ibm.com/v1"},
+		{"vpc", "https://us-south.iaas.cloud.ibm.com/v1", "https://us-south.private.iaas.cloud.ibm.com/v1"},
+	}
+
+	tested := make(map[string]bool)
+	for _, tc := range cases {
+		tested[tc.service] = true
+		t.Run(tc.service, func(t *testing.T) {
+			public := &EndpointResolver{Region: "us-south", Visibility: "public"}
+			if url, err := public.Resolve(tc.service); err != nil || url != tc.public {
+				t.Fatalf("public: got %q, %v, expected %q", url, err, tc.public)
+			}
+
+			private := &EndpointResolver{Region: "us-south", Visibility: "private"}
+			url, err := private.Resolve(tc.service)
+			if tc.private == "" {
+				if err == nil || !strings.Contains(err.Error(), serviceEndpoints[tc.service].Name+" does not support private endpoints") {
+					t.Fatalf("private: expected an unsupported endpoint error, got %v", err)
+				}
+				if url != tc.public {
+					t.Fatalf("private: expected the public endpoint %q with the error, got %q", tc.public, url)
+				}
+			} else if err != nil || url != tc.private {
+				t.Fatalf("private: got %q, %v, expected %q", url, err, tc.private)
+			}
+
+			expected := tc.private
+			if expected == "" {
+				expected = tc.public
+			}
+			publicAndPrivate := &EndpointResolver{Region: "us-south", Visibility: "public-and-private"}
+			if url, err := publicAndPrivate.Resolve(tc.service); err != nil || url != expected {
+				t.Fatalf("public-and-private: got %q, %v, expected %q", url, err, expected)
+			}
+		})
+	}
+	for service, e := range serviceEndpoints {
+		if !e.PerInstance {
+			if !tested[service] {
+				t.Errorf("no test case for the endpoint of %s", service)
+			}
+			continue
+		}
+		// The clients of these services use the endpoint of the instance unless it is overridden
+		for _, visibility := range []string{"public", "private", "public-and-private"} {
+			r := &EndpointResolver{Region: "us-south", Visibility: visibility}
+			if url, err := r.Resolve(service); err != nil || url != "" {
+				t.Errorf("%s with %s visibility: got %q, %v, expected no endpoint", service, visibility, url, err)
+			}
+		}
+	}
+}
+
+func TestEndpointResolverRegions(t *testing.T) {
+	clearEndpointOverrides(t)
+
+	cases := []struct {
+		service    string
+		region     string
+		visibility string
+		expected   string
+		err        string
+	}{
+		{"iam", "eu-de", "private", "https://private.iam.cloud.ibm.com", ""},
+		{"iam", "us-east", "private", "https://private.us-east.iam.cloud.ibm.com", ""},
+		{"resource_controller", "eu-de", "private", "https://private.us-south.resource-controller.cloud.ibm.com", ""},
+		{"enterprise", "eu-fr", "private", "https://private.eu-fr.enterprise.cloud.ibm.com/v1", ""},
+		{"global_tagging", "eu-gb", "private", "https://tags.private.us-south.global-search-tagging.cloud.ibm.com", ""},
+		{"container_registry", "mars", "private", "https://private.icr.io", ""},
+		{"container_registry", "mars", "public", "https://icr.io", ""},
+		{"atracker_v2", "mars", "public", "https://us-south.atracker.cloud.ibm.com", ""},
+		{"atracker_v2", "mars", "public-and-private", "https://us-south.atracker.cloud.ibm.com", ""},
+		{"atracker_v2", "mars", "private", "https://us-south.atracker.cloud.ibm.com", "Activity Tracker v2 does not support private endpoints in region mars"},
+		{"atracker", "mars", "public", "", "Activity Tracker has no endpoint in region mars"},
+		{"posture_management_v2", "mars", "public", "", "Posture Management v2 has no endpoint in region mars"},
+		{"unknown", "us-south", "public", "", "Unknown service unknown"},
+	}
+	for _, tc := range cases {
+		r := &EndpointResolver{Region: tc.region, Visibility: tc.visibility}
+		url, err := r.Resolve(tc.service)
+		if url != tc.expected {
+			t.Errorf("%s in %s with %s visibility: got %q, expected %q", tc.service, tc.region, tc.visibility, url, tc.expected)
+		}
+		if tc.err == "" && err != nil {
+			t.Errorf("%s in %s with %s visibility: unexpected error %s", tc.service, tc.region, tc.visibility, err)
+		}
+		if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s in %s with %s visibility: expected an error containing %q, got %v", tc.service, tc.region, tc.visibility, tc.err, err)
+		}
+	}
+}
+
+func TestEndpointResolverOverrides(t *testing.T) {
+	clearEndpointOverrides(t)
+
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	err := ioutil.WriteFile(path, []byte(`{
+  "IBMCLOUD_IS_NG_API_ENDPOINT": {
+    "public": {"us-south": "https://vpc.public.example.com/v1"},
+    "private": {"us-south": "https://vpc.private.example.com/v1"}
+  },
+  "IBMCLOUD_CIS_API_ENDPOINT": {
+    "private": {"us-south": "https://cis.private.example.com"}
+  },
+  "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT": {
+    "public": {"eu-de": "https://appid.public.example.com"}
+  }
+}`), 0600)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		service    string
+		region     string
+		visibility string
+		expected   string
+	}{
+		{"vpc", "us-south", "public", "https://vpc.public.example.com/v1"},
+		{"vpc", "us-south", "private", "https://vpc.private.example.com/v1"},
+		{"vpc", "us-south", "public-and-private", "https://vpc.private.example.com/v1"},
+		{"vpc", "eu-de", "private", "https://eu-de.private.iaas.cloud.ibm.com/v1"},
+		{"cis", "us-south", "private", "https://cis.private.example.com"},
+		{"appid", "eu-de", "public-and-private", "https://appid.public.example.com"},
+	}
+	for _, tc := range cases {
+		r, err := NewEndpointResolver(tc.region, tc.visibility, path)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if url, err := r.Resolve(tc.service); err != nil || url != tc.expected {
+			t.Errorf("%s in %s with %s visibility: got %q, %v, expected %q", tc.service, tc.region, tc.visibility, url, err, tc.expected)
+		}
+	}
+
+	r, err := NewEndpointResolver("us-south", "private", path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	unsupported := r.UnsupportedServices()
+	for _, name := range unsupported {
+		if name == "CIS" || name == "VPC" {
+			t.Fatalf("%s has a private endpoint in the endpoints file, got unsupported services %v", name, unsupported)
+		}
+	}
+	_, err = r.Resolve("push_notifications")
+	if err == nil || !strings.Contains(err.Error(), strings.Join(unsupported, ", ")) {
+		t.Fatalf("expected the error to list the unsupported services, got %v", err)
+	}
+
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://vpc.env.example.com/v1")
+	t.Setenv("IBMCLOUD_PUSH_API_ENDPOINT", "https://push.env.example.com")
+	if url, err := r.Resolve("vpc"); err != nil || url != "https://vpc.env.example.com/v1" {
+		t.Fatalf("expected the environment to override the endpoints file, got %q, %v", url, err)
+	}
+	if url, err := r.Resolve("push_notifications"); err != nil || url != "https://push.env.example.com" {
+		t.Fatalf("expected the environment to override the unsupported private endpoint, got %q, %v", url, err)
+	}
+}
+
+func TestNewEndpointResolverInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	cases := map[string]string{
+		"invalid.json":    `{`,
+		"visibility.json": `{"IBMCLOUD_IS_NG_API_ENDPOINT": "https://vpc.example.com"}`,
+		"region.json":     `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": ["https://vpc.example.com"]}}`,
+		"endpoint.json":   `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": 1}}}`,
+	}
+	for name, content := range cases {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if _, err := NewEndpointResolver("us-south", "public", path); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := NewEndpointResolver("us-south", "public", filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("expected an error for a missing endpoints file")
+	}
+}
+
+func TestClientSessionEndpoints(t *testing.T) {
+	clearEndpointOverrides(t)
+	t.Setenv("IBMCLOUD_UAA_ENDPOINT", "")
+	t.Setenv(HTTPFixturesFileEnv, "")
+
+	// The IAM and UAA tokens are issued by the test server
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "token", "refresh_token": "refresh", "token_type": "Bearer", "expires_in": 3600, "expiration": %d}`, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	urls := map[string]string{}
+	fileMap := map[string]interface{}{}
+	for _, e := range serviceEndpoints {
+		url := fmt.Sprintf("https://%s.example.com", strings.ToLower(strings.ReplaceAll(e.Key, "_", "-")))
+		if e.Key == "IBMCLOUD_IAM_API_ENDPOINT" {
+			url = server.URL
+		}
+		urls[e.Key] = url
+		fileMap[e.Key] = map[string]interface{}{"public": map[string]interface{}{"us-south": url}}
+	}
+	fileMap["IBMCLOUD_UAA_ENDPOINT"] = map[string]interface{}{"public": map[string]interface{}{"us-south": server.URL}}
+	bytes, err := json.Marshal(fileMap)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := ioutil.WriteFile(path, bytes, 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	c := &Config{
+		BluemixAPIKey:  "key", //pragma: allowlist secret
+		Region:         "us-south",
+		Visibility:     "public",
+		EndpointsFile:  path,
+		BluemixTimeout: 10 * time.Second,
+		RetryDelay:     time.Millisecond,
+	}
+	s, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	sess := s.(clientSession)
+	locator := sess.session.BluemixSession.Config.EndpointLocator
+	locate := func(endpoint func() (string, error)) string {
+		url, err := endpoint()
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		return url
+	}
+
+	cases := []struct {
+		service string
+		url     string
+	}{
+		{"api_gateway", sess.apigatewayAPI.Service.GetServiceURL()},
+		{"app_configuration", sess.appConfigurationClient.Service.GetServiceURL()},
+		{"appid", sess.appidAPI.Service.GetServiceURL()},
+		{"atracker", sess.atrackerClient.Service.GetServiceURL()},
+		{"atracker_v2", sess.atrackerClientV2.Service.GetServiceURL()},
+		{"catalog_management", sess.catalogManagementClient.Service.GetServiceURL()},
+		{"cis", sess.cisZonesV1Client.Service.GetServiceURL()},
+		{"cloud_shell", sess.ibmCloudShellClient.Service.GetServiceURL()},
+		{"configuration_governance", sess.configServiceApiClient.Service.GetServiceURL()},
+		{"container", locate(locator.ContainerEndpoint)},
+		{"container_registry", sess.containerRegistryClient.Service.GetServiceURL()},
+		{"context_based_restrictions", sess.contextBasedRestrictionsClient.Service.GetServiceURL()},
+		{"cos_config", sess.cosConfigAPI.Service.GetServiceURL()},
+		{"databases", sess.cloudDatabasesClient.Service.GetServiceURL()},
+		{"direct_link", sess.directlinkAPI.Service.GetServiceURL()},
+		{"direct_link_provider", sess.dlProviderAPI.Service.GetServiceURL()},
+		{"enterprise", sess.enterpriseManagementClient.Service.GetServiceURL()},
+		{"event_notifications", sess.eventNotificationsApiClient.Service.GetServiceURL()},
+		{"event_streams", sess.esSchemaRegistryClient.Service.GetServiceURL()},
+		{"functions", locate(locator.FunctionsEndpoint)},
+		{"functions", strings.TrimSuffix(sess.functionClient.BaseURL.String(), "/api")},
+		{"global_search", sess.globalSearchServiceAPIV2.Service.GetServiceURL()},
+		{"global_tagging", sess.globalTaggingServiceAPIV1.Service.GetServiceURL()},
+		{"hpcs", locate(locator.HpcsEndpoint)},
+		{"hpcs_uko", sess.ukoClient.Service.GetServiceURL()},
+		{"iam", sess.iamIdentityAPI.Service.GetServiceURL()},
+		{"icd", locate(locator.ICDEndpoint)},
+		{"kms", sess.kpAPI.Config.BaseURL},
+		{"kms", sess.kmsAPI.Config.BaseURL},
+		{"logging", locate(func() (string, error) { return sess.ResolveEndpoint("logging", "") })},
+		{"posture_management", sess.postureManagementClient.Service.GetServiceURL()},
+		{"posture_management_v2", sess.postureManagementClientv2.Service.GetServiceURL()},
+		{"power", sess.ibmpiOptions.URL},
+		{"private_dns", sess.pDNSClient.Service.GetServiceURL()},
+		{"push_notifications", sess.pushServiceClient.Service.GetServiceURL()},
+		{"resource_controller", sess.resourceControllerAPI.Service.GetServiceURL()},
+		{"resource_manager", sess.resourceManagerAPI.Service.GetServiceURL()},
+		{"satellite", sess.satelliteClient.Service.GetServiceURL()},
+		{"satellite_link", sess.satelliteLinkClient.Service.GetServiceURL()},
+		{"scc_admin", sess.adminServiceApiClient.Service.GetServiceURL()},
+		{"schematics", sess.schematicsClient.Service.GetServiceURL()},
+		{"secrets_manager", sess.secretsManagerClient.Service.GetServiceURL()},
+		{"tekton_pipeline", sess.cdTektonPipelineClient.Service.GetServiceURL()},
+		{"toolchain", sess.cdToolchainClient.Service.GetServiceURL()},
+		{"transit_gateway", sess.transitgatewayAPI.Service.GetServiceURL()},
+		{"vpc", sess.vpcAPI.Service.GetServiceURL()},
+	}
+	tested := make(map[string]bool)
+	for _, tc := range cases {
+		tested[tc.service] = true
+		if expected := urls[serviceEndpoints[tc.service].Key]; tc.url != expected {
+			t.Errorf("%s: got %q, expected %q", tc.service, tc.url, expected)
+		}
+	}
+	for service := range serviceEndpoints {
+		if !tested[service] {
+			t.Errorf("no client tested for the endpoint of %s", service)
+		}
+	}
+}
+
+func TestClientSessionUnsupportedPrivateEndpoints(t *testing.T) {
+	clearEndpointOverrides(t)
+	t.Setenv(HTTPFixturesFileEnv, "")
+
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "token", "refresh_token": "refresh", "token_type": "Bearer", "expires_in": 3600, "expiration": %d}`, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+	t.Setenv("IBMCLOUD_UAA_ENDPOINT", server.URL)
+
+	c := &Config{
+		BluemixAPIKey:  "key", //pragma: allowlist secret
+		Region:         "us-south",
+		Visibility:     "private",
+		BluemixTimeout: 10 * time.Second,
+		RetryDelay:     time.Millisecond,
+	}
+	s, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	sess := s.(clientSession)
+
+	// The clients of the services without a private endpoint are not built, they return the error of the resolver
+	if client, err := sess.PushServiceV1(); client != nil || err == nil || !strings.Contains(err.Error(), "does not support private endpoints") {
+		t.Errorf("push_notifications: expected the resolver error, got %v", err)
+	}
+	if client, err := sess.AppIDAPI(); client != nil || err == nil || !strings.Contains(err.Error(), "does not support private endpoints") {
+		t.Errorf("appid: expected the resolver error, got %v", err)
+	}
+	if _, err := sess.VpcV1API(); err != nil {
+		t.Errorf("vpc: err: %s", err)
+	}
+}
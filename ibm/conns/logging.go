@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Names of the tflog subsystems of the service packages. The level of a subsystem is read from
+// TF_LOG_PROVIDER_IBM_<SUBSYSTEM>, e.g. TF_LOG_PROVIDER_IBM_VPC=TRACE, and defaults to TF_LOG_PROVIDER.
+const (
+	SubsystemCis            = "cis"
+	SubsystemCos            = "cos"
+	SubsystemDatabase       = "database"
+	SubsystemIam            = "iam"
+	SubsystemKms            = "kms"
+	SubsystemKubernetes     = "kubernetes"
+	SubsystemPower          = "power"
+	SubsystemSchematics     = "schematics"
+	SubsystemSecretsManager = "secretsmanager"
+	SubsystemVpc            = "vpc"
+
+	logLevelEnvVar = "TF_LOG_PROVIDER_IBM"
+)
+
+// subsystemPrefixes maps the prefix of the resource and data source names to the subsystem of their service, longer
+// prefixes must come first when they share the start of a shorter one
+var subsystemPrefixes = []struct {
+	prefix    string
+	subsystem string
+}{
+	{"ibm_is_", SubsystemVpc},
+	{"ibm_pi_", SubsystemPower},
+	{"ibm_container_", SubsystemKubernetes},
+	{"ibm_cis", SubsystemCis},
+	{"ibm_cos_", SubsystemCos},
+	{"ibm_database", SubsystemDatabase},
+	{"ibm_iam_", SubsystemIam},
+	{"ibm_kms_", SubsystemKms},
+	{"ibm_kp_", SubsystemKms},
+	{"ibm_schematics_", SubsystemSchematics},
+	{"ibm_secrets_manager_", SubsystemSecretsManager},
+}
+
+type subsystemContextKey struct{}
+
+// SubsystemForResource returns the subsystem of the service of the resource or data source, or an empty string
+// if the service has no subsystem of its own
+func SubsystemForResource(name string) string {
+	for _, p := range subsystemPrefixes {
+		if strings.HasPrefix(name, p.prefix) {
+			return p.subsystem
+		}
+	}
+	return ""
+}
+
+// WithSubsystem creates the tflog subsystem in the context and records it as the subsystem the conns logging
+// functions write to
+func WithSubsystem(ctx context.Context, subsystem string) context.Context {
+	if subsystem == "" {
+		return ctx
+	}
+	ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv(logLevelEnvVar, subsystem))
+	return context.WithValue(ctx, subsystemContextKey{}, subsystem)
+}
+
+// Subsystem returns the subsystem recorded in the context by WithSubsystem
+func Subsystem(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	subsystem, _ := ctx.Value(subsystemContextKey{}).(string)
+	return subsystem
+}
+
+// LogDebug writes msg at the debug level to the subsystem of the context. Contexts without a subsystem, such as the
+// ones of callers which have no context, fall back to the standard logger the plugin forwards to Terraform.
+func LogDebug(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
+	subsystem := Subsystem(ctx)
+	if subsystem == "" {
+		log.Printf("[DEBUG] %s%s", msg, formatLogFields(additionalFields))
+		return
+	}
+	tflog.SubsystemDebug(ctx, subsystem, msg, additionalFields...)
+}
+
+// LogTrace writes msg at the trace level to the subsystem of the context, see LogDebug
+func LogTrace(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
+	subsystem := Subsystem(ctx)
+	if subsystem == "" {
+		log.Printf("[TRACE] %s%s", msg, formatLogFields(additionalFields))
+		return
+	}
+	tflog.SubsystemTrace(ctx, subsystem, msg, additionalFields...)
+}
+
+func formatLogFields(additionalFields []map[string]interface{}) string {
+	var b strings.Builder
+	for _, fields := range additionalFields {
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, " %s=%v", k, fields[k])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestSubsystemForResource(t *testing.T) {
+	cases := map[string]string{
+		"ibm_is_vpc":                                SubsystemVpc,
+		"ibm_pi_instance":                           SubsystemPower,
+		"ibm_container_vpc_cluster":                 SubsystemKubernetes,
+		"ibm_cis_dns_record":                        SubsystemCis,
+		"ibm_database":                              SubsystemDatabase,
+		"ibm_secrets_manager_secret_group":          SubsystemSecretsManager,
+		"ibm_resource_instance":                     "",
+		"ibm_iam_access_group_policy":               SubsystemIam,
+		"ibm_kms_key":                               SubsystemKms,
+		"ibm_schematics_workspace":                  SubsystemSchematics,
+		"ibm_cos_bucket_replication_rule":           SubsystemCos,
+		"ibm_container_worker_pool_zone_attachment": SubsystemKubernetes,
+	}
+	for name, expected := range cases {
+		if actual := SubsystemForResource(name); actual != expected {
+			t.Fatalf("bad subsystem for %s: %q, expected %q", name, actual, expected)
+		}
+	}
+}
+
+func TestLogDebugSubsystem(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_IBM_VPC", "DEBUG")
+	var output bytes.Buffer
+	ctx := WithSubsystem(tflogtest.RootLogger(context.Background(), &output), SubsystemVpc)
+	if Subsystem(ctx) != SubsystemVpc {
+		t.Fatalf("bad subsystem: %q", Subsystem(ctx))
+	}
+
+	LogDebug(ctx, "Locking", map[string]interface{}{"key": "r006-vpc"})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("bad number of log entries: %d\n%s", len(entries), output.String())
+	}
+	entry := entries[0]
+	if entry["@message"] != "Locking" || entry["@module"] != "provider.vpc" || entry["key"] != "r006-vpc" {
+		t.Fatalf("bad log entry: %#v", entry)
+	}
+}
@@ -4,7 +4,7 @@
 package conns
 
 import (
-	"log"
+	"context"
 	"sync"
 )
 
@@ -32,9 +32,14 @@ type MutexKV struct {
 // Deprecated: This will be removed in v2 without replacement. If you need
 // its functionality, you can copy it or reference the v1 package.
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
+	m.LockContext(context.Background(), key)
+}
+
+// LockContext locks the mutex for the given key like Lock, logging to the subsystem of the context
+func (m *MutexKV) LockContext(ctx context.Context, key string) {
+	LogDebug(ctx, "Locking", map[string]interface{}{"key": key})
 	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	LogDebug(ctx, "Locked", map[string]interface{}{"key": key})
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
@@ -42,9 +47,14 @@ func (m *MutexKV) Lock(key string) {
 // Deprecated: This will be removed in v2 without replacement. If you need
 // its functionality, you can copy it or reference the v1 package.
 func (m *MutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
+	m.UnlockContext(context.Background(), key)
+}
+
+// UnlockContext unlocks the mutex for the given key like Unlock, logging to the subsystem of the context
+func (m *MutexKV) UnlockContext(ctx context.Context, key string) {
+	LogDebug(ctx, "Unlocking", map[string]interface{}{"key": key})
 	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
+	LogDebug(ctx, "Unlocked", map[string]interface{}{"key": key})
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
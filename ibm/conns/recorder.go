@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Modes of the fixture Recorder
+const (
+	RecorderModeRecord = "record"
+	RecorderModeReplay = "replay"
+)
+
+// Environment variables which inject a fixture Recorder into the service clients built by Config.ClientSession
+const (
+	HTTPFixturesFileEnv = "IBMCLOUD_HTTP_FIXTURES_FILE"
+	HTTPFixturesModeEnv = "IBMCLOUD_HTTP_FIXTURES_MODE"
+)
+
+// RecordedRequest is the part of a request which is matched when replaying an interaction
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is the response replayed for a matching request
+type RecordedResponse struct {
+	StatusCode int           `json:"status_code"`
+	Header     gohttp.Header `json:"header,omitempty"`
+	Body       string        `json:"body,omitempty"`
+}
+
+// Interaction is a request sent to a service endpoint together with the response of the endpoint
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Recorder is an http.RoundTripper which records the interactions of the service clients with the service endpoints
+// to a fixture file, or replays the interactions of the fixture file without sending the requests. Requests are
+// matched on the method and the path and query of the URL, regardless of the host, so fixtures recorded against the
+// live endpoints replay against the fake endpoints of an endpoints file. Interactions without a query in their URL
+// match the requests to their path with any query, which keeps hand written fixtures independent of the API version
+// dates the SDKs send. Matching interactions are replayed in the
+// order they were recorded and the last one is repeated once they are used up, which keeps polling loops working.
+//
+// The fixture file holds the response bodies as they were sent, including the tokens of the IAM token endpoint, it
+// must be reviewed before it is committed.
+type Recorder struct {
+	mode         string
+	path         string
+	next         gohttp.RoundTripper
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewRecorder returns a Recorder for the fixture file at path. In record mode the requests are sent with next, or
+// with DefaultTransport if next is nil, and the fixture file is rewritten after each interaction. In replay mode the
+// fixture file must exist.
+func NewRecorder(path, mode string, next gohttp.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		mode: mode,
+		path: path,
+		next: next,
+	}
+	switch mode {
+	case RecorderModeRecord:
+		if r.next == nil {
+			r.next = DefaultTransport()
+		}
+	case RecorderModeReplay:
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Unable to read the fixtures file %s: %s", path, err)
+		}
+		if err = json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("[ERROR] Unable to unmarshal the fixtures file %s: %s", path, err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	default:
+		return nil, fmt.Errorf("[ERROR] Unknown fixtures mode %q, expected %s or %s", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+	return r, nil
+}
+
+// RecorderFromEnv returns the Recorder configured with IBMCLOUD_HTTP_FIXTURES_FILE and IBMCLOUD_HTTP_FIXTURES_MODE,
+// or nil if no fixtures file is set. The mode defaults to replay.
+func RecorderFromEnv() (*Recorder, error) {
+	path := os.Getenv(HTTPFixturesFileEnv)
+	if path == "" {
+		return nil, nil
+	}
+	mode := os.Getenv(HTTPFixturesModeEnv)
+	if mode == "" {
+		mode = RecorderModeReplay
+	}
+	return NewRecorder(path, mode, nil)
+}
+
+// Interactions returns the interactions recorded or loaded by the Recorder
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	interactions := make([]Interaction, len(r.interactions))
+	copy(interactions, r.interactions)
+	return interactions
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Body:   body,
+	}
+	if r.mode == RecorderModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) record(req *gohttp.Request, recorded RecordedRequest) (*gohttp.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       string(respBody),
+		},
+	})
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = ioutil.WriteFile(r.path, data, 0600); err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to write the fixtures file %s: %s", r.path, err)
+	}
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *gohttp.Request, recorded RecordedRequest) (*gohttp.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last := -1
+	for i, interaction := range r.interactions {
+		if interaction.Request.Method != recorded.Method || !matchRecordedURL(interaction.Request.URL, recorded.URL) {
+			continue
+		}
+		last = i
+		if !r.replayed[i] {
+			r.replayed[i] = true
+			return replayResponse(req, interaction.Response), nil
+		}
+	}
+	if last < 0 {
+		return nil, fmt.Errorf("[ERROR] No interaction recorded in %s for %s %s", r.path, recorded.Method, recorded.URL)
+	}
+	return replayResponse(req, r.interactions[last].Response), nil
+}
+
+// matchRecordedURL reports whether the request URI matches the URL of an interaction
+func matchRecordedURL(interactionURL, requestURI string) bool {
+	if interactionURL == requestURI {
+		return true
+	}
+	if strings.Contains(interactionURL, "?") {
+		return false
+	}
+	if i := strings.Index(requestURI, "?"); i >= 0 {
+		requestURI = requestURI[:i]
+	}
+	return interactionURL == requestURI
+}
+
+func replayResponse(req *gohttp.Request, recorded RecordedResponse) *gohttp.Response {
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = gohttp.Header{}
+	}
+	return &gohttp.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, gohttp.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+// readRequestBody reads the body of the request and replaces it, so that it can still be sent
+func readRequestBody(req *gohttp.Request) (string, error) {
+	if req.Body == nil || req.Body == gohttp.NoBody {
+		return "", nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return string(body), nil
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	gohttp "net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderRecordReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, req *gohttp.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(req.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if req.Method == gohttp.MethodPost {
+			w.WriteHeader(gohttp.StatusCreated)
+			w.Write([]byte(`{"id":"r006-1","name":` + string(body) + `}`))
+			return
+		}
+		w.Write([]byte(`{"id":"r006-1","status":"` + req.URL.Query().Get("status") + `"}`))
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+
+	recorder, err := NewRecorder(path, RecorderModeRecord, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client := &gohttp.Client{Transport: recorder}
+	for _, url := range []string{"/v1/vpcs?status=pending", "/v1/vpcs?status=available"} {
+		resp, err := client.Get(server.URL + url)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		resp.Body.Close()
+	}
+	resp, err := client.Post(server.URL+"/v1/vpcs", "application/json", strings.NewReader(`"vpc"`))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != `{"id":"r006-1","name":"vpc"}` {
+		t.Fatalf("bad recorded response body: %s", body)
+	}
+	if interactions := recorder.Interactions(); len(interactions) != 3 || interactions[2].Request.Body != `"vpc"` {
+		t.Fatalf("bad interactions: %#v", interactions)
+	}
+
+	replayer, err := NewRecorder(path, RecorderModeReplay, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client = &gohttp.Client{Transport: replayer}
+	cases := []struct {
+		method, url string
+		statusCode  int
+		body        string
+	}{
+		{gohttp.MethodPost, "/v1/vpcs", gohttp.StatusCreated, `{"id":"r006-1","name":"vpc"}`},
+		{gohttp.MethodGet, "/v1/vpcs?status=available", gohttp.StatusOK, `{"id":"r006-1","status":"available"}`},
+		{gohttp.MethodGet, "/v1/vpcs?status=available", gohttp.StatusOK, `{"id":"r006-1","status":"available"}`},
+		{gohttp.MethodGet, "/v1/vpcs?status=pending", gohttp.StatusOK, `{"id":"r006-1","status":"pending"}`},
+	}
+	for _, c := range cases {
+		req, _ := gohttp.NewRequest(c.method, "https://us-south.iaas.cloud.ibm.com"+c.url, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != c.statusCode || string(body) != c.body {
+			t.Fatalf("bad response for %s %s: %d %s", c.method, c.url, resp.StatusCode, body)
+		}
+	}
+	if _, err := client.Get("https://us-south.iaas.cloud.ibm.com/v1/subnets"); err == nil {
+		t.Fatalf("expected an error for a request without a recorded interaction")
+	}
+	if calls != 3 {
+		t.Fatalf("the replayed requests were sent to the server: %d calls", calls)
+	}
+}
+
+func TestMatchRecordedURL(t *testing.T) {
+	cases := []struct {
+		interactionURL, requestURI string
+		expected                   bool
+	}{
+		{"/v1/vpcs?version=2022-07-01", "/v1/vpcs?version=2022-07-01", true},
+		{"/v1/vpcs", "/v1/vpcs?version=2022-07-01&generation=2", true},
+		{"/v1/vpcs?version=2022-06-01", "/v1/vpcs?version=2022-07-01", false},
+		{"/v1/vpcs", "/v1/vpcs/r006-1", false},
+	}
+	for _, c := range cases {
+		if actual := matchRecordedURL(c.interactionURL, c.requestURI); actual != c.expected {
+			t.Fatalf("bad match of %s and %s: %t", c.interactionURL, c.requestURI, actual)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"strings"
+)
+
+// TagsConfig holds the provider level default_tags and ignore_tags settings
+type TagsConfig struct {
+	DefaultTags       []string
+	IgnoreKeys        []string
+	IgnoreKeyPrefixes []string
+}
+
+// TagKey returns the key of a tag, tags are of the form key:value or a plain label
+func TagKey(tag string) string {
+	tag = strings.TrimSpace(tag)
+	if i := strings.Index(tag, ":"); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+// IsIgnored reports whether the tag key matches one of the ignore_tags keys or key prefixes
+func (t *TagsConfig) IsIgnored(tag string) bool {
+	if t == nil {
+		return false
+	}
+	key := strings.ToLower(TagKey(tag))
+	for _, k := range t.IgnoreKeys {
+		if key == strings.ToLower(strings.TrimSpace(k)) {
+			return true
+		}
+	}
+	for _, p := range t.IgnoreKeyPrefixes {
+		if strings.HasPrefix(key, strings.ToLower(strings.TrimSpace(p))) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDefault reports whether the tag is one of the default_tags
+func (t *TagsConfig) IsDefault(tag string) bool {
+	if t == nil {
+		return false
+	}
+	for _, d := range t.Defaults() {
+		if strings.EqualFold(strings.TrimSpace(d), strings.TrimSpace(tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Defaults returns the default_tags which are not ignored
+func (t *TagsConfig) Defaults() []string {
+	if t == nil {
+		return nil
+	}
+	defaults := make([]string, 0, len(t.DefaultTags))
+	for _, tag := range t.DefaultTags {
+		if strings.TrimSpace(tag) == "" || t.IsIgnored(tag) {
+			continue
+		}
+		defaults = append(defaults, tag)
+	}
+	return defaults
+}
+
+// Filter removes the ignored tags from the list
+func (t *TagsConfig) Filter(tags []string) []string {
+	filtered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if t.IsIgnored(tag) {
+			continue
+		}
+		filtered = append(filtered, tag)
+	}
+	return filtered
+}
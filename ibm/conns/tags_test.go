@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagKey(t *testing.T) {
+	cases := map[string]string{
+		"env:prod":        "env",
+		"cost-center:123": "cost-center",
+		"owner":           "owner",
+		" team:a:b ":      "team",
+	}
+	for tag, expected := range cases {
+		if actual := TagKey(tag); actual != expected {
+			t.Fatalf("bad key for %q: %q, expected %q", tag, actual, expected)
+		}
+	}
+}
+
+func TestTagsConfigIsIgnored(t *testing.T) {
+	cfg := &TagsConfig{
+		IgnoreKeys:        []string{"schematics"},
+		IgnoreKeyPrefixes: []string{"ibm-"},
+	}
+	ignored := []string{"schematics:123", "Schematics", "ibm-cloud:managed", "IBM-Owner:x"}
+	for _, tag := range ignored {
+		if !cfg.IsIgnored(tag) {
+			t.Fatalf("expected %q to be ignored", tag)
+		}
+	}
+	kept := []string{"env:prod", "schematicsx:1", "owner:ibm-team"}
+	for _, tag := range kept {
+		if cfg.IsIgnored(tag) {
+			t.Fatalf("expected %q not to be ignored", tag)
+		}
+	}
+
+	var nilCfg *TagsConfig
+	if nilCfg.IsIgnored("env:prod") {
+		t.Fatalf("nil config must not ignore tags")
+	}
+}
+
+func TestTagsConfigDefaults(t *testing.T) {
+	cfg := &TagsConfig{
+		DefaultTags:       []string{"env:prod", "", "ibm-managed:true", "owner:team-a"},
+		IgnoreKeyPrefixes: []string{"ibm-"},
+	}
+	expected := []string{"env:prod", "owner:team-a"}
+	if actual := cfg.Defaults(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad defaults: %#v, expected %#v", actual, expected)
+	}
+	if !cfg.IsDefault("ENV:prod") {
+		t.Fatalf("expected default tags to be matched case insensitively")
+	}
+	if cfg.IsDefault("ibm-managed:true") {
+		t.Fatalf("ignored tags must not be treated as defaults")
+	}
+}
+
+func TestTagsConfigFilter(t *testing.T) {
+	cfg := &TagsConfig{IgnoreKeys: []string{"schematics"}}
+	actual := cfg.Filter([]string{"env:prod", "schematics:123", "owner"})
+	expected := []string{"env:prod", "owner"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad filter result: %#v, expected %#v", actual, expected)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AddStateUpgrader bumps the SchemaVersion of the resource and registers upgrade to migrate the raw state of the
+// previous version. It is meant for upgrades which only rewrite values, such as the format of the ID, as the previous
+// version is described with the attributes of the current schema. Upgrades which change the type of an attribute
+// must append a schema.StateUpgrader built from the schema of the previous version instead.
+func AddStateUpgrader(r *schema.Resource, upgrade schema.StateUpgradeFunc) *schema.Resource {
+	r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+		Version: r.SchemaVersion,
+		Type:    r.CoreConfigSchema().ImpliedType(),
+		Upgrade: upgrade,
+	})
+	r.SchemaVersion++
+	return r
+}
+
+// RawStateString returns the string value of the attribute in the raw state, or an empty string if it is not set
+func RawStateString(rawState map[string]interface{}, key string) string {
+	if rawState == nil {
+		return ""
+	}
+	if v, ok := rawState[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// UpgradeIDWithParent prefixes the ID in the raw state with the value of the parent attribute when the ID only holds
+// the ID of the child object, IDs which already are composite are left untouched
+func UpgradeIDWithParent(rawState map[string]interface{}, parentKeys ...string) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	id := RawStateString(rawState, "id")
+	if id == "" || strings.Contains(id, "/") {
+		return rawState, nil
+	}
+	for _, key := range parentKeys {
+		if parent := RawStateString(rawState, key); parent != "" {
+			rawState["id"] = fmt.Sprintf("%s/%s", parent, id)
+			return rawState, nil
+		}
+	}
+	return rawState, fmt.Errorf("[ERROR] Unable to upgrade the ID %s, none of %s is set in the state", id, strings.Join(parentKeys, ", "))
+}
+
+// UpgradeCisZoneResourceState upgrades the raw state of CIS resources scoped to a domain. Older states hold the ID of
+// the ibm_cis_domain resource, <zone_id>:<cis_id>, in domain_id and may hold the object ID only as ID, the upgraded
+// state holds the zone ID in domain_id and <id>:<zone_id>:<cis_id> as ID.
+func UpgradeCisZoneResourceState(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	zoneID := RawStateString(rawState, "domain_id")
+	if i := strings.Index(zoneID, ":"); i >= 0 {
+		zoneID = zoneID[:i]
+		rawState["domain_id"] = zoneID
+	}
+
+	id := RawStateString(rawState, "id")
+	if id == "" || strings.Contains(id, ":") {
+		return rawState, nil
+	}
+	cisID := RawStateString(rawState, "cis_id")
+	if zoneID == "" || cisID == "" {
+		return rawState, fmt.Errorf("[ERROR] Unable to upgrade the ID %s, domain_id and cis_id must be set in the state", id)
+	}
+	rawState["id"] = ConvertCisToTfThreeVar(id, zoneID, cisID)
+	return rawState, nil
+}
@@ -62,7 +62,9 @@ const (
 	//ResourceGroupName ...
 	ResourceGroupName = "resource_group_name"
 	//RelatedCRN ...
-	RelatedCRN                                = "related_crn"
+	RelatedCRN = "related_crn"
+	//TagsAll ...
+	TagsAll                                   = "tags_all"
 	SystemIBMLabelPrefix                      = "ibm-cloud.kubernetes.io/"
 	KubernetesLabelPrefix                     = "kubernetes.io/"
 	K8sLabelPrefix                            = "k8s.io/"
@@ -726,6 +728,9 @@ func ArchiveRuleGet(in []*s3.LifecycleRule) []interface{} {
 					rule["type"] = *transition.StorageClass
 				}
 			}
+			if r.Filter != nil && r.Filter.Prefix != nil {
+				rule["prefix"] = *(r.Filter).Prefix
+			}
 
 			rules = append(rules, rule)
 		}
@@ -912,6 +917,70 @@ func ReplicationRuleGet(in *s3.ReplicationConfiguration) []map[string]interface{
 	return rules
 }
 
+func CorsRulesGet(in []*s3.CORSRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := make(map[string]interface{})
+		rule["allowed_headers"] = flattenCosStringList(r.AllowedHeaders)
+		rule["allowed_methods"] = flattenCosStringList(r.AllowedMethods)
+		rule["allowed_origins"] = flattenCosStringList(r.AllowedOrigins)
+		rule["expose_headers"] = flattenCosStringList(r.ExposeHeaders)
+		if r.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(*r.MaxAgeSeconds)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func RoutingRulesGet(in []*s3.RoutingRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := make(map[string]interface{})
+		if r.Condition != nil {
+			condition := make(map[string]interface{})
+			if r.Condition.HttpErrorCodeReturnedEquals != nil {
+				condition["http_error_code_returned_equals"] = *(r.Condition).HttpErrorCodeReturnedEquals
+			}
+			if r.Condition.KeyPrefixEquals != nil {
+				condition["key_prefix_equals"] = *(r.Condition).KeyPrefixEquals
+			}
+			rule["condition"] = []interface{}{condition}
+		}
+		if r.Redirect != nil {
+			redirect := make(map[string]interface{})
+			if r.Redirect.HostName != nil {
+				redirect["host_name"] = *(r.Redirect).HostName
+			}
+			if r.Redirect.HttpRedirectCode != nil {
+				redirect["http_redirect_code"] = *(r.Redirect).HttpRedirectCode
+			}
+			if r.Redirect.Protocol != nil {
+				redirect["protocol"] = *(r.Redirect).Protocol
+			}
+			if r.Redirect.ReplaceKeyPrefixWith != nil {
+				redirect["replace_key_prefix_with"] = *(r.Redirect).ReplaceKeyPrefixWith
+			}
+			if r.Redirect.ReplaceKeyWith != nil {
+				redirect["replace_key_with"] = *(r.Redirect).ReplaceKeyWith
+			}
+			rule["redirect"] = []interface{}{redirect}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenCosStringList(in []*string) []interface{} {
+	out := make([]interface{}, 0, len(in))
+	for _, v := range in {
+		if v != nil {
+			out = append(out, *v)
+		}
+	}
+	return out
+}
+
 func FlattenLimits(in *whisk.Limits) []interface{} {
 	att := make(map[string]interface{})
 	if in.Timeout != nil {
@@ -1313,23 +1382,22 @@ func CfIdParts(id string) ([]string, error) {
 	return parts, nil
 }
 
+// IsPolicyResourceSystemAttribute reports whether a policy resource attribute is system defined, system defined
+// attributes are flattened into their own field by FlattenPolicyResource or are not flattened at all
+func IsPolicyResourceSystemAttribute(name string) bool {
+	switch name {
+	case "accesGroupId", "accountId", "organizationId", "spaceId", "region", "resource", "resourceType",
+		"resourceGroupId", "serviceType", "serviceName", "serviceInstance":
+		return true
+	}
+	return false
+}
+
 // getCustomAttributes will return all attributes which are not system defined
 func getCustomAttributes(r iampolicymanagementv1.PolicyResource) []iampolicymanagementv1.ResourceAttribute {
 	attributes := []iampolicymanagementv1.ResourceAttribute{}
 	for _, a := range r.Attributes {
-		switch *a.Name {
-		case "accesGroupId":
-		case "accountId":
-		case "organizationId":
-		case "spaceId":
-		case "region":
-		case "resource":
-		case "resourceType":
-		case "resourceGroupId":
-		case "serviceType":
-		case "serviceName":
-		case "serviceInstance":
-		default:
+		if !IsPolicyResourceSystemAttribute(*a.Name) {
 			attributes = append(attributes, a)
 		}
 	}
@@ -2053,7 +2121,7 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 		taglist = append(taglist, *item.Name)
 	}
 	log.Println("tagList: ", taglist)
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return NewStringSet(ResourceIBMVPCHash, tagsConfig(meta).Filter(taglist)), nil
 }
 
 func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tagType string) (*schema.Set, error) {
@@ -2103,7 +2171,7 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 			}
 		}
 	}
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return NewStringSet(ResourceIBMVPCHash, tagsConfig(meta).Filter(taglist)), nil
 }
 
 func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
@@ -2130,16 +2198,21 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	}
 	olds := oldList.(*schema.Set)
 	news := newList.(*schema.Set)
+	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+		news = MergeDefaultTags(meta, news)
+	}
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
-	add := make([]string, len(addInt))
-	for i, v := range addInt {
-		add[i] = fmt.Sprint(v)
+	add := make([]string, 0, len(addInt))
+	for _, v := range addInt {
+		add = append(add, fmt.Sprint(v))
 	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
+	remove := make([]string, 0, len(removeInt))
+	for _, v := range removeInt {
+		remove = append(remove, fmt.Sprint(v))
 	}
+	add = tagsConfig(meta).Filter(add)
+	remove = tagsConfig(meta).Filter(remove)
 
 	if strings.TrimSpace(tagType) == "" || tagType == "user" {
 		schematicTags := os.Getenv("IC_ENV_TAGS")
@@ -2232,7 +2305,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, item.Name)
 	}
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return NewStringSet(ResourceIBMVPCHash, tagsConfig(meta).Filter(taglist)), nil
 }
 
 func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
@@ -2247,17 +2320,19 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		newList = new(schema.Set)
 	}
 	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	news := MergeDefaultTags(meta, newList.(*schema.Set))
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
-	add := make([]string, len(addInt))
-	for i, v := range addInt {
-		add[i] = fmt.Sprint(v)
+	add := make([]string, 0, len(addInt))
+	for _, v := range addInt {
+		add = append(add, fmt.Sprint(v))
 	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
+	remove := make([]string, 0, len(removeInt))
+	for _, v := range removeInt {
+		remove = append(remove, fmt.Sprint(v))
 	}
+	add = tagsConfig(meta).Filter(add)
+	remove = tagsConfig(meta).Filter(remove)
 
 	schematicTags := os.Getenv("IC_ENV_TAGS")
 	var envTags []string
@@ -2327,6 +2402,83 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	return nil
 }
 
+// ResourceTagsAllCustomizeDiff plans tags_all as the resource tags merged with the
+// provider default_tags, so a change to default_tags shows up as an in-place update.
+func ResourceTagsAllCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := ResourceTagsCustomizeDiff(diff); err != nil {
+		return err
+	}
+	if !diff.NewValueKnown("tags.#") {
+		return diff.SetNewComputed(TagsAll)
+	}
+	tagsAll := mergeTagsAll(meta, diff.Get("tags").(*schema.Set))
+	if o, _ := diff.GetChange(TagsAll); o != nil && o.(*schema.Set).Equal(tagsAll) {
+		return nil
+	}
+	return diff.SetNew(TagsAll, tagsAll)
+}
+
+// ResourceTagsAll returns the tags_all of a resource computed from its final tags, tags_all is unknown in the plan
+// when the tags are
+func ResourceTagsAll(d *schema.ResourceData, meta interface{}) *schema.Set {
+	return mergeTagsAll(meta, d.Get("tags").(*schema.Set))
+}
+
+// TagsAllChange returns the old tags_all of a resource and the tags_all computed from its final tags
+func TagsAllChange(d *schema.ResourceData, meta interface{}) (interface{}, interface{}) {
+	o, _ := d.GetChange(TagsAll)
+	return o, ResourceTagsAll(d, meta)
+}
+
+func mergeTagsAll(meta interface{}, tags *schema.Set) *schema.Set {
+	tagsAll := MergeDefaultTags(meta, tags)
+	if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+		for _, t := range strings.Split(v, ",") {
+			tagsAll.Add(t)
+		}
+	}
+	return NewStringSet(ResourceIBMVPCHash, tagsConfig(meta).Filter(ExpandStringList(tagsAll.List())))
+}
+
+// MergeDefaultTags returns the union of the tags and the provider default_tags
+func MergeDefaultTags(meta interface{}, tags *schema.Set) *schema.Set {
+	merged := NewStringSet(ResourceIBMVPCHash, ExpandStringList(tags.List()))
+	for _, t := range tagsConfig(meta).Defaults() {
+		merged.Add(t)
+	}
+	return merged
+}
+
+// RemoveDefaultTags removes the provider default_tags from the tags read from a resource,
+// unless they are also part of the configured tags
+func RemoveDefaultTags(meta interface{}, tags *schema.Set, configured interface{}) *schema.Set {
+	if tags == nil {
+		// The tags could not be read
+		return nil
+	}
+	cfg := tagsConfig(meta)
+	var c *schema.Set
+	if configured != nil {
+		c, _ = configured.(*schema.Set)
+	}
+	var taglist []string
+	for _, v := range tags.List() {
+		t := fmt.Sprint(v)
+		if cfg.IsDefault(t) && (c == nil || !c.Contains(t)) {
+			continue
+		}
+		taglist = append(taglist, t)
+	}
+	return NewStringSet(ResourceIBMVPCHash, taglist)
+}
+
+func tagsConfig(meta interface{}) *conns.TagsConfig {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.TagsConfig()
+	}
+	return nil
+}
+
 func ResourceLBListenerPolicyCustomizeDiff(diff *schema.ResourceDiff) error {
 	policyActionIntf, _ := diff.GetOk(isLBListenerPolicyAction)
 	policyAction := policyActionIntf.(string)
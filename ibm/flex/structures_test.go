@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// unknownVariableValue is the value of an unknown attribute in a raw resource config
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// tagsSession is a client session which only provides the tags settings
+type tagsSession struct {
+	conns.ClientSession
+	tagsConfig *conns.TagsConfig
+}
+
+func (s tagsSession) TagsConfig() *conns.TagsConfig {
+	return s.tagsConfig
+}
+
+func tagsTestResource(tagsAll bool) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      ResourceIBMVPCHash,
+			},
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return ResourceTagsCustomizeDiff(diff)
+		},
+	}
+	if tagsAll {
+		r.Schema[TagsAll] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      ResourceIBMVPCHash,
+		}
+		r.CustomizeDiff = func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return ResourceTagsAllCustomizeDiff(diff, meta)
+		}
+	}
+	return r
+}
+
+// readTags returns the state of the tags a resource reads for the tags attached to it
+func readTags(meta interface{}, attached []string, state *terraform.InstanceState, r *schema.Resource) map[string]string {
+	d := r.Data(state)
+	tags := NewStringSet(ResourceIBMVPCHash, attached)
+	d.Set("tags", RemoveDefaultTags(meta, tags, d.Get("tags")))
+	if _, ok := r.Schema[TagsAll]; ok {
+		d.Set(TagsAll, tags)
+	}
+	return d.State().Attributes
+}
+
+func TestResourceTagsCustomizeDiffRemovedDefaultTag(t *testing.T) {
+	meta := tagsSession{tagsConfig: &conns.TagsConfig{DefaultTags: []string{"env:prod"}}}
+	r := tagsTestResource(true)
+
+	// env:prod is both set on the resource and a default tag, removing it from the
+	// resource is planned even though it stays attached as a default tag
+	state := &terraform.InstanceState{ID: "r1", Attributes: map[string]string{
+		"id":     "r1",
+		"tags.#": "2",
+		"tags.1": "env:prod",
+		"tags.2": "tag1",
+	}}
+	state.Attributes = readTags(meta, []string{"env:prod", "tag1"}, state, r)
+	state.Attributes["id"] = "r1"
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": []interface{}{"tag1"},
+	}), meta)
+	if err != nil {
+		t.Fatalf("Diff returned %s", err)
+	}
+	if diff == nil || diff.Attributes["tags.#"] == nil || diff.Attributes["tags.#"].New != "1" {
+		t.Fatalf("expected the removal of env:prod from the tags to be planned, got %v", diff)
+	}
+	if _, ok := diff.Attributes[TagsAll+".#"]; ok {
+		t.Fatalf("expected tags_all to keep the default tag, got %v", diff)
+	}
+}
+
+func TestResourceTagsCustomizeDiffDroppedDefaultTag(t *testing.T) {
+	r := tagsTestResource(false)
+	attached := []string{"env:prod", "tag1"}
+	state := &terraform.InstanceState{ID: "r1", Attributes: map[string]string{
+		"id":     "r1",
+		"tags.#": "1",
+		"tags.1": "tag1",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": []interface{}{"tag1"},
+	})
+
+	// While env:prod is a default tag it is not drift
+	meta := tagsSession{tagsConfig: &conns.TagsConfig{DefaultTags: []string{"env:prod"}}}
+	state.Attributes = readTags(meta, attached, state, r)
+	state.Attributes["id"] = "r1"
+	diff, err := r.Diff(context.Background(), state, config, meta)
+	if err != nil {
+		t.Fatalf("Diff returned %s", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no diff for the default tag, got %v", diff)
+	}
+
+	// Once it is removed from the default tags it is planned to be detached
+	meta = tagsSession{tagsConfig: &conns.TagsConfig{DefaultTags: []string{"env:stage"}}}
+	state.Attributes = readTags(meta, attached, state, r)
+	state.Attributes["id"] = "r1"
+	diff, err = r.Diff(context.Background(), state, config, meta)
+	if err != nil {
+		t.Fatalf("Diff returned %s", err)
+	}
+	if diff == nil || diff.Attributes["tags.#"] == nil || diff.Attributes["tags.#"].Old != "2" || diff.Attributes["tags.#"].New != "1" {
+		t.Fatalf("expected the removed default tag to be detached, got %v", diff)
+	}
+}
+
+func TestRemoveDefaultTagsUnreadTags(t *testing.T) {
+	meta := tagsSession{tagsConfig: &conns.TagsConfig{DefaultTags: []string{"env:prod"}}}
+	// GetTagsUsingCRN returns no tags when it fails, the resources only log the error
+	if tags := RemoveDefaultTags(meta, nil, nil); tags != nil {
+		t.Fatalf("expected no tags, got %v", tags.List())
+	}
+}
+
+func TestTagsAllChangeUnknownTags(t *testing.T) {
+	meta := tagsSession{tagsConfig: &conns.TagsConfig{DefaultTags: []string{"env:prod"}}}
+	r := tagsTestResource(true)
+
+	// tags_all is unknown in the plan when the tags are
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": []interface{}{unknownVariableValue},
+	}), meta)
+	if err != nil {
+		t.Fatalf("Diff returned %s", err)
+	}
+	if diff == nil || diff.Attributes[TagsAll+".#"] == nil || !diff.Attributes[TagsAll+".#"].NewComputed {
+		t.Fatalf("expected tags_all to be computed, got %v", diff)
+	}
+
+	// create applies the tags_all of the final tags
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": []interface{}{"tag1"},
+	})
+	_, n := TagsAllChange(d, meta)
+	if expected := NewStringSet(ResourceIBMVPCHash, []string{"env:prod", "tag1"}); !n.(*schema.Set).Equal(expected) {
+		t.Fatalf("expected tags_all %v, got %v", expected.List(), n.(*schema.Set).List())
+	}
+}
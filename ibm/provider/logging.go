@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withServiceSubsystems sets up the tflog subsystem of the service in the context passed to the operations of the
+// resources and data sources, so that conns.LogDebug and tflog.Subsystem* calls can be filtered per service with
+// TF_LOG_PROVIDER_IBM_<SUBSYSTEM>. The operations which do not take a context are converted to context aware ones,
+// the start and the failure of every operation are logged to the subsystem.
+func withServiceSubsystems(p *schema.Provider) *schema.Provider {
+	for name, r := range p.ResourcesMap {
+		addServiceSubsystem(r, name, conns.SubsystemForResource(name))
+	}
+	for name, r := range p.DataSourcesMap {
+		addServiceSubsystem(r, name, conns.SubsystemForResource(name))
+	}
+	return p
+}
+
+func addServiceSubsystem(r *schema.Resource, name, subsystem string) {
+	if subsystem == "" {
+		return
+	}
+	r.CreateContext = contextWithSubsystem(r.CreateContext, legacyContextFunc(r.Create), name, "create", subsystem)
+	r.ReadContext = contextWithSubsystem(r.ReadContext, legacyContextFunc(r.Read), name, "read", subsystem)
+	r.UpdateContext = contextWithSubsystem(r.UpdateContext, legacyContextFunc(r.Update), name, "update", subsystem)
+	r.DeleteContext = contextWithSubsystem(r.DeleteContext, legacyContextFunc(r.Delete), name, "delete", subsystem)
+	r.Create, r.Read, r.Update, r.Delete = nil, nil, nil, nil
+
+	r.CreateWithoutTimeout = contextWithSubsystem(r.CreateWithoutTimeout, nil, name, "create", subsystem)
+	r.ReadWithoutTimeout = contextWithSubsystem(r.ReadWithoutTimeout, nil, name, "read", subsystem)
+	r.UpdateWithoutTimeout = contextWithSubsystem(r.UpdateWithoutTimeout, nil, name, "update", subsystem)
+	r.DeleteWithoutTimeout = contextWithSubsystem(r.DeleteWithoutTimeout, nil, name, "delete", subsystem)
+}
+
+type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// legacyContextFunc converts an operation which does not take a context, the same way the SDK calls it
+func legacyContextFunc(f func(*schema.ResourceData, interface{}) error) contextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(f(d, meta))
+	}
+}
+
+func contextWithSubsystem(f, legacy contextFunc, name, operation, subsystem string) contextFunc {
+	if f == nil {
+		f = legacy
+	}
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx = conns.WithSubsystem(ctx, subsystem)
+		conns.LogDebug(ctx, "Calling the operation", map[string]interface{}{"resource": name, "operation": operation, "id": d.Id()})
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			conns.LogDebug(ctx, "The operation failed", map[string]interface{}{"resource": name, "operation": operation, "id": d.Id(), "error": diags[0].Summary})
+		}
+		return diags
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func TestProviderServiceSubsystemsValidate(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAddServiceSubsystemLegacyOperations(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_IBM_VPC", "DEBUG")
+	var subsystem string
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return fmt.Errorf("[ERROR] Error getting VPC (%s)", d.Id())
+		},
+	}
+	wrapped := &schema.Resource{Schema: r.Schema, ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		subsystem = conns.Subsystem(ctx)
+		return nil
+	}}
+
+	addServiceSubsystem(r, "ibm_is_vpc", conns.SubsystemVpc)
+	addServiceSubsystem(wrapped, "ibm_is_vpc", conns.SubsystemVpc)
+	if r.Read != nil || r.ReadContext == nil {
+		t.Fatalf("expected the legacy read to be converted to a context aware read")
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	d := r.Data(nil)
+	d.SetId("r006-vpc")
+	diags := r.ReadContext(ctx, d, nil)
+	if !diags.HasError() || diags[0].Summary != "[ERROR] Error getting VPC (r006-vpc)" {
+		t.Fatalf("bad diagnostics: %#v", diags)
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(entries) != 2 || entries[1]["@module"] != "provider.vpc" || entries[1]["resource"] != "ibm_is_vpc" || entries[1]["operation"] != "read" {
+		t.Fatalf("bad log entries: %s", output.String())
+	}
+
+	wrapped.ReadContext(ctx, wrapped.Data(nil), nil)
+	if subsystem != conns.SubsystemVpc {
+		t.Fatalf("bad subsystem in the context of the read: %q", subsystem)
+	}
+}
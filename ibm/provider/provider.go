@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -56,7 +57,7 @@ import (
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	return withServiceSubsystems(&schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -212,6 +213,44 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags applied to every resource that supports tagging",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of tags, in the form key:value or label, added to every taggable resource",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are ignored when reading and updating resource tags",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag keys to ignore",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag key prefixes to ignore",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -348,6 +387,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_user_profile":                  iamidentity.DataSourceIBMIAMUserProfile(),
 			"ibm_iam_service_id":                    iamidentity.DataSourceIBMIAMServiceID(),
 			"ibm_iam_service_policy":                iampolicy.DataSourceIBMIAMServicePolicy(),
+			"ibm_iam_effective_access":              iampolicy.DataSourceIBMIAMEffectiveAccess(),
 			"ibm_iam_api_key":                       iamidentity.DataSourceIBMIamApiKey(),
 			"ibm_iam_trusted_profile":               iamidentity.DataSourceIBMIamTrustedProfile(),
 			"ibm_iam_trusted_profile_claim_rule":    iamidentity.DataSourceIBMIamTrustedProfileClaimRule(),
@@ -458,6 +498,8 @@ func Provider() *schema.Provider {
 			"ibm_is_security_group_rules":            vpc.DataSourceIBMIsSecurityGroupRules(),
 			"ibm_is_security_group_target":           vpc.DataSourceIBMISSecurityGroupTarget(),
 			"ibm_is_security_group_targets":          vpc.DataSourceIBMISSecurityGroupTargets(),
+			"ibm_is_share_profiles":                  vpc.DataSourceIBMIsShareProfiles(),
+			"ibm_is_shares":                          vpc.DataSourceIBMIsShares(),
 			"ibm_is_snapshot":                        vpc.DataSourceSnapshot(),
 			"ibm_is_snapshots":                       vpc.DataSourceSnapshots(),
 			"ibm_is_volume":                          vpc.DataSourceIBMISVolume(),
@@ -674,8 +716,9 @@ func Provider() *schema.Provider {
 			"ibm_scc_posture_credentials":       scc.DataSourceIBMSccPostureCredentials(),
 			"ibm_scc_posture_collectors":        scc.DataSourceIBMSccPostureCollectors(),
 			// // Added for Context Based Restrictions
-			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":        contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_rule":        contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_rule_report": contextbasedrestrictions.DataSourceIBMCbrRuleReport(),
 
 			// // Added for Event Notifications
 			"ibm_en_source":               eventnotification.DataSourceIBMEnSource(),
@@ -774,6 +817,9 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                    functions.ResourceIBMFunctionNamespace(),
 			"ibm_cis":                                   cis.ResourceIBMCISInstance(),
 			"ibm_database":                              database.ResourceIBMDatabaseInstance(),
+			"ibm_database_configuration":                database.ResourceIBMDatabaseConfiguration(),
+			"ibm_database_read_replica":                 database.ResourceIBMDatabaseReadReplica(),
+			"ibm_database_user":                         database.ResourceIBMDatabaseUser(),
 			"ibm_certificate_manager_import":            certificatemanager.ResourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":             certificatemanager.ResourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                            cis.ResourceIBMCISDomain(),
@@ -836,6 +882,7 @@ func Provider() *schema.Provider {
 			"ibm_container_bind_service":                kubernetes.ResourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                 kubernetes.ResourceIBMContainerWorkerPool(),
 			"ibm_container_worker_pool_zone_attachment": kubernetes.ResourceIBMContainerWorkerPoolZoneAttachment(),
+			"ibm_container_worker_pool_autoscaling":     kubernetes.ResourceIBMContainerWorkerPoolAutoscaling(),
 			"ibm_container_storage_attachment":          kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachment(),
 			"ibm_container_nlb_dns":                     kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_container_dedicated_host_pool":         kubernetes.ResourceIBMContainerDedicatedHostPool(),
@@ -847,6 +894,9 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":           cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_cors_configuration":         cos.ResourceIBMCOSBucketCorsConfiguration(),
+			"ibm_cos_bucket_object_lock_configuration":  cos.ResourceIBMCOSBucketObjectLockConfiguration(),
+			"ibm_cos_bucket_website_configuration":      cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                         classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -880,6 +930,8 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_claim_rule":        iamidentity.ResourceIBMIAMTrustedProfileClaimRule(),
 			"ibm_iam_trusted_profile_link":              iamidentity.ResourceIBMIAMTrustedProfileLink(),
 			"ibm_iam_trusted_profile_policy":            iampolicy.ResourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_policy_template":                   iampolicy.ResourceIBMIAMPolicyTemplate(),
+			"ibm_iam_policy_assignment":                 iampolicy.ResourceIBMIAMPolicyAssignment(),
 			"ibm_ipsec_vpn":                             classicinfrastructure.ResourceIBMIPSecVPN(),
 
 			"ibm_is_backup_policy":      vpc.ResourceIBMIsBackupPolicy(),
@@ -925,6 +977,7 @@ func Provider() *schema.Provider {
 			"ibm_is_public_gateway":                              vpc.ResourceIBMISPublicGateway(),
 			"ibm_is_security_group":                              vpc.ResourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                         vpc.ResourceIBMISSecurityGroupRule(),
+			"ibm_is_security_group_rules":                        vpc.ResourceIBMISSecurityGroupRules(),
 			"ibm_is_security_group_target":                       vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_security_group_network_interface_attachment": vpc.ResourceIBMISSecurityGroupNetworkInterfaceAttachment(),
 			"ibm_is_subnet":                                      vpc.ResourceIBMISSubnet(),
@@ -933,6 +986,8 @@ func Provider() *schema.Provider {
 			"ibm_is_subnet_public_gateway_attachment":            vpc.ResourceIBMISSubnetPublicGatewayAttachment(),
 			"ibm_is_subnet_routing_table_attachment":             vpc.ResourceIBMISSubnetRoutingTableAttachment(),
 			"ibm_is_ssh_key":                                     vpc.ResourceIBMISSSHKey(),
+			"ibm_is_share":                                       vpc.ResourceIBMIsShare(),
+			"ibm_is_share_mount_target":                          vpc.ResourceIBMIsShareMountTarget(),
 			"ibm_is_snapshot":                                    vpc.ResourceIBMSnapshot(),
 			"ibm_is_volume":                                      vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                                 vpc.ResourceIBMISVPNGateway(),
@@ -946,6 +1001,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_server_client":                           vpc.ResourceIBMIsVPNServerClient(),
 			"ibm_is_vpn_server_route":                            vpc.ResourceIBMIsVPNServerRoute(),
 			"ibm_is_image":                                       vpc.ResourceIBMISImage(),
+			"ibm_is_image_export_job":                            vpc.ResourceIBMIsImageExportJob(),
 			"ibm_lb":                                             classicinfrastructure.ResourceIBMLb(),
 			"ibm_lbaas":                                          classicinfrastructure.ResourceIBMLbaas(),
 			"ibm_lbaas_health_monitor":                           classicinfrastructure.ResourceIBMLbaasHealthMonitor(),
@@ -976,6 +1032,9 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_alias":                                  kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                                  kms.ResourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                               kms.ResourceIBMKmskeyPolicies(),
+			"ibm_kms_key_action":                                 kms.ResourceIBMKmsKeyAction(),
+			"ibm_kms_instance_policies":                          kms.ResourceIBMKmsInstancePolicies(),
+			"ibm_kms_import_token":                               kms.ResourceIBMKmsImportToken(),
 			"ibm_kp_key":                                         kms.ResourceIBMkey(),
 			"ibm_resource_group":                                 resourcemanager.ResourceIBMResourceGroup(),
 			"ibm_resource_instance":                              resourcecontroller.ResourceIBMResourceInstance(),
@@ -1021,6 +1080,9 @@ func Provider() *schema.Provider {
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
 			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
+			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
+			"ibm_pi_volume_replication":              power.ResourceIBMPIVolumeReplication(),
+			"ibm_pi_volume_group_failover":           power.ResourceIBMPIVolumeGroupFailover(),
 
 			// //Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1064,6 +1126,7 @@ func Provider() *schema.Provider {
 			"ibm_schematics_job":            schematics.ResourceIBMSchematicsJob(),
 			"ibm_schematics_inventory":      schematics.ResourceIBMSchematicsInventory(),
 			"ibm_schematics_resource_query": schematics.ResourceIBMSchematicsResourceQuery(),
+			"ibm_schematics_workspace_run":  schematics.ResourceIBMSchematicsWorkspaceRun(),
 
 			// //satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
@@ -1097,6 +1160,15 @@ func Provider() *schema.Provider {
 			"ibm_scc_posture_profile_import":           scc.ResourceIBMSccPostureProfileImport(),
 			"ibm_scc_posture_scan_initiate_validation": scc.ResourceIBMSccPostureScanInitiateValidation(),
 
+			// Added for Secrets Manager
+			"ibm_secrets_manager_secret_group":             secretsmanager.ResourceIBMSecretsManagerSecretGroup(),
+			"ibm_secrets_manager_arbitrary_secret":         secretsmanager.ResourceIBMSecretsManagerArbitrarySecret(),
+			"ibm_secrets_manager_username_password_secret": secretsmanager.ResourceIBMSecretsManagerUsernamePasswordSecret(),
+			"ibm_secrets_manager_iam_credentials_secret":   secretsmanager.ResourceIBMSecretsManagerIamCredentialsSecret(),
+			"ibm_secrets_manager_imported_cert":            secretsmanager.ResourceIBMSecretsManagerImportedCert(),
+			"ibm_secrets_manager_kv_secret":                secretsmanager.ResourceIBMSecretsManagerKvSecret(),
+			"ibm_secrets_manager_secret_rotation_policy":   secretsmanager.ResourceIBMSecretsManagerSecretRotationPolicy(),
+
 			// // Added for Context Based Restrictions
 			"ibm_cbr_zone": contextbasedrestrictions.ResourceIBMCbrZone(),
 			"ibm_cbr_rule": contextbasedrestrictions.ResourceIBMCbrRule(),
@@ -1156,7 +1228,7 @@ func Provider() *schema.Provider {
 		},
 
 		ConfigureFunc: providerConfigure,
-	}
+	})
 }
 
 var globalValidatorDict validate.ValidatorDict
@@ -1250,6 +1322,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_floating_ip":                       vpc.ResourceIBMISFloatingIPValidator(),
 				"ibm_is_ike_policy":                        vpc.ResourceIBMISIKEValidator(),
 				"ibm_is_image":                             vpc.ResourceIBMISImageValidator(),
+				"ibm_is_image_export_job":                  vpc.ResourceIBMIsImageExportJobValidator(),
 				"ibm_is_instance_template":                 vpc.ResourceIBMISInstanceTemplateValidator(),
 				"ibm_is_instance":                          vpc.ResourceIBMISInstanceValidator(),
 				"ibm_is_instance_action":                   vpc.ResourceIBMISInstanceActionValidator(),
@@ -1270,6 +1343,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_security_group_target":             vpc.ResourceIBMISSecurityGroupTargetValidator(),
 				"ibm_is_security_group_rule":               vpc.ResourceIBMISSecurityGroupRuleValidator(),
 				"ibm_is_security_group":                    vpc.ResourceIBMISSecurityGroupValidator(),
+				"ibm_is_share":                             vpc.ResourceIBMIsShareValidator(),
+				"ibm_is_share_mount_target":                vpc.ResourceIBMIsShareMountTargetValidator(),
 				"ibm_is_snapshot":                          vpc.ResourceIBMISSnapshotValidator(),
 				"ibm_is_ssh_key":                           vpc.ResourceIBMISSHKeyValidator(),
 				"ibm_is_subnet":                            vpc.ResourceIBMISSubnetValidator(),
@@ -1316,6 +1391,15 @@ func Validator() validate.ValidatorDict {
 				"ibm_cbr_rule":                             contextbasedrestrictions.ResourceIBMCbrRuleValidator(),
 				"ibm_satellite_host":                       satellite.ResourceIBMSatelliteHostValidator(),
 
+				// Added for Secrets Manager
+				"ibm_secrets_manager_secret_group":             secretsmanager.ResourceIBMSecretsManagerSecretGroupValidator(),
+				"ibm_secrets_manager_arbitrary_secret":         secretsmanager.ResourceIBMSecretsManagerArbitrarySecretValidator(),
+				"ibm_secrets_manager_username_password_secret": secretsmanager.ResourceIBMSecretsManagerUsernamePasswordSecretValidator(),
+				"ibm_secrets_manager_iam_credentials_secret":   secretsmanager.ResourceIBMSecretsManagerIamCredentialsSecretValidator(),
+				"ibm_secrets_manager_imported_cert":            secretsmanager.ResourceIBMSecretsManagerImportedCertValidator(),
+				"ibm_secrets_manager_kv_secret":                secretsmanager.ResourceIBMSecretsManagerKvSecretValidator(),
+				"ibm_secrets_manager_secret_rotation_policy":   secretsmanager.ResourceIBMSecretsManagerSecretRotationPolicyValidator(),
+
 				// // Added for Event Notifications
 				"ibm_en_destination": eventnotification.ResourceIBMEnDestinationValidator(),
 
@@ -1355,6 +1439,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_vpc_alb_create":              kubernetes.ResourceIBMContainerVpcAlbCreateNewValidator(),
 				"ibm_container_storage_attachment":          kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachmentValidator(),
 				"ibm_container_worker_pool_zone_attachment": kubernetes.ResourceIBMContainerWorkerPoolZoneAttachmentValidator(),
+				"ibm_container_worker_pool_autoscaling":     kubernetes.ResourceIBMContainerWorkerPoolAutoscalingValidator(),
 				"ibm_container_bind_service":                kubernetes.ResourceIBMContainerBindServiceValidator(),
 				"ibm_container_alb_cert":                    kubernetes.ResourceIBMContainerALBCertValidator(),
 				"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeatureValidator(),
@@ -1515,6 +1600,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		file = f.(string)
 	}
 
+	var defaultTags, ignoreTagKeys, ignoreTagKeyPrefixes []string
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		defaultTagsMap := v.([]interface{})[0].(map[string]interface{})
+		defaultTags = flex.ExpandStringList(defaultTagsMap["tags"].(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ignoreTagsMap := v.([]interface{})[0].(map[string]interface{})
+		ignoreTagKeys = flex.ExpandStringList(ignoreTagsMap["keys"].(*schema.Set).List())
+		ignoreTagKeyPrefixes = flex.ExpandStringList(ignoreTagsMap["key_prefixes"].(*schema.Set).List())
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
@@ -1550,6 +1646,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		DefaultTags:          defaultTags,
+		IgnoreTagKeys:        ignoreTagKeys,
+		IgnoreTagKeyPrefixes: ignoreTagKeyPrefixes,
 	}
 
 	return config.ClientSession()
@@ -290,7 +290,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 		log.Printf(
 			"Error on get of ibm cis tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
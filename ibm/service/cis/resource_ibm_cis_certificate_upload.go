@@ -38,7 +38,7 @@ const (
 )
 
 func ResourceIBMCISCertificateUpload() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create:   resourceCISCertificateUploadCreate,
 		Read:     resourceCISCertificateUploadRead,
 		Update:   resourceCISCertificateUploadUpdate,
@@ -129,7 +129,7 @@ func ResourceIBMCISCertificateUpload() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, flex.UpgradeCisZoneResourceState)
 }
 
 func ResourceIBMCISCertificateUploadValidator() *validate.ResourceValidator {
@@ -50,7 +50,7 @@ const (
 )
 
 func ResourceIBMCISDnsRecord() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create:   ResourceIBMCISDnsRecordCreate,
 		Read:     ResourceIBMCISDnsRecordRead,
 		Update:   ResourceIBMCISDnsRecordUpdate,
@@ -139,7 +139,7 @@ func ResourceIBMCISDnsRecord() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, flex.UpgradeCisZoneResourceState)
 }
 func ResourceIBMCISDnsRecordValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
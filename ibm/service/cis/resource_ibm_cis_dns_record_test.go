@@ -4,12 +4,15 @@
 package cis_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -378,3 +381,59 @@ func testAccCheckIBMCisDNSRecordConfigProxied(resourceID string, CisDomainStatic
 	  }
 `, resourceID)
 }
+
+func TestIBMCISDnsRecordStateUpgradeV0(t *testing.T) {
+	r := cis.ResourceIBMCISDnsRecord()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("bad schema version %d with %d state upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	crn := "crn:v1:bluemix:public:internet-svcs:global:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3::"
+	cases := []struct {
+		rawState string
+		id       string
+		domainID string
+	}{
+		{
+			rawState: `{"id": "7d6a4fe9b28e5d8e5e6d2f9b1d0e2a31:1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e:` + crn + `", "domain_id": "1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e", "cis_id": "` + crn + `", "name": "www"}`,
+			id:       "7d6a4fe9b28e5d8e5e6d2f9b1d0e2a31:1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e:" + crn,
+			domainID: "1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e",
+		},
+		{
+			rawState: `{"id": "7d6a4fe9b28e5d8e5e6d2f9b1d0e2a31:1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e:` + crn + `", "domain_id": "1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e:` + crn + `", "cis_id": "` + crn + `", "name": "www"}`,
+			id:       "7d6a4fe9b28e5d8e5e6d2f9b1d0e2a31:1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e:" + crn,
+			domainID: "1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e",
+		},
+		{
+			rawState: `{"id": "7d6a4fe9b28e5d8e5e6d2f9b1d0e2a31", "domain_id": "1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e:` + crn + `", "cis_id": "` + crn + `", "name": "www"}`,
+			id:       "7d6a4fe9b28e5d8e5e6d2f9b1d0e2a31:1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e:" + crn,
+			domainID: "1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e",
+		},
+	}
+	for _, c := range cases {
+		var rawState map[string]interface{}
+		if err := json.Unmarshal([]byte(c.rawState), &rawState); err != nil {
+			t.Fatalf("Error decoding the raw state: %s", err)
+		}
+		actual, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+		if err != nil {
+			t.Fatalf("Error upgrading the state %s: %s", c.rawState, err)
+		}
+		if actual["id"] != c.id {
+			t.Fatalf("bad id %q, expected %q", actual["id"], c.id)
+		}
+		if actual["domain_id"] != c.domainID {
+			t.Fatalf("bad domain_id %q, expected %q", actual["domain_id"], c.domainID)
+		}
+		if actual["name"] != "www" {
+			t.Fatalf("unrelated attributes must be kept, got name %q", actual["name"])
+		}
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"id": "7d6a4fe9b28e5d8e5e6d2f9b1d0e2a31", "domain_id": "1f4eb8a16b5d9c7a4d8b1e6f2a3c5d7e"}`), &rawState); err != nil {
+		t.Fatalf("Error decoding the raw state: %s", err)
+	}
+	if _, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil); err == nil {
+		t.Fatalf("expected an error when cis_id is missing from the state")
+	}
+}
@@ -23,7 +23,7 @@ const (
 )
 
 func ResourceIBMCISFilter() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create:   ResourceIBMCISFilterCreate,
 		Read:     ResourceIBMCISFilterRead,
 		Update:   ResourceIBMCISFilterUpdate,
@@ -65,7 +65,7 @@ func ResourceIBMCISFilter() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator(ibmCISFilters, cisFilterDescription),
 			},
 		},
-	}
+	}, flex.UpgradeCisZoneResourceState)
 }
 func ResourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
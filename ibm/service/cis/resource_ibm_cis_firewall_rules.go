@@ -27,7 +27,7 @@ const (
 )
 
 func ResourceIBMCISFirewallrules() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		CreateContext: ResourceIBMCISFirewallrulesCreate,
 		ReadContext:   ResourceIBMCISFirewallrulesRead,
 		UpdateContext: ResourceIBMCISFirewallrulesUpdate,
@@ -78,7 +78,7 @@ func ResourceIBMCISFirewallrules() *schema.Resource {
 				Description: "Firewallrules Paused",
 			},
 		},
-	}
+	}, flex.UpgradeCisZoneResourceState)
 }
 
 func ResourceIBMCISFirewallrulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -43,7 +43,7 @@ const (
 )
 
 func ResourceIBMCISPageRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create:   resourceCISPageRuleCreate,
 		Read:     resourceCISPageRuleRead,
 		Update:   resourceCISPageRuleUpdate,
@@ -163,7 +163,7 @@ func ResourceIBMCISPageRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.UpgradeCisZoneResourceState)
 }
 
 func ResourceIBMCISPageRuleValidator() *validate.ResourceValidator {
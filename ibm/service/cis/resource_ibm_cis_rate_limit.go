@@ -25,7 +25,7 @@ const (
 )
 
 func ResourceIBMCISRateLimit() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create:   ResourceIBMCISRateLimitCreate,
 		Read:     ResourceIBMCISRateLimitRead,
 		Update:   ResourceIBMCISRateLimitUpdate,
@@ -253,7 +253,7 @@ func ResourceIBMCISRateLimit() *schema.Resource {
 				Description: "Rate Limit rule Id",
 			},
 		},
-	}
+	}, flex.UpgradeCisZoneResourceState)
 }
 func ResourceIBMCISRateLimitValidator() *validate.ResourceValidator {
 
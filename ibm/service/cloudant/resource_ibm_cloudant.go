@@ -127,7 +127,7 @@ func ResourceIBMCloudant() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// cbrRuleResourceAttributeNames are the attributes a resource of a rule is selected with
+var cbrRuleResourceAttributeNames = []string{"accountId", "region", "resource", "resourceGroupId", "resourceType", "serviceGroupId", "serviceInstance", "serviceName", "serviceType"}
+
+// cbrRuleResourceAttributeOperators are the operators of the resource attributes, stringEquals by default
+var cbrRuleResourceAttributeOperators = []string{"stringEquals", "stringMatch"}
+
+// cbrRuleContextEndpointTypes are the values of the endpointType attribute of a context
+var cbrRuleContextEndpointTypes = []string{"direct", "private", "public"}
+
+// cbrRuleLockoutServiceNames are the services Terraform and IAM rely on to authenticate and to manage the rules, a
+// rule enforced on them can deny the requests of the provider itself
+var cbrRuleLockoutServiceNames = []string{"context-based-restrictions", "iam-access-management", "iam-groups", "iam-identity"}
+
+// resourceIBMCbrRuleValidateCustomizeDiff validates the resources, contexts and operations of a rule before the
+// rule is applied, against the services which support context-based restrictions and their API types
+func resourceIBMCbrRuleValidateCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	resources := diff.Get("resources").([]interface{})
+	contexts := diff.Get("contexts").([]interface{})
+	apiTypes := []string{}
+	for _, apiType := range diff.Get("operations.0.api_types").([]interface{}) {
+		if apiType != nil && apiType.(map[string]interface{})["api_type_id"].(string) != "" {
+			apiTypes = append(apiTypes, apiType.(map[string]interface{})["api_type_id"].(string))
+		}
+	}
+
+	err := ValidateCbrRuleShape(resources, contexts)
+	if err != nil {
+		return err
+	}
+
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	err = validateCbrRuleLockout(diff, session)
+	if err != nil {
+		return err
+	}
+	if !diff.NewValueKnown("resources") {
+		return nil
+	}
+	contextBasedRestrictionsClient, err := session.ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+	return validateCbrRuleServiceReferences(context, contextBasedRestrictionsClient, resources, apiTypes)
+}
+
+// validateCbrRuleLockout rejects an enabled rule which can deny the requests of Terraform and IAM, unless the lockout
+// is acknowledged. The check is advisory, it is skipped when the account of the provider can not be determined.
+func validateCbrRuleLockout(diff *schema.ResourceDiff, session conns.ClientSession) error {
+	if diff.Get("enforcement_mode").(string) != contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst || diff.Get("acknowledge_lockout").(bool) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChanges("resources", "contexts", "enforcement_mode", "acknowledge_lockout") {
+		return nil
+	}
+	if !diff.NewValueKnown("resources") || !diff.NewValueKnown("contexts") {
+		return nil
+	}
+	userDetails, err := session.BluemixUserDetails()
+	if err != nil {
+		log.Printf("[WARN] Skipping the lockout check of the rule, the account of the provider could not be determined: %s", err)
+		return nil
+	}
+	bluemixSession, err := session.BluemixSession()
+	if err != nil {
+		log.Printf("[WARN] Skipping the lockout check of the rule, the visibility of the provider could not be determined: %s", err)
+		return nil
+	}
+	warning := CbrRuleLockoutWarning(diff.Get("resources").([]interface{}), diff.Get("contexts").([]interface{}), userDetails.UserAccount, bluemixSession.Config.Visibility)
+	if warning == "" {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] %s Set acknowledge_lockout to true to apply the rule in the enabled enforcement mode anyway.", warning)
+}
+
+// ValidateCbrRuleShape checks the names and operators of the resource attributes and the names and values of the
+// context attributes of a rule, the values which are not known yet are skipped
+func ValidateCbrRuleShape(resources []interface{}, contexts []interface{}) error {
+	for i, resource := range resources {
+		if resource == nil {
+			continue
+		}
+		attributes := map[string]bool{}
+		for _, attribute := range resource.(map[string]interface{})["attributes"].([]interface{}) {
+			if attribute == nil {
+				continue
+			}
+			a := attribute.(map[string]interface{})
+			name, operator := a["name"].(string), a["operator"].(string)
+			if name == "" {
+				continue
+			}
+			if !flex.StringContains(cbrRuleResourceAttributeNames, name) {
+				return fmt.Errorf("[ERROR] The attribute %s of the resource %d of the rule is not supported, supported attributes are %s", name, i, strings.Join(cbrRuleResourceAttributeNames, ", "))
+			}
+			if attributes[name] {
+				return fmt.Errorf("[ERROR] The attribute %s is set more than once in the resource %d of the rule", name, i)
+			}
+			attributes[name] = true
+			if operator != "" && !flex.StringContains(cbrRuleResourceAttributeOperators, operator) {
+				return fmt.Errorf("[ERROR] The operator %s of the attribute %s of the resource %d of the rule is not supported, supported operators are %s", operator, name, i, strings.Join(cbrRuleResourceAttributeOperators, ", "))
+			}
+		}
+		if len(attributes) > 0 && attributes["serviceName"] == attributes["serviceGroupId"] {
+			return fmt.Errorf("[ERROR] The resource %d of the rule must be selected with either the serviceName or the serviceGroupId attribute", i)
+		}
+	}
+
+	for i, ruleContext := range contexts {
+		if ruleContext == nil {
+			continue
+		}
+		for _, attribute := range ruleContext.(map[string]interface{})["attributes"].([]interface{}) {
+			if attribute == nil {
+				continue
+			}
+			a := attribute.(map[string]interface{})
+			name, value := a["name"].(string), a["value"].(string)
+			switch name {
+			case "", "networkZoneId":
+			case "endpointType":
+				if value != "" && !flex.StringContains(cbrRuleContextEndpointTypes, value) {
+					return fmt.Errorf("[ERROR] The endpoint type %s of the context %d of the rule is not supported, supported endpoint types are %s", value, i, strings.Join(cbrRuleContextEndpointTypes, ", "))
+				}
+			default:
+				return fmt.Errorf("[ERROR] The attribute %s of the context %d of the rule is not supported, supported attributes are networkZoneId, endpointType", name, i)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCbrRuleServiceReferences checks that the services of the rule resources support context-based
+// restrictions in their region, and that they support the API types of the rule
+func validateCbrRuleServiceReferences(context context.Context, contextBasedRestrictionsClient *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, resources []interface{}, apiTypes []string) error {
+	targetList, response, err := contextBasedRestrictionsClient.ListAvailableServicerefTargetsWithContext(context, &contextbasedrestrictionsv1.ListAvailableServicerefTargetsOptions{})
+	if err != nil || targetList == nil {
+		return fmt.Errorf("[ERROR] ListAvailableServicerefTargetsWithContext failed %s\n%s", err, response)
+	}
+	targets := map[string]contextbasedrestrictionsv1.ServiceRefTarget{}
+	for _, target := range targetList.Targets {
+		if target.ServiceName != nil {
+			targets[*target.ServiceName] = target
+		}
+	}
+
+	serviceAPITypes := map[string][]string{}
+	for i, resource := range resources {
+		if resource == nil {
+			continue
+		}
+		serviceName, region, regionOperator := "", "", ""
+		for _, attribute := range resource.(map[string]interface{})["attributes"].([]interface{}) {
+			if attribute == nil {
+				continue
+			}
+			a := attribute.(map[string]interface{})
+			switch a["name"].(string) {
+			case "serviceName":
+				serviceName = a["value"].(string)
+			case "region":
+				region, regionOperator = a["value"].(string), a["operator"].(string)
+			}
+		}
+		if serviceName == "" {
+			continue
+		}
+
+		target, ok := targets[serviceName]
+		if !ok {
+			return fmt.Errorf("[ERROR] The service %s of the resource %d of the rule does not support context-based restrictions", serviceName, i)
+		}
+		if region != "" && regionOperator != "stringMatch" && len(target.Locations) > 0 {
+			locations := make([]string, 0, len(target.Locations))
+			for _, location := range target.Locations {
+				locations = append(locations, *location.Name)
+			}
+			if !flex.StringContains(locations, region) {
+				return fmt.Errorf("[ERROR] The service %s of the resource %d of the rule does not support context-based restrictions in the region %s, supported regions are %s", serviceName, i, region, strings.Join(locations, ", "))
+			}
+		}
+
+		if len(apiTypes) == 0 {
+			continue
+		}
+		if _, ok := serviceAPITypes[serviceName]; !ok {
+			operations, response, err := contextBasedRestrictionsClient.ListAvailableServiceOperationsWithContext(context, &contextbasedrestrictionsv1.ListAvailableServiceOperationsOptions{
+				ServiceName: core.StringPtr(serviceName),
+			})
+			if err != nil || operations == nil {
+				return fmt.Errorf("[ERROR] ListAvailableServiceOperationsWithContext failed %s\n%s", err, response)
+			}
+			for _, apiType := range operations.APITypes {
+				serviceAPITypes[serviceName] = append(serviceAPITypes[serviceName], *apiType.APITypeID)
+			}
+		}
+		for _, apiType := range apiTypes {
+			if !flex.StringContains(serviceAPITypes[serviceName], apiType) {
+				return fmt.Errorf("[ERROR] The API type %s of the rule is not supported by the service %s, supported API types are %s", apiType, serviceName, strings.Join(serviceAPITypes[serviceName], ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// CbrRuleLockoutWarning returns a warning when a rule restricts the services Terraform and IAM rely on in the account
+// of the provider, and none of the rule contexts allows the endpoints the provider uses from any network
+func CbrRuleLockoutWarning(resources []interface{}, contexts []interface{}, accountID string, visibility string) string {
+	services := []string{}
+	for _, resource := range resources {
+		if resource == nil {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attribute := range resource.(map[string]interface{})["attributes"].([]interface{}) {
+			if attribute != nil {
+				a := attribute.(map[string]interface{})
+				attributes[a["name"].(string)] = a["value"].(string)
+			}
+		}
+		if account, ok := attributes["accountId"]; ok && account != accountID {
+			continue
+		}
+		if attributes["serviceGroupId"] == "IAM" {
+			services = append(services, "IAM")
+		} else if flex.StringContains(cbrRuleLockoutServiceNames, attributes["serviceName"]) {
+			services = append(services, attributes["serviceName"])
+		}
+	}
+	if len(services) == 0 {
+		return ""
+	}
+
+	endpointTypes := []string{"public"}
+	switch visibility {
+	case "private":
+		endpointTypes = []string{"private"}
+	case "public-and-private":
+		endpointTypes = []string{"public", "private"}
+	}
+	networkZones := []string{}
+	for _, ruleContext := range contexts {
+		if ruleContext == nil {
+			continue
+		}
+		contextEndpointType, contextNetworkZone := "", ""
+		for _, attribute := range ruleContext.(map[string]interface{})["attributes"].([]interface{}) {
+			if attribute == nil {
+				continue
+			}
+			a := attribute.(map[string]interface{})
+			switch a["name"].(string) {
+			case "endpointType":
+				contextEndpointType = a["value"].(string)
+			case "networkZoneId":
+				contextNetworkZone = a["value"].(string)
+			}
+		}
+		if contextNetworkZone != "" {
+			networkZones = append(networkZones, contextNetworkZone)
+			continue
+		}
+		if contextEndpointType == "" || flex.StringContains(endpointTypes, contextEndpointType) {
+			return ""
+		}
+	}
+
+	sort.Strings(services)
+	return fmt.Sprintf("The rule restricts the %s services of the account %s, the requests of Terraform and IAM over %s endpoints are denied unless they originate from the network zones %s. Apply the rule with the report enforcement mode first and review its decisions with the ibm_cbr_rule_report data source.",
+		strings.Join(services, ", "), accountID, strings.Join(endpointTypes, " and "), strings.Join(networkZones, ", "))
+}
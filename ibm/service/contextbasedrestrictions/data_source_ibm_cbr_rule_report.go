@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// cbrRuleEvaluationAction is the action of the Activity Tracker events of the decisions of the rules
+const cbrRuleEvaluationAction = "context-based-restrictions.policy.eval"
+
+func DataSourceIBMCbrRuleReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"rule_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of a rule.",
+			},
+			"logging_region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The region of the Activity Tracker instance the decisions of the rule are reported to.",
+			},
+			"service_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The service key of the Activity Tracker instance.",
+			},
+			"hours": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 720),
+				Description:  "The number of hours of decisions to summarize, until now.",
+			},
+			"max_events": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10000,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 100000),
+				Description:  "The maximum number of events to summarize.",
+			},
+			"enforcement_mode": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rule enforcement mode.",
+			},
+			"permit_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests the rule permitted.",
+			},
+			"deny_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests the rule denied, or would have denied in report mode.",
+			},
+			"ready_to_enforce": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rule is in report mode and denied no request, so that it can be enabled without denying the requests of the period.",
+			},
+			"denied_requests": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The requests the rule denied, grouped by initiator, source address and target, the most frequent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initiator_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the initiator of the requests.",
+						},
+						"source_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address the requests originated from.",
+						},
+						"target_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the target of the requests.",
+						},
+						"count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of requests denied.",
+						},
+						"last_seen": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the last request denied.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrRuleReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleID := d.Get("rule_id").(string)
+	getRuleOptions := &contextbasedrestrictionsv1.GetRuleOptions{}
+	getRuleOptions.SetRuleID(ruleID)
+	rule, response, err := contextBasedRestrictionsClient.GetRuleWithContext(context, getRuleOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+	}
+	var diags diag.Diagnostics
+	if rule.EnforcementMode != nil && *rule.EnforcementMode != contextbasedrestrictionsv1.RuleEnforcementModeReportConst {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The rule %s is not in the report enforcement mode", ruleID),
+			Detail:   fmt.Sprintf("The rule is in the %s enforcement mode, its decisions are only reported without being enforced in the report enforcement mode", *rule.EnforcementMode),
+		})
+	}
+
+	loggingURL, err := meta.(conns.ClientSession).ResolveEndpoint("logging", d.Get("logging_region").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	loggingService, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           loggingURL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The export API is called with the HTTP client of the provider, with its transport and retries
+	loggingService.Client = contextBasedRestrictionsClient.Service.Client
+
+	to := time.Now()
+	from := to.Add(-time.Duration(d.Get("hours").(int)) * time.Hour)
+	events, err := exportCbrRuleEvents(context, loggingService, d.Get("service_key").(string), ruleID, from, to, d.Get("max_events").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	summary := SummarizeCbrRuleEvents(events)
+
+	d.SetId(ruleID)
+	if err = d.Set("enforcement_mode", rule.EnforcementMode); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enforcement_mode: %s", err))
+	}
+	d.Set("permit_count", summary.PermitCount)
+	d.Set("deny_count", summary.DenyCount)
+	d.Set("ready_to_enforce", rule.EnforcementMode != nil && *rule.EnforcementMode == contextbasedrestrictionsv1.RuleEnforcementModeReportConst && summary.DenyCount == 0)
+	if err = d.Set("denied_requests", summary.DeniedRequests); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting denied_requests: %s", err))
+	}
+
+	return diags
+}
+
+// exportCbrRuleEvents exports the events of the Activity Tracker instance which mention the rule in the period, with
+// the export API of the instance
+func exportCbrRuleEvents(context context.Context, service *core.BaseService, serviceKey, ruleID string, from, to time.Time, maxEvents int) ([]map[string]interface{}, error) {
+	events := []map[string]interface{}{}
+	paginationID := ""
+	for len(events) < maxEvents {
+		builder := core.NewRequestBuilder(core.GET)
+		builder = builder.WithContext(context)
+		_, err := builder.ResolveRequestURL(service.Options.URL, `/v2/export`, nil)
+		if err != nil {
+			return nil, err
+		}
+		// The service key is the user of the basic authentication, without a password
+		builder.AddHeader("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(serviceKey+":")))
+		builder.AddHeader("Accept", "application/json")
+		builder.AddQuery("from", strconv.FormatInt(from.Unix(), 10))
+		builder.AddQuery("to", strconv.FormatInt(to.Unix(), 10))
+		builder.AddQuery("query", ruleID)
+		builder.AddQuery("size", strconv.Itoa(maxEvents-len(events)))
+		if paginationID != "" {
+			builder.AddQuery("pagination_id", paginationID)
+		}
+		request, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Lines        []map[string]interface{} `json:"lines"`
+			PaginationID string                   `json:"pagination_id"`
+		}
+		response, err := service.Request(request, &page)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error exporting the events of the rule %s: %s\n%s", ruleID, err, response)
+		}
+		for _, line := range page.Lines {
+			// The event is either the line itself or encoded in the _line field of the line
+			if raw, ok := line["_line"].(string); ok {
+				event := map[string]interface{}{}
+				if json.Unmarshal([]byte(raw), &event) == nil {
+					line = event
+				}
+			}
+			events = append(events, line)
+		}
+		paginationID = page.PaginationID
+		if paginationID == "" || len(page.Lines) == 0 {
+			break
+		}
+	}
+	return events, nil
+}
+
+// CbrRuleReportSummary is the summary of the decisions of a rule
+type CbrRuleReportSummary struct {
+	PermitCount    int
+	DenyCount      int
+	DeniedRequests []map[string]interface{}
+}
+
+// SummarizeCbrRuleEvents counts the decisions of the rule evaluation events and groups the denied requests by
+// initiator, source address and target
+func SummarizeCbrRuleEvents(events []map[string]interface{}) CbrRuleReportSummary {
+	summary := CbrRuleReportSummary{DeniedRequests: []map[string]interface{}{}}
+	denied := map[string]map[string]interface{}{}
+	for _, event := range events {
+		if cbrEventField(event, "action") != cbrRuleEvaluationAction {
+			continue
+		}
+		switch strings.ToLower(cbrEventField(event, "responseData.decision")) {
+		case "permit":
+			summary.PermitCount++
+		case "deny":
+			summary.DenyCount++
+			initiatorID := cbrEventField(event, "initiator.id")
+			sourceAddress := cbrEventField(event, "initiator.host.address")
+			targetCRN := cbrEventField(event, "target.id")
+			eventTime := cbrEventField(event, "eventTime")
+			key := strings.Join([]string{initiatorID, sourceAddress, targetCRN}, "|")
+			request, ok := denied[key]
+			if !ok {
+				request = map[string]interface{}{
+					"initiator_id":   initiatorID,
+					"source_address": sourceAddress,
+					"target_crn":     targetCRN,
+					"count":          0,
+					"last_seen":      eventTime,
+				}
+				denied[key] = request
+				summary.DeniedRequests = append(summary.DeniedRequests, request)
+			}
+			request["count"] = request["count"].(int) + 1
+			if eventTime > request["last_seen"].(string) {
+				request["last_seen"] = eventTime
+			}
+		}
+	}
+	sort.SliceStable(summary.DeniedRequests, func(i, j int) bool {
+		return summary.DeniedRequests[i]["count"].(int) > summary.DeniedRequests[j]["count"].(int)
+	})
+	return summary
+}
+
+// cbrEventField returns the string value of a field of an event, with a path of the form a.b.c
+func cbrEventField(event map[string]interface{}, path string) string {
+	var value interface{} = event
+	for _, name := range strings.Split(path, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		value = fields[name]
+	}
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestExportCbrRuleEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "key" || password != "" {
+			t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+		if r.URL.Path != "/v2/export" || r.URL.Query().Get("query") != "rule" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("pagination_id") == "" {
+			fmt.Fprint(w, `{"lines": [{"_line": "{\"action\": \"a\"}"}], "pagination_id": "next"}`)
+			return
+		}
+		fmt.Fprint(w, `{"lines": [{"action": "b"}]}`)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	events, err := exportCbrRuleEvents(context.Background(), service, "key", "rule", time.Now().Add(-time.Hour), time.Now(), 10)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(events) != 2 || events[0]["action"] != "a" || events[1]["action"] != "b" {
+		t.Errorf("unexpected events %v", events)
+	}
+}
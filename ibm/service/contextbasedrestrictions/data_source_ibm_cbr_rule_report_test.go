@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/contextbasedrestrictions"
+)
+
+func TestAccIBMCbrRuleReportDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCbrRuleReport(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleReportDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_report.cbr_rule_report", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "enforcement_mode", "report"),
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_report.cbr_rule_report", "permit_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_report.cbr_rule_report", "deny_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_report.cbr_rule_report", "ready_to_enforce"),
+				),
+			},
+		},
+	})
+}
+
+func TestIBMCbrRuleReportSummary(t *testing.T) {
+	event := func(decision, initiator, address, eventTime string) map[string]interface{} {
+		return map[string]interface{}{
+			"action":       "context-based-restrictions.policy.eval",
+			"eventTime":    eventTime,
+			"initiator":    map[string]interface{}{"id": initiator, "host": map[string]interface{}{"address": address}},
+			"target":       map[string]interface{}{"id": "crn:v1:bluemix:public:kms:us-south:a/12ab34cd56ef78ab90cd12ef34ab56cd::"},
+			"responseData": map[string]interface{}{"decision": decision, "isEnforced": false},
+		}
+	}
+	events := []map[string]interface{}{
+		event("Permit", "IBMid-1", "169.23.56.234", "2022-07-01T10:00:00.00+0000"),
+		event("Deny", "IBMid-2", "10.0.0.1", "2022-07-01T10:01:00.00+0000"),
+		event("Deny", "IBMid-3", "10.0.0.2", "2022-07-01T10:02:00.00+0000"),
+		event("Deny", "IBMid-3", "10.0.0.2", "2022-07-01T10:04:00.00+0000"),
+		event("Deny", "IBMid-3", "10.0.0.2", "2022-07-01T10:03:00.00+0000"),
+		{"action": "kms.secrets.read", "responseData": map[string]interface{}{"decision": "Deny"}},
+	}
+
+	summary := contextbasedrestrictions.SummarizeCbrRuleEvents(events)
+	if summary.PermitCount != 1 || summary.DenyCount != 4 {
+		t.Fatalf("SummarizeCbrRuleEvents counted %d permits and %d denies, expected 1 and 4", summary.PermitCount, summary.DenyCount)
+	}
+	if len(summary.DeniedRequests) != 2 {
+		t.Fatalf("SummarizeCbrRuleEvents returned %d denied requests, expected 2", len(summary.DeniedRequests))
+	}
+	denied := summary.DeniedRequests[0]
+	if denied["initiator_id"] != "IBMid-3" || denied["source_address"] != "10.0.0.2" || denied["count"] != 3 || denied["last_seen"] != "2022-07-01T10:04:00.00+0000" {
+		t.Errorf("SummarizeCbrRuleEvents returned %v as the most frequent denied request", denied)
+	}
+}
+
+func testAccCheckIBMCbrRuleReportDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description = "test rule report"
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = "559052eb8f43302824e7ae490c0281eb"
+				}
+			}
+			resources {
+				attributes {
+					name = "accountId"
+					value = "12ab34cd56ef78ab90cd12ef34ab56cd"
+				}
+				attributes {
+					name = "serviceName"
+					value = "user-management"
+				}
+			}
+			enforcement_mode = "report"
+		}
+
+		data "ibm_cbr_rule_report" "cbr_rule_report" {
+			rule_id        = ibm_cbr_rule.cbr_rule.id
+			logging_region = "%s"
+			service_key    = "%s"
+			hours          = 1
+		}
+	`, acc.CbrAtRegion, acc.CbrAtServiceKey)
+}
@@ -24,6 +24,7 @@ func ResourceIBMCbrRule() *schema.Resource {
 		ReadContext:   resourceIBMCbrRuleRead,
 		UpdateContext: resourceIBMCbrRuleUpdate,
 		DeleteContext: resourceIBMCbrRuleDelete,
+		CustomizeDiff: resourceIBMCbrRuleValidateCustomizeDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
@@ -148,6 +149,12 @@ func ResourceIBMCbrRule() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "enforcement_mode"),
 				Description:  "The rule enforcement mode: * `enabled` - The restrictions are enforced and reported. This is the default. * `disabled` - The restrictions are disabled. Nothing is enforced or reported. * `report` - The restrictions are evaluated and reported, but not enforced.",
 			},
+			"acknowledge_lockout": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to apply an enabled rule which can deny the requests of Terraform and IAM.",
+			},
 			"x_correlation_id": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
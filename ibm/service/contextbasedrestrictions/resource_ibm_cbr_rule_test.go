@@ -5,6 +5,7 @@ package contextbasedrestrictions_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -13,6 +14,7 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/contextbasedrestrictions"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
 )
 
@@ -70,6 +72,78 @@ func TestAccIBMCbrRuleAllArgs(t *testing.T) {
 	})
 }
 
+func TestIBMCbrRuleValidateShape(t *testing.T) {
+	attributes := func(attributes ...string) []interface{} {
+		list := []interface{}{}
+		for i := 0; i+2 < len(attributes); i += 3 {
+			list = append(list, map[string]interface{}{"name": attributes[i], "value": attributes[i+1], "operator": attributes[i+2]})
+		}
+		return []interface{}{map[string]interface{}{"attributes": list}}
+	}
+	contexts := attributes("networkZoneId", "559052eb8f43302824e7ae490c0281eb", "", "endpointType", "private", "")
+
+	testCases := []struct {
+		resources []interface{}
+		contexts  []interface{}
+		err       string
+	}{
+		{attributes("accountId", "12ab34cd56ef78ab90cd12ef34ab56cd", "", "serviceName", "user-management", ""), contexts, ""},
+		{attributes("serviceGroupId", "IAM", "", "region", "us-*", "stringMatch"), contexts, ""},
+		{attributes("serviceName", "kms", "", "zone", "dal10", ""), contexts, "attribute zone"},
+		{attributes("serviceName", "kms", "", "serviceName", "iam-groups", ""), contexts, "more than once"},
+		{attributes("serviceName", "kms", "", "region", "us-south", "stringContains"), contexts, "operator stringContains"},
+		{attributes("accountId", "12ab34cd56ef78ab90cd12ef34ab56cd", ""), contexts, "either the serviceName or the serviceGroupId"},
+		{attributes("serviceName", "kms", "", "serviceGroupId", "IAM", ""), contexts, "either the serviceName or the serviceGroupId"},
+		{attributes("serviceName", "kms", ""), attributes("endpointType", "internal", ""), "endpoint type internal"},
+		{attributes("serviceName", "kms", ""), attributes("ipAddress", "169.23.56.234", ""), "attribute ipAddress"},
+	}
+	for _, testCase := range testCases {
+		err := contextbasedrestrictions.ValidateCbrRuleShape(testCase.resources, testCase.contexts)
+		if testCase.err == "" && err != nil {
+			t.Errorf("ValidateCbrRuleShape(%v) returned %s", testCase.resources, err)
+		}
+		if testCase.err != "" && (err == nil || !strings.Contains(err.Error(), testCase.err)) {
+			t.Errorf("ValidateCbrRuleShape(%v) returned %v, expected %s", testCase.resources, err, testCase.err)
+		}
+	}
+}
+
+func TestIBMCbrRuleLockoutWarning(t *testing.T) {
+	account := "12ab34cd56ef78ab90cd12ef34ab56cd"
+	ruleResource := func(name, value string) []interface{} {
+		return []interface{}{map[string]interface{}{"attributes": []interface{}{
+			map[string]interface{}{"name": "accountId", "value": account, "operator": ""},
+			map[string]interface{}{"name": name, "value": value, "operator": ""},
+		}}}
+	}
+	ruleContext := func(name, value string) []interface{} {
+		return []interface{}{map[string]interface{}{"attributes": []interface{}{
+			map[string]interface{}{"name": name, "value": value, "operator": ""},
+		}}}
+	}
+
+	testCases := []struct {
+		resources  []interface{}
+		contexts   []interface{}
+		accountID  string
+		visibility string
+		warning    bool
+	}{
+		{ruleResource("serviceGroupId", "IAM"), ruleContext("networkZoneId", "559052eb8f43302824e7ae490c0281eb"), account, "public", true},
+		{ruleResource("serviceName", "iam-identity"), ruleContext("endpointType", "private"), account, "public", true},
+		{ruleResource("serviceName", "iam-identity"), ruleContext("endpointType", "private"), account, "private", false},
+		{ruleResource("serviceName", "iam-identity"), ruleContext("endpointType", "public"), account, "public-and-private", false},
+		{ruleResource("serviceName", "user-management"), ruleContext("networkZoneId", "559052eb8f43302824e7ae490c0281eb"), account, "public", false},
+		{ruleResource("serviceGroupId", "IAM"), ruleContext("networkZoneId", "559052eb8f43302824e7ae490c0281eb"), "ab34cd56ef78ab90cd12ef34ab56cd12", "public", false},
+	}
+	for i, testCase := range testCases {
+		warning := contextbasedrestrictions.CbrRuleLockoutWarning(testCase.resources, testCase.contexts, testCase.accountID, testCase.visibility)
+		if testCase.warning != (warning != "") {
+			t.Errorf("CbrRuleLockoutWarning of the test case %d returned %q", i, warning)
+		}
+	}
+}
+
 func testAccCheckIBMCbrRuleConfigBasic() string {
 	return fmt.Sprintf(`
 
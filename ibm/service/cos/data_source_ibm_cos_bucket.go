@@ -209,6 +209,11 @@ func DataSourceIBMCosBucket() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
 					},
 				},
 			},
@@ -447,7 +452,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(rsConClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" {
@@ -460,7 +465,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(rsConClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
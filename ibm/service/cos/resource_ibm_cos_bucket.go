@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -60,7 +61,7 @@ func resourceinstanceidDiffSuppress(_, old, new string, _ *schema.ResourceData)
 	return false
 }
 func ResourceIBMCOSBucket() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Read:          resourceIBMCOSBucketRead,
 		Create:        resourceIBMCOSBucketCreate,
 		Update:        resourceIBMCOSBucketUpdate,
@@ -289,6 +290,12 @@ func ResourceIBMCOSBucket() *schema.Resource {
 							DiffSuppressFunc: caseDiffSuppress,
 							Description:      "Specifies the storage class/archive type to which you want the object to transition. It can be Glacier or Accelerated",
 						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
 					},
 				},
 			},
@@ -436,7 +443,7 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				Description: "COS buckets need to be empty before they can be deleted. force_delete option empty the bucket and delete it.",
 			},
 		},
-	}
+	}, resourceIBMCOSBucketStateUpgradeV0)
 }
 func ResourceIBMCOSBucketValidator() *validate.ResourceValidator {
 
@@ -496,7 +503,7 @@ func ResourceIBMCOSBucketValidator() *validate.ResourceValidator {
 }
 
 func archiveRuleList(archiveList []interface{}) []*s3.LifecycleRule {
-	var archive_status, archiveStorageClass, rule_id string
+	var archive_prefix, archive_status, archiveStorageClass, rule_id string
 	var days int64
 	var rules []*s3.LifecycleRule
 
@@ -527,6 +534,11 @@ func archiveRuleList(archiveList []interface{}) []*s3.LifecycleRule {
 			archiveType := archiveStorgaeClassSet.(string)
 			archiveStorageClass = archiveType
 		}
+		//Archive Prefix
+		if archivePrefixClassSet, exist := archiveMap["prefix"]; exist {
+			prefix_check := archivePrefixClassSet.(string)
+			archive_prefix = prefix_check
+		}
 
 		archive_rule := s3.LifecycleRule{
 			ID:     aws.String(rule_id),
@@ -539,6 +551,9 @@ func archiveRuleList(archiveList []interface{}) []*s3.LifecycleRule {
 				},
 			},
 		}
+		if archive_prefix != "" {
+			archive_rule.Filter.Prefix = aws.String(archive_prefix)
+		}
 
 		rules = append(rules, &archive_rule)
 	}
@@ -753,7 +768,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(rsConClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" {
@@ -766,7 +781,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(rsConClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
@@ -1053,7 +1068,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(rsConClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1066,7 +1081,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(rsConClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
@@ -1337,7 +1352,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(rsConClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1350,7 +1365,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(rsConClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 
 	s3Sess := session.Must(session.NewSession())
@@ -1427,7 +1442,7 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(rsConClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1440,7 +1455,7 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(rsConClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 
 	s3Sess := session.Must(session.NewSession())
@@ -1536,7 +1551,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(rsConClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1549,7 +1564,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(rsConClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(rsConClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 
 	s3Sess := session.Must(session.NewSession())
@@ -1567,6 +1582,16 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 	return false, nil
 }
 
+// cosSessionConfig returns the configuration of the S3 client and of its IAM token client, sending the requests with the
+// HTTP client of the IBM Cloud session when the provider configures one, such as the client of the fixture recorder
+func cosSessionConfig(bxSession *bxsession.Session) *aws.Config {
+	conf := aws.NewConfig()
+	if bxSession != nil && bxSession.Config != nil && bxSession.Config.HTTPClient != nil {
+		conf = conf.WithHTTPClient(bxSession.Config.HTTPClient)
+	}
+	return conf
+}
+
 func SelectCosApi(apiType string, bLocation string) (string, string, string) {
 	if apiType == "crl" {
 		return fmt.Sprintf("s3.%s.cloud-object-storage.appdomain.cloud", bLocation), fmt.Sprintf("s3.private.%s.cloud-object-storage.appdomain.cloud", bLocation), fmt.Sprintf("s3.direct.%s.cloud-object-storage.appdomain.cloud", bLocation)
@@ -1615,6 +1640,42 @@ func parseBucketId(id string, info string) string {
 	return ""
 }
 
+// resourceIBMCOSBucketStateUpgradeV0 completes the ID of buckets created before the location and the endpoint type
+// were encoded in the ID, the upgraded ID is <instance crn>:bucket:<name>:meta:<api type>:<location>:<endpoint type>
+func resourceIBMCOSBucketStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	id := flex.RawStateString(rawState, "id")
+	if id == "" {
+		return rawState, nil
+	}
+	endpointType := flex.RawStateString(rawState, "endpoint_type")
+	if endpointType == "" {
+		endpointType = "public"
+	}
+
+	if parts := strings.Split(id, ":meta:"); len(parts) == 2 {
+		if len(strings.Split(parts[1], ":")) < 3 {
+			rawState["id"] = fmt.Sprintf("%s:%s", id, endpointType)
+		}
+		return rawState, nil
+	}
+
+	var apiType, bLocation string
+	if bLocation = flex.RawStateString(rawState, "cross_region_location"); bLocation != "" {
+		apiType = "crl"
+	} else if bLocation = flex.RawStateString(rawState, "region_location"); bLocation != "" {
+		apiType = "rl"
+	} else if bLocation = flex.RawStateString(rawState, "single_site_location"); bLocation != "" {
+		apiType = "ssl"
+	}
+	serviceID := flex.RawStateString(rawState, "resource_instance_id")
+	bucketName := flex.RawStateString(rawState, "bucket_name")
+	if apiType == "" || serviceID == "" || bucketName == "" {
+		return rawState, fmt.Errorf("[ERROR] Unable to upgrade the ID %s of the COS bucket, the bucket location is missing from the state", id)
+	}
+	rawState["id"] = fmt.Sprintf("%s:%s:%s:meta:%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName, apiType, bLocation, endpointType)
+	return rawState, nil
+}
+
 func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if expire, ok := diff.GetOk("expire_rule"); ok {
 		expire_list := expire.([]interface{})
@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketCorsConfigurationCreate,
+		Read:     resourceIBMCOSBucketCorsConfigurationRead,
+		Update:   resourceIBMCOSBucketCorsConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    100,
+				Description: "The cross-origin resource sharing (CORS) rules of the bucket, up to 100 rules",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers that are allowed in the Access-Control-Request-Headers header of a preflight request",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateAllowedStringValues([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}),
+							},
+							Description: "The HTTP methods the origins are allowed to execute: GET, PUT, POST, DELETE, HEAD",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The origins which are allowed to access the bucket",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers in the response which the applications are allowed to access",
+						},
+						"max_age_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The time in seconds the browser caches the response of a preflight request",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsRuleList(corsList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsList {
+		corsMap, _ := l.(map[string]interface{})
+		rule := s3.CORSRule{
+			AllowedHeaders: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_headers"].([]interface{}))),
+			AllowedMethods: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_origins"].([]interface{}))),
+			ExposeHeaders:  aws.StringSlice(flex.ExpandStringList(corsMap["expose_headers"].([]interface{}))),
+		}
+		if maxAge, exist := corsMap["max_age_seconds"]; exist && maxAge.(int) > 0 {
+			rule.MaxAgeSeconds = aws.Int64(int64(maxAge.(int)))
+		}
+		rules = append(rules, &rule)
+	}
+	return rules
+}
+
+func resourceIBMCOSBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	putBucketCorsInput := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: corsRuleList(d.Get("cors_rule").([]interface{})),
+		},
+	}
+
+	_, err = s3Client.PutBucketCors(putBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to create the CORS configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("cors_rule") {
+		bucketName := parseBucketReplId(d.Id(), "bucketName")
+		bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+		instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+		endpointType := d.Get("endpoint_type").(string)
+
+		bxSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return err
+		}
+
+		s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return err
+		}
+
+		putBucketCorsInput := &s3.PutBucketCorsInput{
+			Bucket: aws.String(bucketName),
+			CORSConfiguration: &s3.CORSConfiguration{
+				CORSRules: corsRuleList(d.Get("cors_rule").([]interface{})),
+			},
+		}
+
+		_, err = s3Client.PutBucketCors(putBucketCorsInput)
+		if err != nil {
+			return fmt.Errorf("failed to update the CORS configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	corsPtr, err := s3Client.GetBucketCors(&s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NoSuchCORSConfiguration" || aerr.Code() == "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to get the CORS configuration of COS bucket %s, %v", bucketName, err)
+	}
+
+	if corsPtr != nil {
+		d.Set("cors_rule", flex.CorsRulesGet(corsPtr.CORSRules))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Client.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete the CORS configuration of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucketCorsConfiguration_basic(t *testing.T) {
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-testacc-cors-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketCorsConfiguration(cosServiceName, bucketName, "https://www.example.com", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucketCorsConfiguration(cosServiceName, bucketName, "https://app.example.com", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.allowed_origins.0", "https://app.example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.max_age_seconds", "600"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_cors_configuration.cors",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketCorsConfiguration(cosServiceName, bucketName, origin string, maxAge int) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+
+	resource "ibm_cos_bucket_cors_configuration" "cors" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		cors_rule {
+			allowed_headers = ["*"]
+			allowed_methods = ["GET", "PUT"]
+			allowed_origins = ["%s"]
+			expose_headers  = ["ETag"]
+			max_age_seconds = %d
+		}
+	}
+	`, cosServiceName, bucketName, origin, maxAge)
+}
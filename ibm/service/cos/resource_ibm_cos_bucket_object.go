@@ -121,6 +121,12 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:     true,
 				Description: "COS buckets need to be empty before they can be deleted. force_delete option empty the bucket and delete it.",
 			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"ON", "OFF"}),
+				Description:  "The legal hold status of the COS object, ON or OFF. The bucket must have object lock enabled.",
+			},
 			"object_sql_url": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -201,6 +207,12 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
 	d.SetId(objectID)
 
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		if err := putObjectLegalHold(s3Client, bucketName, objectKey, nil, v.(string)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting the legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		}
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -279,6 +291,19 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Ignoring body of COS bucket (%s) object (%s) with Content-Type %q", bucketName, objectKey, contentType)
 	}
 
+	// The legal hold can only be read from buckets with object lock enabled, so it is only read back once it is managed
+	if _, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		legalHold, err := getObjectLegalHold(s3Client, bucketName, objectKey, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed getting the legal hold of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+		}
+		if legalHold != nil && legalHold.Status != nil {
+			d.Set("object_lock_legal_hold_status", *legalHold.Status)
+		} else {
+			d.Set("object_lock_legal_hold_status", "OFF")
+		}
+	}
+
 	d.Set("key", objectKey)
 	d.Set("version_id", out.VersionId)
 	d.Set("object_sql_url", "cos://"+bucketLocation+"/"+bucketName+"/"+objectKey)
@@ -348,6 +373,34 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 		d.SetId(objectID)
 	}
 
+	if d.HasChange("object_lock_legal_hold_status") {
+		bucketCRN := d.Get("bucket_crn").(string)
+		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+		bucketLocation := d.Get("bucket_location").(string)
+		endpointType := d.Get("endpoint_type").(string)
+
+		bxSession, err := m.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		objectKey := d.Get("key").(string)
+		status := d.Get("object_lock_legal_hold_status").(string)
+		if status == "" {
+			status = "OFF"
+		}
+		if err := putObjectLegalHold(s3Client, bucketName, objectKey, nil, status); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting the legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		}
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -414,7 +467,7 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := bxSession.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(bxSession).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(bxSession), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := bxSession.Config.IAMAccessToken
 	if iamAccessToken != "" {
@@ -427,7 +480,7 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(bxSession).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(bxSession), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	return s3.New(s3Sess, s3Conf), nil
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketObjectLockConfigurationCreate,
+		Read:     resourceIBMCOSBucketObjectLockConfigurationRead,
+		Update:   resourceIBMCOSBucketObjectLockConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketObjectLockConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"object_lock_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Enabled",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"Enabled"}),
+				Description:  "Enables object lock on the bucket, the bucket must have versioning enabled. Object lock cannot be disabled once it is enabled.",
+			},
+			"object_lock_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The object lock rule of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_retention": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "The retention applied to the new objects of the bucket which have no retention of their own",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"COMPLIANCE"}),
+										Description:  "The retention mode, COS supports COMPLIANCE",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The retention period in days",
+									},
+									"years": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The retention period in years",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func objectLockConfigurationSet(d *schema.ResourceData) (*ObjectLockConfiguration, error) {
+	config := &ObjectLockConfiguration{
+		ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+	}
+	rules := d.Get("object_lock_rule").([]interface{})
+	if len(rules) == 0 || rules[0] == nil {
+		return config, nil
+	}
+	retentions := rules[0].(map[string]interface{})["default_retention"].([]interface{})
+	if len(retentions) == 0 || retentions[0] == nil {
+		return config, nil
+	}
+	retentionMap := retentions[0].(map[string]interface{})
+	retention := &DefaultRetention{
+		Mode: aws.String(retentionMap["mode"].(string)),
+	}
+	days, years := retentionMap["days"].(int), retentionMap["years"].(int)
+	if (days > 0) == (years > 0) {
+		return nil, fmt.Errorf("[ERROR] Exactly one of days or years must be set in the default_retention of the object lock rule")
+	}
+	if days > 0 {
+		retention.Days = aws.Int64(int64(days))
+	} else {
+		retention.Years = aws.Int64(int64(years))
+	}
+	config.Rule = &ObjectLockRule{DefaultRetention: retention}
+	return config, nil
+}
+
+func flattenObjectLockRule(in *ObjectLockRule) []interface{} {
+	rules := make([]interface{}, 0, 1)
+	if in != nil && in.DefaultRetention != nil {
+		retention := make(map[string]interface{})
+		if in.DefaultRetention.Mode != nil {
+			retention["mode"] = *in.DefaultRetention.Mode
+		}
+		if in.DefaultRetention.Days != nil {
+			retention["days"] = int(*in.DefaultRetention.Days)
+		}
+		if in.DefaultRetention.Years != nil {
+			retention["years"] = int(*in.DefaultRetention.Years)
+		}
+		rules = append(rules, map[string]interface{}{
+			"default_retention": []interface{}{retention},
+		})
+	}
+	return rules
+}
+
+func resourceIBMCOSBucketObjectLockConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	config, err := objectLockConfigurationSet(d)
+	if err != nil {
+		return err
+	}
+	if err = putObjectLockConfiguration(s3Client, bucketName, config); err != nil {
+		return fmt.Errorf("failed to create the object lock configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+
+	return resourceIBMCOSBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketObjectLockConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("object_lock_enabled", "object_lock_rule") {
+		bucketName := parseBucketReplId(d.Id(), "bucketName")
+		bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+		instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+		endpointType := d.Get("endpoint_type").(string)
+
+		bxSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return err
+		}
+
+		s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return err
+		}
+
+		config, err := objectLockConfigurationSet(d)
+		if err != nil {
+			return err
+		}
+		if err = putObjectLockConfiguration(s3Client, bucketName, config); err != nil {
+			return fmt.Errorf("failed to update the object lock configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketObjectLockConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	config, err := getObjectLockConfiguration(s3Client, bucketName)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "ObjectLockConfigurationNotFoundError" || aerr.Code() == "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to get the object lock configuration of COS bucket %s, %v", bucketName, err)
+	}
+	if config == nil || config.ObjectLockEnabled == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("object_lock_enabled", *config.ObjectLockEnabled)
+	d.Set("object_lock_rule", flattenObjectLockRule(config.Rule))
+	return nil
+}
+
+func resourceIBMCOSBucketObjectLockConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	// Object lock cannot be disabled on a bucket, only the default retention is removed
+	config := &ObjectLockConfiguration{
+		ObjectLockEnabled: aws.String("Enabled"),
+	}
+	if err = putObjectLockConfiguration(s3Client, bucketName, config); err != nil {
+		return fmt.Errorf("failed to remove the default retention of the object lock configuration of COS bucket %s, %v", bucketName, err)
+	}
+	log.Printf("[WARN] Object lock stays enabled on COS bucket %s, only its default retention was removed", bucketName)
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucketObjectLockConfiguration_basic(t *testing.T) {
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-testacc-objectlock-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketObjectLockConfiguration(cosServiceName, bucketName, "days", 1, "ON"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.lock", "object_lock_enabled", "Enabled"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.lock", "object_lock_rule.0.default_retention.0.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.lock", "object_lock_rule.0.default_retention.0.days", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.object", "object_lock_legal_hold_status", "ON"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucketObjectLockConfiguration(cosServiceName, bucketName, "years", 1, "OFF"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.lock", "object_lock_rule.0.default_retention.0.years", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.object", "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketObjectLockConfiguration(cosServiceName, bucketName, period string, value int, legalHold string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+		object_versioning {
+			enable = true
+		}
+	}
+
+	resource "ibm_cos_bucket_object_lock_configuration" "lock" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		object_lock_rule {
+			default_retention {
+				mode = "COMPLIANCE"
+				%s   = %d
+			}
+		}
+	}
+
+	resource "ibm_cos_bucket_object" "object" {
+		bucket_crn                    = ibm_cos_bucket.bucket.crn
+		bucket_location               = ibm_cos_bucket.bucket.region_location
+		key                           = "locked.txt"
+		content                       = "Locked object"
+		object_lock_legal_hold_status = "%s"
+		depends_on                    = [ibm_cos_bucket_object_lock_configuration.lock]
+	}
+	`, cosServiceName, bucketName, period, value, legalHold)
+}
@@ -4,6 +4,8 @@
 package cos_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -2532,3 +2534,84 @@ func TestCrossRegionLocationRegex(t *testing.T) {
 		}
 	}
 }
+
+func TestIBMCOSBucketStateUpgradeV0(t *testing.T) {
+	r := cos.ResourceIBMCOSBucket()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("bad schema version %d with %d state upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	instance := "crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:5a3e2f5d-7c1b-4a7e-9d2b-1f0e3c4d5b6a::"
+	prefix := "crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:5a3e2f5d-7c1b-4a7e-9d2b-1f0e3c4d5b6a:bucket:tf-bucket:meta:"
+	cases := []struct {
+		rawState string
+		id       string
+	}{
+		{
+			rawState: `{"id": "` + prefix + `rl:us-south:private", "bucket_name": "tf-bucket", "region_location": "us-south", "endpoint_type": "private"}`,
+			id:       prefix + "rl:us-south:private",
+		},
+		{
+			rawState: `{"id": "` + prefix + `rl:us-south", "bucket_name": "tf-bucket", "region_location": "us-south", "endpoint_type": "private"}`,
+			id:       prefix + "rl:us-south:private",
+		},
+		{
+			rawState: `{"id": "` + prefix + `crl:us", "bucket_name": "tf-bucket", "cross_region_location": "us"}`,
+			id:       prefix + "crl:us:public",
+		},
+		{
+			rawState: `{"id": "tf-bucket", "bucket_name": "tf-bucket", "resource_instance_id": "` + instance + `", "single_site_location": "ams03", "endpoint_type": "public"}`,
+			id:       prefix + "ssl:ams03:public",
+		},
+	}
+	for _, c := range cases {
+		var rawState map[string]interface{}
+		if err := json.Unmarshal([]byte(c.rawState), &rawState); err != nil {
+			t.Fatalf("Error decoding the raw state: %s", err)
+		}
+		actual, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+		if err != nil {
+			t.Fatalf("Error upgrading the state %s: %s", c.rawState, err)
+		}
+		if actual["id"] != c.id {
+			t.Fatalf("bad id %q, expected %q", actual["id"], c.id)
+		}
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"id": "tf-bucket", "bucket_name": "tf-bucket"}`), &rawState); err != nil {
+		t.Fatalf("Error decoding the raw state: %s", err)
+	}
+	if _, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil); err == nil {
+		t.Fatalf("expected an error when the bucket location is missing from the state")
+	}
+}
+
+func TestIBMCosBucketOffline(t *testing.T) {
+	acc.RunOfflineCRUDTest(t, acc.OfflineCRUDTest{
+		Resource: cos.ResourceIBMCOSBucket(),
+		Fixtures: "testdata/ibm_cos_bucket.json",
+		Attributes: map[string]interface{}{
+			"bucket_name":          "tf-offline-bucket",
+			"resource_instance_id": "crn:v1:bluemix:public:cloud-object-storage:global:a/fakeaccount:fake-cos-instance::",
+			"region_location":      "us-south",
+			"storage_class":        "standard",
+			"endpoint_type":        "public",
+		},
+		ID: "crn:v1:bluemix:public:cloud-object-storage:global:a/fakeaccount:fake-cos-instance:bucket:tf-offline-bucket:meta:rl:us-south:public",
+		Expected: map[string]string{
+			"bucket_name":                           "tf-offline-bucket",
+			"region_location":                       "us-south",
+			"storage_class":                         "standard",
+			"crn":                                   "crn:v1:bluemix:public:cloud-object-storage:global:a/fakeaccount:fake-cos-instance:bucket:tf-offline-bucket",
+			"s3_endpoint_private":                   "s3.private.us-south.cloud-object-storage.appdomain.cloud",
+			"activity_tracking.0.write_data_events": "true",
+			"retention_rule.0.default":              "7",
+			"retention_rule.0.maximum":              "30",
+			"object_versioning.0.enable":            "true",
+		},
+		Setup: func(t *testing.T, endpoints *acc.FakeEndpoints) {
+			// The S3 API endpoint of the bucket is not part of the endpoints file
+			t.Setenv("IBMCLOUD_COS_ENDPOINT", endpoints.Server.URL)
+		},
+	})
+}
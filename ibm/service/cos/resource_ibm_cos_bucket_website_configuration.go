@@ -0,0 +1,390 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketWebsiteConfigurationCreate,
+		Read:     resourceIBMCOSBucketWebsiteConfigurationRead,
+		Update:   resourceIBMCOSBucketWebsiteConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"index_document": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Description:   "The document served for the requests to the root of the website or to a folder",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suffix": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The suffix appended to the requests for a folder, e.g. index.html",
+						},
+					},
+				},
+			},
+			"error_document": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Description:   "The document served when an error occurs",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The key of the object served when an error occurs",
+						},
+					},
+				},
+			},
+			"redirect_all_requests_to": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"index_document", "error_document", "routing_rule"},
+				Description:   "Redirects all the requests to the website to another host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The host the requests are redirected to",
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+							Description:  "The protocol of the redirect: http or https. Defaults to the protocol of the original request",
+						},
+					},
+				},
+			},
+			"routing_rule": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Description:   "The rules which redirect the requests matching their condition",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The condition the request must match for the redirect to apply",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_error_code_returned_equals": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The HTTP error code of the response the redirect applies to",
+									},
+									"key_prefix_equals": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The prefix of the object keys the redirect applies to",
+									},
+								},
+							},
+						},
+						"redirect": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "The redirect applied to the matching requests",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The host the request is redirected to",
+									},
+									"http_redirect_code": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The HTTP redirect code of the response",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+										Description:  "The protocol of the redirect: http or https",
+									},
+									"replace_key_prefix_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The prefix which replaces the key_prefix_equals of the condition in the redirect",
+									},
+									"replace_key_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The object key the request is redirected to",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The endpoint of the static website of the bucket",
+			},
+		},
+	}
+}
+
+func websiteConfigurationSet(d *schema.ResourceData) *s3.WebsiteConfiguration {
+	websiteConfig := &s3.WebsiteConfiguration{}
+
+	if v, ok := d.GetOk("index_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		indexMap := v.([]interface{})[0].(map[string]interface{})
+		websiteConfig.IndexDocument = &s3.IndexDocument{Suffix: aws.String(indexMap["suffix"].(string))}
+	}
+	if v, ok := d.GetOk("error_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		errorMap := v.([]interface{})[0].(map[string]interface{})
+		websiteConfig.ErrorDocument = &s3.ErrorDocument{Key: aws.String(errorMap["key"].(string))}
+	}
+	if v, ok := d.GetOk("redirect_all_requests_to"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		redirectMap := v.([]interface{})[0].(map[string]interface{})
+		redirectAll := &s3.RedirectAllRequestsTo{HostName: aws.String(redirectMap["host_name"].(string))}
+		if protocol := redirectMap["protocol"].(string); protocol != "" {
+			redirectAll.Protocol = aws.String(protocol)
+		}
+		websiteConfig.RedirectAllRequestsTo = redirectAll
+	}
+	if v, ok := d.GetOk("routing_rule"); ok {
+		for _, r := range v.([]interface{}) {
+			ruleMap, _ := r.(map[string]interface{})
+			rule := &s3.RoutingRule{}
+			if conditions := ruleMap["condition"].([]interface{}); len(conditions) > 0 && conditions[0] != nil {
+				conditionMap := conditions[0].(map[string]interface{})
+				rule.Condition = &s3.Condition{}
+				if code := conditionMap["http_error_code_returned_equals"].(string); code != "" {
+					rule.Condition.HttpErrorCodeReturnedEquals = aws.String(code)
+				}
+				if prefix := conditionMap["key_prefix_equals"].(string); prefix != "" {
+					rule.Condition.KeyPrefixEquals = aws.String(prefix)
+				}
+			}
+			rule.Redirect = &s3.Redirect{}
+			if redirects := ruleMap["redirect"].([]interface{}); len(redirects) > 0 && redirects[0] != nil {
+				redirectMap := redirects[0].(map[string]interface{})
+				if hostName := redirectMap["host_name"].(string); hostName != "" {
+					rule.Redirect.HostName = aws.String(hostName)
+				}
+				if code := redirectMap["http_redirect_code"].(string); code != "" {
+					rule.Redirect.HttpRedirectCode = aws.String(code)
+				}
+				if protocol := redirectMap["protocol"].(string); protocol != "" {
+					rule.Redirect.Protocol = aws.String(protocol)
+				}
+				if prefix := redirectMap["replace_key_prefix_with"].(string); prefix != "" {
+					rule.Redirect.ReplaceKeyPrefixWith = aws.String(prefix)
+				}
+				if key := redirectMap["replace_key_with"].(string); key != "" {
+					rule.Redirect.ReplaceKeyWith = aws.String(key)
+				}
+			}
+			websiteConfig.RoutingRules = append(websiteConfig.RoutingRules, rule)
+		}
+	}
+	return websiteConfig
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	putBucketWebsiteInput := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucketName),
+		WebsiteConfiguration: websiteConfigurationSet(d),
+	}
+
+	_, err = s3Client.PutBucketWebsite(putBucketWebsiteInput)
+	if err != nil {
+		return fmt.Errorf("failed to create the website configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+
+	return resourceIBMCOSBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("index_document", "error_document", "redirect_all_requests_to", "routing_rule") {
+		bucketName := parseBucketReplId(d.Id(), "bucketName")
+		bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+		instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+		endpointType := d.Get("endpoint_type").(string)
+
+		bxSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return err
+		}
+
+		s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return err
+		}
+
+		putBucketWebsiteInput := &s3.PutBucketWebsiteInput{
+			Bucket:               aws.String(bucketName),
+			WebsiteConfiguration: websiteConfigurationSet(d),
+		}
+
+		_, err = s3Client.PutBucketWebsite(putBucketWebsiteInput)
+		if err != nil {
+			return fmt.Errorf("failed to update the website configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	websitePtr, err := s3Client.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NoSuchWebsiteConfiguration" || aerr.Code() == "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to get the website configuration of COS bucket %s, %v", bucketName, err)
+	}
+
+	indexDocument := []interface{}{}
+	if websitePtr.IndexDocument != nil && websitePtr.IndexDocument.Suffix != nil {
+		indexDocument = append(indexDocument, map[string]interface{}{"suffix": *websitePtr.IndexDocument.Suffix})
+	}
+	d.Set("index_document", indexDocument)
+
+	errorDocument := []interface{}{}
+	if websitePtr.ErrorDocument != nil && websitePtr.ErrorDocument.Key != nil {
+		errorDocument = append(errorDocument, map[string]interface{}{"key": *websitePtr.ErrorDocument.Key})
+	}
+	d.Set("error_document", errorDocument)
+
+	redirectAll := []interface{}{}
+	if websitePtr.RedirectAllRequestsTo != nil {
+		redirect := map[string]interface{}{}
+		if websitePtr.RedirectAllRequestsTo.HostName != nil {
+			redirect["host_name"] = *websitePtr.RedirectAllRequestsTo.HostName
+		}
+		if websitePtr.RedirectAllRequestsTo.Protocol != nil {
+			redirect["protocol"] = *websitePtr.RedirectAllRequestsTo.Protocol
+		}
+		redirectAll = append(redirectAll, redirect)
+	}
+	d.Set("redirect_all_requests_to", redirectAll)
+	d.Set("routing_rule", flex.RoutingRulesGet(websitePtr.RoutingRules))
+	d.Set("website_endpoint", fmt.Sprintf("%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation))
+	return nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Client.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete the website configuration of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
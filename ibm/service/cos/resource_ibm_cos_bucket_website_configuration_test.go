@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucketWebsiteConfiguration_basic(t *testing.T) {
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-testacc-website-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketWebsiteConfiguration(cosServiceName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "index_document.0.suffix", "index.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "error_document.0.key", "error.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "routing_rule.0.condition.0.key_prefix_equals", "docs/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "routing_rule.0.redirect.0.replace_key_prefix_with", "documents/"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_website_configuration.website", "website_endpoint"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucketWebsiteConfigurationRedirect(cosServiceName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "redirect_all_requests_to.0.host_name", "www.example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "redirect_all_requests_to.0.protocol", "https"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "index_document.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketWebsiteConfigurationBase(cosServiceName, bucketName string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+	`, cosServiceName, bucketName)
+}
+
+func testAccCheckIBMCosBucketWebsiteConfiguration(cosServiceName, bucketName string) string {
+	return testAccCheckIBMCosBucketWebsiteConfigurationBase(cosServiceName, bucketName) + `
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		index_document {
+			suffix = "index.html"
+		}
+		error_document {
+			key = "error.html"
+		}
+		routing_rule {
+			condition {
+				key_prefix_equals = "docs/"
+			}
+			redirect {
+				replace_key_prefix_with = "documents/"
+			}
+		}
+	}
+	`
+}
+
+func testAccCheckIBMCosBucketWebsiteConfigurationRedirect(cosServiceName, bucketName string) string {
+	return testAccCheckIBMCosBucketWebsiteConfigurationBase(cosServiceName, bucketName) + `
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		redirect_all_requests_to {
+			host_name = "www.example.com"
+			protocol  = "https"
+		}
+	}
+	`
+}
@@ -367,7 +367,7 @@ func getS3ClientSession(bxSession *bxsession.Session, bucketLocation string, end
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := bxSession.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(bxSession).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(cosSessionConfig(bxSession), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := bxSession.Config.IAMAccessToken
 	if iamAccessToken != "" {
@@ -380,7 +380,7 @@ func getS3ClientSession(bxSession *bxsession.Session, bucketLocation string, end
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
+		s3Conf = cosSessionConfig(bxSession).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(cosSessionConfig(bxSession), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	return s3.New(s3Sess, s3Conf), nil
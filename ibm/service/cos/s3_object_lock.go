@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/private/checksum"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/restxml"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// The version of the COS SDK the provider is built with has no object lock operations, the types and operations below
+// follow the S3 API the way the SDK models the other bucket configurations, such as the protection configuration.
+
+// ObjectLockConfiguration is the object lock configuration of a bucket
+type ObjectLockConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Enabled when object lock is enabled on the bucket
+	ObjectLockEnabled *string `type:"string"`
+
+	// The default retention applied to the new objects of the bucket
+	Rule *ObjectLockRule `type:"structure"`
+}
+
+// ObjectLockRule is the rule of the object lock configuration
+type ObjectLockRule struct {
+	_ struct{} `type:"structure"`
+
+	DefaultRetention *DefaultRetention `type:"structure"`
+}
+
+// DefaultRetention is the retention mode and period applied to the new objects of a bucket, either Days or Years is set
+type DefaultRetention struct {
+	_ struct{} `type:"structure"`
+
+	Days  *int64  `type:"integer"`
+	Mode  *string `type:"string"`
+	Years *int64  `type:"integer"`
+}
+
+// ObjectLockLegalHold is the legal hold status of an object
+type ObjectLockLegalHold struct {
+	_ struct{} `type:"structure"`
+
+	// ON or OFF
+	Status *string `type:"string"`
+}
+
+type putObjectLockConfigurationInput struct {
+	_ struct{} `locationName:"PutObjectLockConfigurationRequest" type:"structure" payload:"ObjectLockConfiguration"`
+
+	Bucket                  *string                  `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	ObjectLockConfiguration *ObjectLockConfiguration `locationName:"ObjectLockConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type putObjectLockConfigurationOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+type getObjectLockConfigurationInput struct {
+	_ struct{} `locationName:"GetObjectLockConfigurationRequest" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type getObjectLockConfigurationOutput struct {
+	_ struct{} `type:"structure" payload:"ObjectLockConfiguration"`
+
+	ObjectLockConfiguration *ObjectLockConfiguration `type:"structure"`
+}
+
+type putObjectLegalHoldInput struct {
+	_ struct{} `locationName:"PutObjectLegalHoldRequest" type:"structure" payload:"LegalHold"`
+
+	Bucket    *string              `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	Key       *string              `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+	VersionId *string              `location:"querystring" locationName:"versionId" type:"string"`
+	LegalHold *ObjectLockLegalHold `locationName:"LegalHold" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type putObjectLegalHoldOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+type getObjectLegalHoldInput struct {
+	_ struct{} `locationName:"GetObjectLegalHoldRequest" type:"structure"`
+
+	Bucket    *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	Key       *string `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+	VersionId *string `location:"querystring" locationName:"versionId" type:"string"`
+}
+
+type getObjectLegalHoldOutput struct {
+	_ struct{} `type:"structure" payload:"LegalHold"`
+
+	LegalHold *ObjectLockLegalHold `type:"structure"`
+}
+
+// putObjectLockConfiguration sets the object lock configuration of the bucket
+func putObjectLockConfiguration(s3Client *s3.S3, bucketName string, config *ObjectLockConfiguration) error {
+	op := &request.Operation{
+		Name:       "PutObjectLockConfiguration",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	input := &putObjectLockConfigurationInput{
+		Bucket:                  &bucketName,
+		ObjectLockConfiguration: config,
+	}
+	req := s3Client.NewRequest(op, input, &putObjectLockConfigurationOutput{})
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	req.Handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "contentMd5Handler",
+		Fn:   checksum.AddBodyContentMD5Handler,
+	})
+	return req.Send()
+}
+
+// getObjectLockConfiguration returns the object lock configuration of the bucket
+func getObjectLockConfiguration(s3Client *s3.S3, bucketName string) (*ObjectLockConfiguration, error) {
+	op := &request.Operation{
+		Name:       "GetObjectLockConfiguration",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	output := &getObjectLockConfigurationOutput{}
+	req := s3Client.NewRequest(op, &getObjectLockConfigurationInput{Bucket: &bucketName}, output)
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+	return output.ObjectLockConfiguration, nil
+}
+
+// putObjectLegalHold sets the legal hold status of the object
+func putObjectLegalHold(s3Client *s3.S3, bucketName, key string, versionID *string, status string) error {
+	op := &request.Operation{
+		Name:       "PutObjectLegalHold",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+	}
+	input := &putObjectLegalHoldInput{
+		Bucket:    &bucketName,
+		Key:       &key,
+		VersionId: versionID,
+		LegalHold: &ObjectLockLegalHold{Status: &status},
+	}
+	req := s3Client.NewRequest(op, input, &putObjectLegalHoldOutput{})
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	req.Handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "contentMd5Handler",
+		Fn:   checksum.AddBodyContentMD5Handler,
+	})
+	return req.Send()
+}
+
+// getObjectLegalHold returns the legal hold status of the object
+func getObjectLegalHold(s3Client *s3.S3, bucketName, key string, versionID *string) (*ObjectLockLegalHold, error) {
+	op := &request.Operation{
+		Name:       "GetObjectLegalHold",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+	}
+	input := &getObjectLegalHoldInput{
+		Bucket:    &bucketName,
+		Key:       &key,
+		VersionId: versionID,
+	}
+	output := &getObjectLegalHoldOutput{}
+	req := s3Client.NewRequest(op, input, output)
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+	return output.LegalHold, nil
+}
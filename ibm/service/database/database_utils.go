@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// databaseConfigurationSetting is the schema of a configuration setting of a deployment
+type databaseConfigurationSetting struct {
+	Type            string        `json:"type"`
+	Default         interface{}   `json:"default,omitempty"`
+	Minimum         *int64        `json:"minimum,omitempty"`
+	Maximum         *int64        `json:"maximum,omitempty"`
+	Choices         []interface{} `json:"choices,omitempty"`
+	RequiresRestart bool          `json:"requires_restart,omitempty"`
+	Description     string        `json:"description,omitempty"`
+}
+
+// getDatabaseConfigurationSchema returns the schema of the configuration settings of a deployment, by setting name
+func getDatabaseConfigurationSchema(meta interface{}, deploymentID string) (map[string]databaseConfigurationSetting, error) {
+	icdClient, err := meta.(conns.ClientSession).ICDAPI()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+	configSchema, err := icdClient.Configurations().GetConfiguration(flex.EscapeUrlParm(deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", deploymentID, err)
+	}
+	s, err := json.Marshal(configSchema)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error marshalling the database configuration schema: %s", err)
+	}
+	var result struct {
+		Schema map[string]json.RawMessage `json:"schema"`
+	}
+	if err = json.Unmarshal(s, &result); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error unmarshalling the database configuration schema: %s", err)
+	}
+	settings := make(map[string]databaseConfigurationSetting, len(result.Schema))
+	for name, raw := range result.Schema {
+		var setting databaseConfigurationSetting
+		// Settings which are not described by an object are not validated
+		if err := json.Unmarshal(raw, &setting); err == nil {
+			settings[name] = setting
+		}
+	}
+	return settings, nil
+}
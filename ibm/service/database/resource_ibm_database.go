@@ -317,6 +317,13 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_database", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -1164,7 +1171,7 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsAllCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1471,8 +1478,8 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -1711,7 +1718,8 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 		log.Printf(
 			"Error on get of ibm Database tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
+	d.Set(flex.TagsAll, tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -1911,8 +1919,8 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldList, newList := d.GetChange("tags")
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
 			log.Printf(
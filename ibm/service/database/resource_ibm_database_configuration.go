@@ -0,0 +1,508 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// databaseConfigurationSettingKey is a configuration setting of a database engine
+type databaseConfigurationSettingKey struct {
+	// Name is the name of the attribute, Key the name of the setting in the configuration of the deployment
+	Name string
+	Key  string
+	// SchemaKey is the name of the setting in the configuration schema, when it differs from Key
+	SchemaKey string
+	Type      schema.ValueType
+}
+
+func (k databaseConfigurationSettingKey) schemaKey() string {
+	if k.SchemaKey != "" {
+		return k.SchemaKey
+	}
+	return k.Key
+}
+
+// databaseConfigurationEngines are the configuration blocks of the resource and their settings, the keys are the
+// ones of clouddatabasesv5.Configuration
+var databaseConfigurationEngines = map[string][]databaseConfigurationSettingKey{
+	"postgresql": {
+		{Name: "max_connections", Key: "max_connections", Type: schema.TypeInt},
+		{Name: "max_prepared_transactions", Key: "max_prepared_transactions", Type: schema.TypeInt},
+		{Name: "deadlock_timeout", Key: "deadlock_timeout", Type: schema.TypeInt},
+		{Name: "effective_io_concurrency", Key: "effective_io_concurrency", Type: schema.TypeInt},
+		{Name: "max_replication_slots", Key: "max_replication_slots", Type: schema.TypeInt},
+		{Name: "max_wal_senders", Key: "max_wal_senders", Type: schema.TypeInt},
+		{Name: "shared_buffers", Key: "shared_buffers", Type: schema.TypeInt},
+		{Name: "synchronous_commit", Key: "synchronous_commit", Type: schema.TypeString},
+		{Name: "wal_level", Key: "wal_level", Type: schema.TypeString},
+		{Name: "archive_timeout", Key: "archive_timeout", Type: schema.TypeInt},
+		{Name: "log_min_duration_statement", Key: "log_min_duration_statement", Type: schema.TypeInt},
+	},
+	"redis": {
+		{Name: "maxmemory", Key: "maxmemory-redis", SchemaKey: "maxmemory", Type: schema.TypeInt},
+		{Name: "maxmemory_policy", Key: "maxmemory-policy", Type: schema.TypeString},
+		{Name: "appendonly", Key: "appendonly", Type: schema.TypeString},
+		{Name: "maxmemory_samples", Key: "maxmemory-samples", Type: schema.TypeInt},
+		{Name: "stop_writes_on_bgsave_error", Key: "stop-writes-on-bgsave-error", Type: schema.TypeString},
+	},
+	"mysql": {
+		{Name: "max_binlog_age_sec", Key: "mysql_max_binlog_age_sec", Type: schema.TypeInt},
+		{Name: "default_authentication_plugin", Key: "mysql_default_authentication_plugin", Type: schema.TypeString},
+	},
+	// The settings of MongoDB are not part of clouddatabasesv5.Configuration, they are set in the settings map of
+	// the block by their name in the configuration schema of the deployment
+	"mongodb": nil,
+}
+
+// databaseConfigurationSettingsMap is the attribute of the blocks of the engines without settings of their own
+const databaseConfigurationSettingsMap = "settings"
+
+// databaseConfigurationKeys returns the settings of the engine, for an engine without settings of its own a setting
+// is returned for each of the names set in its settings map
+func databaseConfigurationKeys(engine string, names []string) []databaseConfigurationSettingKey {
+	if keys := databaseConfigurationEngines[engine]; len(keys) > 0 {
+		return keys
+	}
+	sort.Strings(names)
+	keys := make([]databaseConfigurationSettingKey, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, databaseConfigurationSettingKey{Name: name, Key: name})
+	}
+	return keys
+}
+
+// databaseConfigurationNames returns the names of the settings of values
+func databaseConfigurationNames(values map[string]interface{}) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	return names
+}
+
+// databaseConfigurationEngineBlocks maps the deployment types to the configuration block of the resource
+var databaseConfigurationEngineBlocks = map[string]string{
+	"postgresql":   "postgresql",
+	"enterprisedb": "postgresql",
+	"redis":        "redis",
+	"mysql":        "mysql",
+	"mongodb":      "mongodb",
+	"mongodbee":    "mongodb",
+}
+
+func ResourceIBMDatabaseConfiguration() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"deployment_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The ID of the database deployment",
+		},
+		"restart_required_settings": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The settings set by the resource which only take effect once the database restarts",
+		},
+	}
+	engines := make([]string, 0, len(databaseConfigurationEngines))
+	for engine := range databaseConfigurationEngines {
+		engines = append(engines, engine)
+	}
+	sort.Strings(engines)
+	for _, engine := range engines {
+		settings := map[string]*schema.Schema{}
+		for _, key := range databaseConfigurationEngines[engine] {
+			settings[key.Name] = &schema.Schema{
+				Type:        key.Type,
+				Optional:    true,
+				Description: fmt.Sprintf("The %s setting of the database", key.schemaKey()),
+			}
+		}
+		if len(settings) == 0 {
+			settings[databaseConfigurationSettingsMap] = &schema.Schema{
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The settings of the database by their name in the configuration schema of the deployment, integer settings are set as strings",
+			}
+		}
+		resourceSchema[engine] = &schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: engines,
+			Description:  fmt.Sprintf("The configuration of a %s deployment", engine),
+			Elem: &schema.Resource{
+				Schema: settings,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseConfigurationCreate,
+		ReadContext:   resourceIBMDatabaseConfigurationRead,
+		UpdateContext: resourceIBMDatabaseConfigurationUpdate,
+		DeleteContext: resourceIBMDatabaseConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMDatabaseConfigurationDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+// databaseConfigurationSettings returns the engine block and the settings set in it by raw, the configuration or the
+// state of the resource. Only the settings which are not null are returned, so that 0 can be set.
+func databaseConfigurationSettings(raw cty.Value) (string, map[string]interface{}) {
+	if raw.IsNull() || !raw.IsKnown() {
+		return "", nil
+	}
+	for engine, keys := range databaseConfigurationEngines {
+		block := raw.GetAttr(engine)
+		if block.IsNull() || !block.IsKnown() || block.LengthInt() == 0 {
+			continue
+		}
+		settings := block.Index(cty.NumberIntVal(0))
+		values := map[string]interface{}{}
+		if len(keys) == 0 {
+			settingsMap := settings.GetAttr(databaseConfigurationSettingsMap)
+			if settingsMap.IsNull() || !settingsMap.IsKnown() {
+				return engine, values
+			}
+			for name, value := range settingsMap.AsValueMap() {
+				if value.IsNull() || !value.IsKnown() {
+					continue
+				}
+				// The integer settings are stored as int64 like the settings of the other engines
+				if i, err := strconv.ParseInt(value.AsString(), 10, 64); err == nil {
+					values[name] = i
+				} else {
+					values[name] = value.AsString()
+				}
+			}
+			return engine, values
+		}
+		for _, key := range keys {
+			value := settings.GetAttr(key.Name)
+			if value.IsNull() || !value.IsKnown() {
+				continue
+			}
+			switch key.Type {
+			case schema.TypeInt:
+				i, _ := value.AsBigFloat().Int64()
+				values[key.Name] = i
+			default:
+				values[key.Name] = value.AsString()
+			}
+		}
+		return engine, values
+	}
+	return "", nil
+}
+
+// validateDatabaseConfiguration validates the settings of the engine block against the configuration schema of the
+// deployment, and returns the settings which require a restart of the database
+func validateDatabaseConfiguration(engine string, values map[string]interface{}, settingsSchema map[string]databaseConfigurationSetting) ([]string, error) {
+	var restartRequired []string
+	var errs []string
+	for _, key := range databaseConfigurationKeys(engine, databaseConfigurationNames(values)) {
+		value, ok := values[key.Name]
+		if !ok {
+			continue
+		}
+		setting, ok := settingsSchema[key.schemaKey()]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s.0.%s is not supported by the version of the deployment", engine, key.Name))
+			continue
+		}
+		if _, ok := value.(int64); !ok && setting.Type == "integer" {
+			errs = append(errs, fmt.Sprintf("%s.0.%s must be an integer, got %v", engine, key.Name, value))
+			continue
+		}
+		if setting.RequiresRestart {
+			restartRequired = append(restartRequired, key.Name)
+		}
+		if i, ok := value.(int64); ok {
+			if setting.Minimum != nil && i < *setting.Minimum {
+				errs = append(errs, fmt.Sprintf("%s.0.%s must be at least %d, got %d", engine, key.Name, *setting.Minimum, i))
+			}
+			if setting.Maximum != nil && i > *setting.Maximum {
+				errs = append(errs, fmt.Sprintf("%s.0.%s must be at most %d, got %d", engine, key.Name, *setting.Maximum, i))
+			}
+		}
+		if len(setting.Choices) > 0 {
+			valid := false
+			choices := make([]string, 0, len(setting.Choices))
+			for _, choice := range setting.Choices {
+				choices = append(choices, fmt.Sprint(choice))
+				if fmt.Sprint(choice) == fmt.Sprint(value) {
+					valid = true
+				}
+			}
+			if !valid {
+				errs = append(errs, fmt.Sprintf("%s.0.%s must be one of %q, got %v", engine, key.Name, choices, value))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("[ERROR] Invalid database configuration: %s", strings.Join(errs, ", "))
+	}
+	return restartRequired, nil
+}
+
+// checkDatabaseConfiguration checks the engine block matches the type of the deployment and validates its settings
+func checkDatabaseConfiguration(context context.Context, client *clouddatabasesv5.CloudDatabasesV5, meta interface{}, deploymentID, engine string, values map[string]interface{}) ([]string, error) {
+	deployment, response, err := client.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &deploymentID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", deploymentID, err, response)
+	}
+	if deployment.Deployment != nil && deployment.Deployment.Type != nil {
+		deploymentType := *deployment.Deployment.Type
+		block, ok := databaseConfigurationEngineBlocks[deploymentType]
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] The configuration of %s deployments cannot be managed", deploymentType)
+		}
+		if block != engine {
+			return nil, fmt.Errorf("[ERROR] The configuration of the %s deployment %s must be set in the %s block", deploymentType, deploymentID, block)
+		}
+	}
+	settingsSchema, err := getDatabaseConfigurationSchema(meta, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	return validateDatabaseConfiguration(engine, values, settingsSchema)
+}
+
+func resourceIBMDatabaseConfigurationDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	deploymentID, ok := diff.GetOk("deployment_id")
+	if !ok || !diff.NewValueKnown("deployment_id") {
+		return nil
+	}
+	engine, values := databaseConfigurationSettings(diff.GetRawConfig())
+	if engine == "" {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(engine) {
+		return nil
+	}
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return err
+	}
+	restartRequired, err := checkDatabaseConfiguration(context, cloudDatabasesClient, meta, deploymentID.(string), engine, values)
+	if err != nil {
+		return err
+	}
+	return diff.SetNew("restart_required_settings", restartRequired)
+}
+
+// updateDatabaseConfiguration sets the settings of the deployment and waits for the task to complete. The settings
+// are sent with the ICD client, which does not restrict them to the ones of clouddatabasesv5.Configuration.
+func updateDatabaseConfiguration(d *schema.ResourceData, meta interface{}, deploymentID, engine string, values map[string]interface{}, timeout time.Duration) error {
+	configurationMap := make(map[string]interface{}, len(values))
+	for _, key := range databaseConfigurationKeys(engine, databaseConfigurationNames(values)) {
+		if value, ok := values[key.Name]; ok {
+			configurationMap[key.Key] = value
+		}
+	}
+	if len(configurationMap) == 0 {
+		return nil
+	}
+
+	icdClient, err := meta.(conns.ClientSession).ICDAPI()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+	task, err := icdClient.Configurations().UpdateConfiguration(flex.EscapeUrlParm(deploymentID), icdv4.ConfigurationReq{Configuration: configurationMap})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating database (%s) configuration: %s", deploymentID, err)
+	}
+	if _, err = waitForDatabaseTaskComplete(task.Id, d, meta, timeout); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for database (%s) configuration update task to complete: %s", deploymentID, err)
+	}
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	engine, values := databaseConfigurationSettings(d.GetRawConfig())
+	// The deployment may not have been known at plan time, the configuration is checked again
+	restartRequired, err := checkDatabaseConfiguration(context, cloudDatabasesClient, meta, deploymentID, engine, values)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = updateDatabaseConfiguration(d, meta, deploymentID, engine, values, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(deploymentID)
+	d.Set("restart_required_settings", restartRequired)
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+func resourceIBMDatabaseConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Id()
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &deploymentID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) not found, removing its configuration from the state", deploymentID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", deploymentID, err, response))
+	}
+	d.Set("deployment_id", deploymentID)
+
+	// The API does not return the current values of the settings, the settings which are no longer in the schema of
+	// the deployment, after a version upgrade, are removed from the state so that they show in the plan
+	engine, values := databaseConfigurationSettings(d.GetRawState())
+	if engine == "" || len(values) == 0 {
+		return nil
+	}
+	settingsSchema, err := getDatabaseConfigurationSchema(meta, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	block := d.Get(engine).([]interface{})
+	if len(block) == 0 || block[0] == nil {
+		return nil
+	}
+	settings := block[0].(map[string]interface{})
+	if settingsMap, ok := settings[databaseConfigurationSettingsMap].(map[string]interface{}); ok {
+		settings = settingsMap
+	}
+	for _, key := range databaseConfigurationKeys(engine, databaseConfigurationNames(values)) {
+		if _, ok := values[key.Name]; !ok {
+			continue
+		}
+		if _, ok := settingsSchema[key.schemaKey()]; !ok {
+			delete(settings, key.Name)
+		}
+	}
+	d.Set(engine, block)
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	engine, values := databaseConfigurationSettings(d.GetRawConfig())
+	if d.HasChange(engine) {
+		// Only the settings which changed are sent, the settings removed from the configuration are reset to their
+		// default
+		_, oldValues := databaseConfigurationSettings(d.GetRawState())
+		changed := map[string]interface{}{}
+		for name, value := range values {
+			if oldValue, ok := oldValues[name]; !ok || oldValue != value {
+				changed[name] = value
+			}
+		}
+		var removed []string
+		for name, oldValue := range oldValues {
+			if _, ok := values[name]; !ok && !zeroDatabaseConfigurationSetting(oldValue) {
+				removed = append(removed, name)
+			}
+		}
+		if len(removed) > 0 {
+			defaults, err := databaseConfigurationDefaults(meta, d.Id(), engine, removed)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for name, value := range defaults {
+				changed[name] = value
+			}
+		}
+		if err := updateDatabaseConfiguration(d, meta, d.Id(), engine, changed, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+// zeroDatabaseConfigurationSetting reports whether a setting from the state has its zero value, the settings which
+// are not set are stored with their zero value and are not reset
+func zeroDatabaseConfigurationSetting(value interface{}) bool {
+	return value == int64(0) || value == ""
+}
+
+// databaseConfigurationDefaults returns the default values of the named settings from the configuration schema of
+// the deployment, the settings without a default are left out
+func databaseConfigurationDefaults(meta interface{}, deploymentID, engine string, names []string) (map[string]interface{}, error) {
+	settingsSchema, err := getDatabaseConfigurationSchema(meta, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	defaults := map[string]interface{}{}
+	for _, key := range databaseConfigurationKeys(engine, names) {
+		for _, name := range names {
+			if key.Name != name {
+				continue
+			}
+			setting, ok := settingsSchema[key.schemaKey()]
+			if !ok || setting.Default == nil {
+				log.Printf("[WARN] The %s setting of database (%s) has no default, it is left unchanged", key.Name, deploymentID)
+				continue
+			}
+			defaults[name] = setting.Default
+		}
+	}
+	return defaults, nil
+}
+
+func resourceIBMDatabaseConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The settings managed by the resource are reset to their default
+	engine, values := databaseConfigurationSettings(d.GetRawState())
+	if engine != "" {
+		names := make([]string, 0, len(values))
+		for name, value := range values {
+			if !zeroDatabaseConfigurationSetting(value) {
+				names = append(names, name)
+			}
+		}
+		defaults, err := databaseConfigurationDefaults(meta, d.Id(), engine, names)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = updateDatabaseConfiguration(d, meta, d.Id(), engine, defaults, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateDatabaseConfiguration(t *testing.T) {
+	minimum, maximum := int64(115), int64(5000)
+	settingsSchema := map[string]databaseConfigurationSetting{
+		"max_connections":            {Type: "integer", Minimum: &minimum, Maximum: &maximum, RequiresRestart: true},
+		"synchronous_commit":         {Type: "string", Choices: []interface{}{"local", "off"}},
+		"maxmemory":                  {Type: "integer"},
+		"maxmemory-policy":           {Type: "string"},
+		"net.maxIncomingConnections": {Type: "integer", Minimum: &minimum},
+	}
+	cases := []struct {
+		name            string
+		engine          string
+		values          map[string]interface{}
+		restartRequired []string
+		errs            []string
+	}{
+		{
+			name:            "valid",
+			engine:          "postgresql",
+			values:          map[string]interface{}{"max_connections": int64(200), "synchronous_commit": "off"},
+			restartRequired: []string{"max_connections"},
+		},
+		{
+			name:   "out of range",
+			engine: "postgresql",
+			values: map[string]interface{}{"max_connections": int64(100)},
+			errs:   []string{"postgresql.0.max_connections must be at least 115, got 100"},
+		},
+		{
+			name:   "invalid choice",
+			engine: "postgresql",
+			values: map[string]interface{}{"synchronous_commit": "on"},
+			errs:   []string{`postgresql.0.synchronous_commit must be one of ["local" "off"], got on`},
+		},
+		{
+			name:   "not supported",
+			engine: "postgresql",
+			values: map[string]interface{}{"wal_level": "logical"},
+			errs:   []string{"postgresql.0.wal_level is not supported by the version of the deployment"},
+		},
+		{
+			name:   "schema key",
+			engine: "redis",
+			values: map[string]interface{}{"maxmemory": int64(100), "maxmemory_policy": "noeviction"},
+		},
+		{
+			name:   "settings map",
+			engine: "mongodb",
+			values: map[string]interface{}{"net.maxIncomingConnections": int64(200)},
+		},
+		{
+			name:   "settings map not an integer",
+			engine: "mongodb",
+			values: map[string]interface{}{"net.maxIncomingConnections": "many"},
+			errs:   []string{"mongodb.0.net.maxIncomingConnections must be an integer, got many"},
+		},
+		{
+			name:   "settings map not supported",
+			engine: "mongodb",
+			values: map[string]interface{}{"unknown": "value"},
+			errs:   []string{"mongodb.0.unknown is not supported by the version of the deployment"},
+		},
+	}
+	for _, tc := range cases {
+		restartRequired, err := validateDatabaseConfiguration(tc.engine, tc.values, settingsSchema)
+		if len(tc.errs) > 0 {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.name)
+				continue
+			}
+			for _, e := range tc.errs {
+				if !strings.Contains(err.Error(), e) {
+					t.Errorf("%s: expected %q in %q", tc.name, e, err)
+				}
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: err: %s", tc.name, err)
+		} else if !reflect.DeepEqual(restartRequired, tc.restartRequired) {
+			t.Errorf("%s: got %v, expected %v", tc.name, restartRequired, tc.restartRequired)
+		}
+	}
+}
+
+func TestDatabaseConfigurationSettingsMap(t *testing.T) {
+	raw := cty.ObjectVal(map[string]cty.Value{
+		"postgresql": cty.NullVal(cty.List(cty.DynamicPseudoType)),
+		"redis":      cty.NullVal(cty.List(cty.DynamicPseudoType)),
+		"mysql":      cty.NullVal(cty.List(cty.DynamicPseudoType)),
+		"mongodb": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			databaseConfigurationSettingsMap: cty.MapVal(map[string]cty.Value{
+				"net.maxIncomingConnections": cty.StringVal("200"),
+				"setParameter.mode":          cty.StringVal("strict"),
+			}),
+		})}),
+	})
+	engine, values := databaseConfigurationSettings(raw)
+	expected := map[string]interface{}{"net.maxIncomingConnections": int64(200), "setParameter.mode": "strict"}
+	if engine != "mongodb" || !reflect.DeepEqual(values, expected) {
+		t.Errorf("got %s %v, expected mongodb %v", engine, values, expected)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseConfigurationPostgresql(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+	name := "ibm_database_configuration.configuration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseConfigurationConfigPostgresql(testName, `
+					max_connections    = 200
+					synchronous_commit = "local"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "postgresql.0.max_connections", "200"),
+					resource.TestCheckResourceAttr(name, "postgresql.0.synchronous_commit", "local"),
+					resource.TestCheckResourceAttr(name, "restart_required_settings.#", "1"),
+					resource.TestCheckResourceAttr(name, "restart_required_settings.0", "max_connections"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseConfigurationConfigPostgresql(testName, `
+					synchronous_commit = "off"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "postgresql.0.max_connections", "0"),
+					resource.TestCheckResourceAttr(name, "postgresql.0.synchronous_commit", "off"),
+					resource.TestCheckResourceAttr(name, "restart_required_settings.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseConfigurationConfigPostgresql(testName, `
+					max_connections = 1
+				`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("postgresql.0.max_connections must be at least"),
+			},
+		},
+	})
+}
+
+func TestAccIBMDatabaseConfigurationEngineMismatch(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDatabaseConfigurationConfigRedisOnPostgresql(testName),
+				ExpectError: regexp.MustCompile("must be set in the postgresql block"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseConfigurationDeployment(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+	}
+	`, name, acc.IcdDbRegion)
+}
+
+func testAccCheckIBMDatabaseConfigurationConfigPostgresql(name, settings string) string {
+	return testAccCheckIBMDatabaseConfigurationDeployment(name) + fmt.Sprintf(`
+	resource "ibm_database_configuration" "configuration" {
+		deployment_id = ibm_database.db.id
+
+		postgresql {
+			%s
+		}
+	}
+	`, settings)
+}
+
+func testAccCheckIBMDatabaseConfigurationConfigRedisOnPostgresql(name string) string {
+	return testAccCheckIBMDatabaseConfigurationDeployment(name) + `
+	resource "ibm_database_configuration" "configuration" {
+		deployment_id = ibm_database.db.id
+
+		redis {
+			maxmemory_policy = "allkeys-lru"
+		}
+	}
+	`
+}
@@ -15,6 +15,7 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/database"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -1059,3 +1060,32 @@ func testAccCheckIBMDatabaseInstancePostgresImport(databaseResourceGroup string,
 	  }
 				`, databaseResourceGroup, name, acc.IcdDbRegion)
 }
+
+func TestIBMDatabaseInstancePostgresOffline(t *testing.T) {
+	acc.RunOfflineCRUDTest(t, acc.OfflineCRUDTest{
+		Resource: database.ResourceIBMDatabaseInstance(),
+		Fixtures: "testdata/ibm_database.json",
+		Attributes: map[string]interface{}{
+			"name":              "tf-offline-postgresql",
+			"service":           "databases-for-postgresql",
+			"plan":              "standard",
+			"location":          "us-south",
+			"resource_group_id": "fake-resource-group",
+			"service_endpoints": "public",
+		},
+		ID: "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/fakeaccount:fake-postgresql::",
+		Expected: map[string]string{
+			"name":                         "tf-offline-postgresql",
+			"service":                      "databases-for-postgresql",
+			"plan":                         "standard",
+			"location":                     "us-south",
+			"status":                       "active",
+			"adminuser":                    "admin",
+			"version":                      "12",
+			"node_count":                   "2",
+			"members_memory_allocation_mb": "2048",
+			"connectionstrings.0.name":     "admin",
+		},
+		Polls: true,
+	})
+}
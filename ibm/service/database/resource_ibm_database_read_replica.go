@@ -0,0 +1,398 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseReadReplica() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseReadReplicaCreate,
+		ReadContext:   resourceIBMDatabaseReadReplicaRead,
+		UpdateContext: resourceIBMDatabaseReadReplicaUpdate,
+		DeleteContext: resourceIBMDatabaseReadReplicaDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.All(
+			// A promoted replica is a deployment of its own, it cannot become a replica again
+			customdiff.ForceNewIfChange("promote", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			customdiff.ComputedIf("leader", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("promote")
+			}),
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the read replica",
+			},
+			"leader_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the deployment the read replica replicates",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location of the read replica, it can differ from the location of the leader",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource group of the read replica",
+			},
+			"service_endpoints": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validation.StringInSlice([]string{"public", "private", "public-and-private"}, false),
+				Description:  "The service endpoints of the read replica, public, private or public-and-private",
+			},
+			"key_protect_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Key Protect key to encrypt the disk of the read replica with",
+			},
+			"promote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Promotes the read replica to a deployment of its own, which no longer replicates the leader. Setting it back to false replaces the deployment with a new read replica.",
+			},
+			"skip_initial_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skips the backup taken when the read replica is promoted, the deployment is available sooner",
+			},
+			"resync_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Resynchronizes the read replica with its leader whenever the value changes",
+			},
+			"leader": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the deployment the read replica currently replicates, empty once it is promoted",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service of the read replica, the one of its leader",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The database version of the read replica",
+			},
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the read replica",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the read replica",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseReadReplicaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	leaderID := d.Get("leader_id").(string)
+	leader, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{
+		ID: &leaderID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving the leader database (%s): %s %s", leaderID, err, response))
+	}
+
+	// The read replica is a deployment of the plan of its leader
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+	servicePlan := *leader.ResourcePlanID
+	location := d.Get("location").(string)
+	deployments, err := rsCatRepo.ListDeployments(servicePlan)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", servicePlan, err))
+	}
+	deployments, supportedLocations := filterDatabaseDeployments(deployments, location)
+	if len(deployments) == 0 {
+		locationList := make([]string, 0, len(supportedLocations))
+		for l := range supportedLocations {
+			locationList = append(locationList, l)
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for the plan of the leader database at location %s.\nValid location(s) are: %q", location, locationList))
+	}
+	catalogCRN := deployments[0].CatalogCRN
+
+	name := d.Get("name").(string)
+	rsInst := rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		ResourcePlanID: &servicePlan,
+		Target:         &catalogCRN,
+	}
+	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
+		rsInst.ResourceGroup = flex.PtrToString(rsGrpID.(string))
+	} else {
+		defaultRg, err := flex.DefaultResourceGroup(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rsInst.ResourceGroup = &defaultRg
+	}
+
+	params := Params{
+		RemoteLeaderID:   leaderID,
+		ServiceEndpoints: d.Get("service_endpoints").(string),
+	}
+	if keyProtect, ok := d.GetOk("key_protect_key"); ok {
+		params.KeyProtectKey = keyProtect.(string)
+	}
+	parameters, _ := json.Marshal(params)
+	var raw map[string]interface{}
+	json.Unmarshal(parameters, &raw)
+	rsInst.Parameters = raw
+
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(context, &rsInst)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating database read replica: %s %s", err, response))
+	}
+	d.SetId(*instance.ID)
+
+	_, err = waitForDatabaseInstanceCreate(d, meta, *instance.ID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create database read replica (%s) to complete: %s", *instance.ID, err))
+	}
+
+	if d.Get("promote").(bool) {
+		if err = promoteDatabaseReadReplica(context, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseReadReplicaRead(context, d, meta)
+}
+
+func resourceIBMDatabaseReadReplicaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Id()
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing record from state because it's not found via the API")
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response))
+	}
+	if strings.Contains(*instance.State, databaseInstanceRemovedStatus) {
+		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", *instance.Name)
+	d.Set("status", *instance.State)
+	d.Set("resource_group_id", *instance.ResourceGroupID)
+	d.Set("guid", *instance.GUID)
+	if instance.CRN != nil {
+		location := strings.Split(*instance.CRN, ":")
+		if len(location) > 5 {
+			d.Set("location", location[5])
+		}
+	}
+	if instance.Parameters != nil {
+		if endpoint, ok := instance.Parameters["service-endpoints"]; ok {
+			d.Set("service_endpoints", endpoint)
+		}
+	}
+
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serviceOff, err := rsCatClient.ResourceCatalog().GetServiceName(*instance.ResourceID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
+	}
+	d.Set("service", serviceOff)
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	deployment, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database read replica (%s): %s\n%s", instanceID, err, response))
+	}
+	if deployment.Deployment != nil {
+		d.Set("version", deployment.Deployment.Version)
+	}
+
+	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, &clouddatabasesv5.ListRemotesOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] ListRemotes (%s) failed %s\n%s", instanceID, err, response))
+	}
+	leader := ""
+	if remotes.Remotes != nil && remotes.Remotes.Leader != nil {
+		leader = *remotes.Remotes.Leader
+	}
+	d.Set("leader", leader)
+	// The read replica was promoted outside of Terraform
+	if leader == "" && !d.Get("promote").(bool) && d.Get("leader_id").(string) != "" {
+		log.Printf("[WARN] Database read replica (%s) no longer replicates %s, it was promoted", instanceID, d.Get("leader_id"))
+		d.Set("promote", true)
+	}
+	if leader != "" {
+		d.Set("leader_id", leader)
+		d.Set("promote", false)
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseReadReplicaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("name") {
+		rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		instanceID := d.Id()
+		_, response, err := rsConClient.UpdateResourceInstanceWithContext(context, &rc.UpdateResourceInstanceOptions{
+			ID:   &instanceID,
+			Name: flex.PtrToString(d.Get("name").(string)),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database read replica (%s) name: %s %s", instanceID, err, response))
+		}
+	}
+
+	if d.HasChange("resync_trigger") && !d.Get("promote").(bool) {
+		cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		instanceID := d.Id()
+		resyncReplicaResponse, response, err := cloudDatabasesClient.ResyncReplicaWithContext(context, &clouddatabasesv5.ResyncReplicaOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] ResyncReplica (%s) failed %s\n%s", instanceID, err, response))
+		}
+		if _, err = waitForDatabaseTaskComplete(*resyncReplicaResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for database read replica (%s) resync task to complete: %s", instanceID, err))
+		}
+	}
+
+	if d.HasChange("promote") && d.Get("promote").(bool) {
+		if err := promoteDatabaseReadReplica(context, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseReadReplicaRead(context, d, meta)
+}
+
+// promoteDatabaseReadReplica promotes the read replica to a deployment of its own and waits for the promotion to
+// complete
+func promoteDatabaseReadReplica(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return err
+	}
+	instanceID := d.Id()
+	promoteReadOnlyReplicaOptions := &clouddatabasesv5.PromoteReadOnlyReplicaOptions{
+		ID: &instanceID,
+		Promotion: map[string]interface{}{
+			"skip_initial_backup": d.Get("skip_initial_backup").(bool),
+		},
+	}
+	promoteResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplicaWithContext(context, promoteReadOnlyReplicaOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] PromoteReadOnlyReplica (%s) failed %s\n%s", instanceID, err, response)
+	}
+	if _, err = waitForDatabaseTaskComplete(*promoteResponse.Task.ID, d, meta, timeout); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for database read replica (%s) promotion task to complete: %s", instanceID, err)
+	}
+	return nil
+}
+
+func resourceIBMDatabaseReadReplicaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+	recursive := true
+	response, err := rsConClient.DeleteResourceInstanceWithContext(context, &rc.DeleteResourceInstanceOptions{
+		ID:        &id,
+		Recursive: &recursive,
+	})
+	if err != nil {
+		// If prior delete occurs, instance is not immediately deleted, but remains in "removed" state"
+		if response != nil && (response.StatusCode == 404 || response.StatusCode == 410) {
+			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
+		} else {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource instance: %s %s ", err, response))
+		}
+	}
+
+	_, err = waitForDatabaseInstanceDelete(d, meta)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
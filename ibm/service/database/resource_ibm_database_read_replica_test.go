@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseReadReplicaBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+	name := "ibm_database_read_replica.replica"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseReadReplicaConfigBasic(testName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", testName+"-replica"),
+					resource.TestCheckResourceAttr(name, "service", "databases-for-postgresql"),
+					resource.TestCheckResourceAttr(name, "promote", "false"),
+					resource.TestCheckResourceAttrPair(name, "leader", "ibm_database.db", "id"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_initial_backup", "resync_trigger", "key_protect_key"},
+			},
+			{
+				Config: testAccCheckIBMDatabaseReadReplicaConfigBasic(testName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "promote", "true"),
+					resource.TestCheckResourceAttr(name, "leader", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseReadReplicaConfigBasic(name string, promote bool) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+	}
+
+	resource "ibm_database_read_replica" "replica" {
+		resource_group_id   = data.ibm_resource_group.test_acc.id
+		name                = "%[1]s-replica"
+		leader_id           = ibm_database.db.id
+		location            = "%[2]s"
+		promote             = %[3]t
+		skip_initial_backup = true
+	}
+	`, name, acc.IcdDbRegion, promote)
+}
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMDatabaseUserDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the database deployment",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+				Description:  "The type of the user, ops_manager is only available for MongoDB Enterprise",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 32),
+				Description:  "The user name",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(10, 32),
+				Description:  "The user password, changing it rotates the password of the user",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"group_read_only", "group_data_access_admin"}, false),
+				Description:  "The role of the user, only available for the ops_manager user type",
+			},
+			"password_changed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time in RFC 3339 format the password was last set by Terraform",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseUserDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("role").(string) != "" && diff.Get("type").(string) != "ops_manager" {
+		return fmt.Errorf("[ERROR] role is only available for the ops_manager user type")
+	}
+	if diff.Id() != "" && diff.HasChange("password") {
+		return diff.SetNewComputed("password_changed_at")
+	}
+	return nil
+}
+
+// databaseUserTypes are the user types each deployment type supports, the deployment types which are not listed
+// only support database users
+var databaseUserTypes = map[string][]string{
+	"mongodb": {"database", "ops_manager"},
+	"mysql":   {"database", "read_only_replica"},
+}
+
+func checkDatabaseUserType(deploymentType, userType string) error {
+	types, ok := databaseUserTypes[deploymentType]
+	if !ok {
+		types = []string{"database"}
+	}
+	for _, t := range types {
+		if t == userType {
+			return nil
+		}
+	}
+	return fmt.Errorf("[ERROR] The %s deployment does not support %s users, the supported user types are %q", deploymentType, userType, types)
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	userType := d.Get("type").(string)
+	name := d.Get("name").(string)
+
+	deployment, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &deploymentID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", deploymentID, err, response))
+	}
+	if deployment.Deployment != nil && deployment.Deployment.Type != nil {
+		if err = checkDatabaseUserType(*deployment.Deployment.Type, userType); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	user := &clouddatabasesv5.User{
+		Username: &name,
+		Password: flex.PtrToString(d.Get("password").(string)),
+	}
+	if role, ok := d.GetOk("role"); ok {
+		user.Role = flex.PtrToString(role.(string))
+	}
+	createDatabaseUserOptions := &clouddatabasesv5.CreateDatabaseUserOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		User:     user,
+	}
+	createDatabaseUserResponse, response, err := cloudDatabasesClient.CreateDatabaseUserWithContext(context, createDatabaseUserOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateDatabaseUser (%s) failed %s\n%s", name, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", deploymentID, userType, name))
+
+	if _, err = waitForDatabaseTaskComplete(*createDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for database (%s) user (%s) create task to complete: %s", deploymentID, name, err))
+	}
+	d.Set("password_changed_at", time.Now().UTC().Format(time.RFC3339))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID/userType/userName", d.Id()))
+	}
+	deploymentID := parts[0]
+
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &deploymentID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) of user %s not found, removing the user from the state", deploymentID, parts[2])
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", deploymentID, err, response))
+	}
+
+	// The API does not list the users of a deployment, the user is checked with its connection, on the public or
+	// the private endpoint as the deployment may only have one of them
+	for _, endpointType := range []string{clouddatabasesv5.GetConnectionOptionsEndpointTypePublicConst, clouddatabasesv5.GetConnectionOptionsEndpointTypePrivateConst} {
+		_, response, err = cloudDatabasesClient.GetConnectionWithContext(context, &clouddatabasesv5.GetConnectionOptions{
+			ID:           &deploymentID,
+			UserType:     &parts[1],
+			UserID:       &parts[2],
+			EndpointType: &endpointType,
+		})
+		if err == nil {
+			break
+		}
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] User %s of database (%s) not found, removing it from the state", parts[2], deploymentID)
+			d.SetId("")
+			return nil
+		}
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the connection of user %s of database (%s): %s\n%s", parts[2], deploymentID, err, response))
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", parts[1])
+	d.Set("name", parts[2])
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("password") {
+		cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		changeUserPasswordOptions := &clouddatabasesv5.ChangeUserPasswordOptions{
+			ID:       flex.PtrToString(d.Get("deployment_id").(string)),
+			UserType: flex.PtrToString(d.Get("type").(string)),
+			Username: flex.PtrToString(d.Get("name").(string)),
+			User: &clouddatabasesv5.APasswordSettingUser{
+				Password: flex.PtrToString(d.Get("password").(string)),
+			},
+		}
+		changeUserPasswordResponse, response, err := cloudDatabasesClient.ChangeUserPasswordWithContext(context, changeUserPasswordOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] ChangeUserPassword (%s) failed %s\n%s", *changeUserPasswordOptions.Username, err, response))
+		}
+		if _, err = waitForDatabaseTaskComplete(*changeUserPasswordResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for database (%s) user (%s) password change task to complete: %s", *changeUserPasswordOptions.ID, *changeUserPasswordOptions.Username, err))
+		}
+		d.Set("password_changed_at", time.Now().UTC().Format(time.RFC3339))
+	}
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDatabaseUserOptions := &clouddatabasesv5.DeleteDatabaseUserOptions{
+		ID:       flex.PtrToString(d.Get("deployment_id").(string)),
+		UserType: flex.PtrToString(d.Get("type").(string)),
+		Username: flex.PtrToString(d.Get("name").(string)),
+	}
+	deleteDatabaseUserResponse, response, err := cloudDatabasesClient.DeleteDatabaseUserWithContext(context, deleteDatabaseUserOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteDatabaseUser (%s) failed %s\n%s", *deleteDatabaseUserOptions.Username, err, response))
+	}
+	if _, err = waitForDatabaseTaskComplete(*deleteDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for database (%s) user (%s) delete task to complete: %s", *deleteDatabaseUserOptions.ID, *deleteDatabaseUserOptions.Username, err))
+	}
+
+	d.SetId("")
+	return nil
+}
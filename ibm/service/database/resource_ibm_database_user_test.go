@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseUserBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+	name := "ibm_database_user.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseUserConfigBasic(testName, "password12345678"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "database"),
+					resource.TestCheckResourceAttr(name, "name", "tfuser01"),
+					resource.TestCheckResourceAttrSet(name, "password_changed_at"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseUserConfigBasic(testName, "password87654321"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "password", "password87654321"),
+					resource.TestCheckResourceAttrSet(name, "password_changed_at"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "password_changed_at"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseUserConfigBasic(name, password string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+	}
+
+	resource "ibm_database_user" "user" {
+		deployment_id = ibm_database.db.id
+		name          = "tfuser01"
+		password      = "%[3]s"
+	}
+	`, name, acc.IcdDbRegion, password)
+}
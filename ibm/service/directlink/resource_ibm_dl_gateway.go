@@ -903,7 +903,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf(
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(dlTags, tags)
+	d.Set(dlTags, flex.RemoveDefaultTags(meta, tags, d.Get(dlTags)))
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
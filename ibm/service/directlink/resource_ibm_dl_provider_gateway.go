@@ -319,7 +319,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(dlTags, tags)
+	d.Set(dlTags, flex.RemoveDefaultTags(meta, tags, d.Get(dlTags)))
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
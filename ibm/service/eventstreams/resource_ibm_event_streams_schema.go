@@ -329,6 +329,18 @@ func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, e
 	}
 	d.Set("kafka_http_url", adminURL)
 	log.Printf("[INFO]getInstanceURL kafka_http_url is set to %s", adminURL)
+	// The schema registry endpoint set in the environment or in the endpoints file is used for every instance
+	region := ""
+	if instance.RegionID != nil {
+		region = *instance.RegionID
+	}
+	overrideURL, err := meta.(conns.ClientSession).ResolveEndpoint("event_streams", region)
+	if err != nil {
+		return "", "", err
+	}
+	if overrideURL != "" {
+		return overrideURL, instanceCRN, nil
+	}
 	return adminURL, instanceCRN, nil
 }
 
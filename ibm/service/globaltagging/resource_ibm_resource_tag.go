@@ -227,6 +227,9 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set(resourceID, rID)
 	d.Set(resourceType, rType)
+	if strings.TrimSpace(tType) == "" || tType == "user" {
+		tagList = flex.RemoveDefaultTags(meta, tagList, d.Get(tags))
+	}
 	d.Set(tags, tagList)
 
 	return nil
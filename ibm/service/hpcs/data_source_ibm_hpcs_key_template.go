@@ -176,7 +176,7 @@ func DataSourceIbmKeyTemplateRead(context context.Context, d *schema.ResourceDat
 	getKeyTemplateOptions.SetID(template_id)
 	getKeyTemplateOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -221,7 +221,7 @@ func DataSourceIbmKeystoreRead(context context.Context, d *schema.ResourceData,
 	getKeystoreOptions.SetID(keystore_id)
 	getKeystoreOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -277,7 +277,7 @@ func DataSourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData
 	getManagedKeyOptions.SetID(key_id)
 	getManagedKeyOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -89,7 +89,7 @@ func DataSourceIbmVaultRead(context context.Context, d *schema.ResourceData, met
 
 	getVaultOptions.SetID(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
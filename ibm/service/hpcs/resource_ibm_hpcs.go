@@ -485,7 +485,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 		log.Printf(
 			"[ERROR] Error on get of HPCS instance tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
 	// Set Location
 	if instance.CRN != nil {
 		location := strings.Split(*instance.CRN, ":")
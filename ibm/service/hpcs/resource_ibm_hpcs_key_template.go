@@ -205,7 +205,7 @@ func ResourceIbmKeyTemplateCreate(context context.Context, d *schema.ResourceDat
 	instance_id := d.Get("instance_id").(string)
 	region := d.Get("region").(string)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -266,7 +266,7 @@ func ResourceIbmKeyTemplateRead(context context.Context, d *schema.ResourceData,
 	getKeyTemplateOptions.SetID(template_id)
 	getKeyTemplateOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -354,7 +354,7 @@ func ResourceIbmKeyTemplateUpdate(context context.Context, d *schema.ResourceDat
 	updateKeyTemplateOptions.SetID(template_id)
 	updateKeyTemplateOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -412,7 +412,7 @@ func ResourceIbmKeyTemplateDelete(context context.Context, d *schema.ResourceDat
 	vault_id := id[2]
 	template_id := id[3]
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
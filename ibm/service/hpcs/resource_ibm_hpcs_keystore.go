@@ -238,7 +238,7 @@ func ResourceIbmKeystoreCreate(context context.Context, d *schema.ResourceData,
 	instance_id := d.Get("instance_id").(string)
 	region := d.Get("region").(string)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -283,7 +283,7 @@ func ResourceIbmKeystoreRead(context context.Context, d *schema.ResourceData, me
 	getKeystoreOptions.SetID(keystore_id)
 	getKeystoreOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -416,7 +416,7 @@ func ResourceIbmKeystoreUpdate(context context.Context, d *schema.ResourceData,
 	updateKeystoreOptions.SetID(keystore_id)
 	updateKeystoreOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -463,7 +463,7 @@ func ResourceIbmKeystoreDelete(context context.Context, d *schema.ResourceData,
 	vault_id := id[2]
 	keystore_id := id[3]
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
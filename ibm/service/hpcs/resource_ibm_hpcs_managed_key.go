@@ -338,7 +338,7 @@ func ResourceIbmManagedKeyCreate(context context.Context, d *schema.ResourceData
 	instance_id := d.Get("instance_id").(string)
 	region := d.Get("region").(string)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -398,7 +398,7 @@ func ResourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData,
 	getManagedKeyOptions.SetID(key_id)
 	getManagedKeyOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -539,7 +539,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 	updateManagedKeyOptions.SetID(key_id)
 	updateManagedKeyOptions.SetUKOVault(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -654,7 +654,7 @@ func ResourceIbmManagedKeyDelete(context context.Context, d *schema.ResourceData
 	vault_id := id[2]
 	key_id := id[3]
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -137,7 +136,7 @@ func ResourceIbmVaultCreate(context context.Context, d *schema.ResourceData, met
 
 	createVaultOptions := &ukov4.CreateVaultOptions{}
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -173,7 +172,7 @@ func ResourceIbmVaultRead(context context.Context, d *schema.ResourceData, meta
 	vault_id := id[2]
 	getVaultOptions.SetID(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -234,7 +233,7 @@ func ResourceIbmVaultUpdate(context context.Context, d *schema.ResourceData, met
 	vault_id := id[2]
 	updateVaultOptions.SetID(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -282,7 +281,7 @@ func ResourceIbmVaultDelete(context context.Context, d *schema.ResourceData, met
 	vault_id := id[2]
 	deleteVaultOptions.SetID(vault_id)
 
-	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	url, err := getUkoUrl(context, meta, region, instance_id, ukoClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -299,29 +298,35 @@ func ResourceIbmVaultDelete(context context.Context, d *schema.ResourceData, met
 	return nil
 }
 
-func getUkoUrl(context context.Context, region string, instance_id string, ukoClient *ukov4.UkoV4) (string, error) {
+func getUkoUrl(context context.Context, meta interface{}, region string, instance_id string, ukoClient *ukov4.UkoV4) (string, error) {
 
 	// Get url
 	pathParamsMap := map[string]string{
 		"id": instance_id,
 	}
 
-	var brokerUrl string
-	if v := os.Getenv("IBMCLOUD_HPCS_UKO_URL"); v != "" {
-		if strings.Contains(v, "https://") {
-			return v, nil
+	// The UKO endpoint set in the environment or in the endpoints file is used for every instance
+	ukoUrl, err := meta.(conns.ClientSession).ResolveEndpoint("hpcs_uko", region)
+	if err != nil {
+		return "", err
+	}
+	if ukoUrl != "" {
+		if strings.Contains(ukoUrl, "https://") {
+			return ukoUrl, nil
 		} else {
-			return fmt.Sprintf("https://%s/", v), nil
+			return fmt.Sprintf("https://%s/", ukoUrl), nil
 		}
-	} else {
-		brokerUrl = fmt.Sprintf("https://%s.broker.hs-crypto.cloud.ibm.com", region)
+	}
+	brokerUrl, err := meta.(conns.ClientSession).ResolveEndpoint("hpcs", region)
+	if err != nil {
+		return "", err
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(context)
 	builder.EnableGzipCompression = ukoClient.GetEnableGzipCompression()
-	_, err := builder.ResolveRequestURL(brokerUrl,
-		`/crypto_v2/instances/{id}`, pathParamsMap)
+	_, err = builder.ResolveRequestURL(brokerUrl,
+		`/instances/{id}`, pathParamsMap)
 	if err != nil {
 		return "", err
 	}
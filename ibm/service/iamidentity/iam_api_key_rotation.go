@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes the API key resources keep the key they rotated away from in, until the overlap window elapses
+var previousAPIKeyAttributes = []string{"previous_apikey", "previous_apikey_id", "previous_apikey_expires_at"}
+
+// apiKeyRotationState is the part of schema.ResourceDiff the rotation is decided with, schema.ResourceData implements
+// it as well
+type apiKeyRotationState interface {
+	Id() string
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
+
+// apiKeyRotationSchema returns the rotation block and the attributes of the previous key of the API key resources
+func apiKeyRotationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"rotation": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"apikey"},
+			Description:   "Rotates the API key, the new key is created first and the previous key is deleted once the overlap window elapses.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"interval_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "Rotates the API key on the first apply after the key is older than this number of days.",
+					},
+					"rotation_trigger": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Rotates the API key whenever the value changes.",
+					},
+					"overlap_hours": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      24,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The number of hours the previous API key stays valid after a rotation. It is deleted on the first apply after the window elapses, 0 deletes it right away.",
+					},
+				},
+			},
+		},
+		"previous_apikey": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The value of the API key replaced by the last rotation, until the overlap window elapses.",
+		},
+		"previous_apikey_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the API key replaced by the last rotation, until the overlap window elapses.",
+		},
+		"previous_apikey_expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time in RFC 3339 format after which the previous API key is deleted.",
+		},
+		"rotated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time in RFC 3339 format of the last rotation of the API key.",
+		},
+	}
+}
+
+// apiKeyRotationCustomizeDiff plans the rotation of the API key and the deletion of the previous key, keys are the
+// computed attributes of the resource which change with a rotation
+func apiKeyRotationCustomizeDiff(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+		now := time.Now()
+		if apiKeyRotationDue(diff, now) {
+			for _, key := range append(keys, "apikey", "rotated_at", "previous_apikey", "previous_apikey_id", "previous_apikey_expires_at") {
+				if err := diff.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if previousAPIKeyExpired(diff, now) {
+			for _, key := range previousAPIKeyAttributes {
+				if err := diff.SetNew(key, ""); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// apiKeyRotationDue reports whether the API key must be rotated, because the rotation trigger changed or the key is
+// older than the rotation interval
+func apiKeyRotationDue(d apiKeyRotationState, now time.Time) bool {
+	if d.Id() == "" {
+		return false
+	}
+	oldRotation, newRotation := d.GetChange("rotation")
+	if len(newRotation.([]interface{})) == 0 || newRotation.([]interface{})[0] == nil {
+		return false
+	}
+	// Adding the rotation block to an existing key does not rotate it, only changing the trigger afterwards does
+	if len(oldRotation.([]interface{})) > 0 && d.HasChange("rotation.0.rotation_trigger") {
+		return true
+	}
+	intervalDays := d.Get("rotation.0.interval_days").(int)
+	if intervalDays == 0 {
+		return false
+	}
+	lastRotation, ok := apiKeyLastRotation(d)
+	if !ok {
+		return false
+	}
+	return now.After(lastRotation.Add(time.Duration(intervalDays) * 24 * time.Hour))
+}
+
+// apiKeyLastRotation returns the time the current API key was rotated at or, if it was never rotated, created at
+func apiKeyLastRotation(d apiKeyRotationState) (time.Time, bool) {
+	if rotatedAt, _ := d.GetChange("rotated_at"); rotatedAt.(string) != "" {
+		t, err := time.Parse(time.RFC3339, rotatedAt.(string))
+		return t, err == nil
+	}
+	createdAt, _ := d.GetChange("created_at")
+	if createdAt.(string) == "" {
+		return time.Time{}, false
+	}
+	t, err := strfmt.ParseDateTime(createdAt.(string))
+	return time.Time(t), err == nil
+}
+
+// previousAPIKeyExpired reports whether the overlap window of the previous API key elapsed
+func previousAPIKeyExpired(d apiKeyRotationState, now time.Time) bool {
+	previousID, _ := d.GetChange("previous_apikey_id")
+	expiresAt, _ := d.GetChange("previous_apikey_expires_at")
+	if previousID.(string) == "" || expiresAt.(string) == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt.(string))
+	return err == nil && !now.Before(t)
+}
+
+// rotateAPIKey creates the new API key with createAPIKeyOptions and makes it the current key of the resource. The
+// replaced key is kept as the previous key for the overlap window, a previous key which is still kept from an
+// earlier rotation is deleted first.
+func rotateAPIKey(client *iamidentityv1.IamIdentityV1, d *schema.ResourceData, createAPIKeyOptions *iamidentityv1.CreateAPIKeyOptions) error {
+	if previousID, _ := d.GetChange("previous_apikey_id"); previousID.(string) != "" {
+		if err := deleteAPIKey(client, previousID.(string)); err != nil {
+			return err
+		}
+	}
+
+	currentID := d.Id()
+	currentKey, _ := d.GetChange("apikey")
+
+	apiKey, response, err := client.CreateAPIKey(createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return fmt.Errorf("[ERROR] Error creating the rotated API key: %s\n%s", err, response)
+	}
+	log.Printf("[INFO] Rotated API key %s to %s", currentID, *apiKey.ID)
+
+	now := time.Now().UTC()
+	d.SetId(*apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+	d.Set("rotated_at", now.Format(time.RFC3339))
+
+	overlapHours := d.Get("rotation.0.overlap_hours").(int)
+	if overlapHours == 0 {
+		if err := deleteAPIKey(client, currentID); err != nil {
+			return err
+		}
+		clearPreviousAPIKey(d)
+	} else {
+		d.Set("previous_apikey", currentKey)
+		d.Set("previous_apikey_id", currentID)
+		d.Set("previous_apikey_expires_at", now.Add(time.Duration(overlapHours)*time.Hour).Format(time.RFC3339))
+	}
+
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+	return nil
+}
+
+// apiKeyRotationPlanned reports whether the plan being applied rotates the API key. The rotation is decided at plan
+// time by apiKeyRotationCustomizeDiff, it is not decided again at apply time so that the result matches the plan.
+func apiKeyRotationPlanned(d *schema.ResourceData) bool {
+	plan := d.GetRawPlan()
+	if plan.IsNull() || !plan.IsKnown() {
+		return false
+	}
+	return !plan.GetAttr("rotated_at").IsKnown()
+}
+
+// previousAPIKeyExpiryPlanned reports whether the plan being applied deletes the previous API key
+func previousAPIKeyExpiryPlanned(d *schema.ResourceData) bool {
+	previousID, newPreviousID := d.GetChange("previous_apikey_id")
+	return previousID.(string) != "" && newPreviousID.(string) == ""
+}
+
+// updateAPIKeyRotation rotates the API key or deletes the previous key as planned, createAPIKeyOptions returns the
+// options the new key is created with
+func updateAPIKeyRotation(client *iamidentityv1.IamIdentityV1, d *schema.ResourceData, createAPIKeyOptions func() (*iamidentityv1.CreateAPIKeyOptions, error)) error {
+	if apiKeyRotationPlanned(d) {
+		options, err := createAPIKeyOptions()
+		if err != nil {
+			return err
+		}
+		return rotateAPIKey(client, d, options)
+	}
+	if previousAPIKeyExpiryPlanned(d) {
+		previousID, _ := d.GetChange("previous_apikey_id")
+		if err := deleteAPIKey(client, previousID.(string)); err != nil {
+			return err
+		}
+		clearPreviousAPIKey(d)
+	}
+	return nil
+}
+
+// deletePreviousAPIKey deletes the previous API key kept by the resource, if any
+func deletePreviousAPIKey(client *iamidentityv1.IamIdentityV1, d *schema.ResourceData) error {
+	if previousID := d.Get("previous_apikey_id").(string); previousID != "" {
+		return deleteAPIKey(client, previousID)
+	}
+	return nil
+}
+
+func clearPreviousAPIKey(d *schema.ResourceData) {
+	for _, key := range previousAPIKeyAttributes {
+		d.Set(key, "")
+	}
+}
+
+// deleteAPIKey deletes the API key, a key which no longer exists is not an error
+func deleteAPIKey(client *iamidentityv1.IamIdentityV1, apiKeyID string) error {
+	response, err := client.DeleteAPIKey(&iamidentityv1.DeleteAPIKeyOptions{
+		ID: &apiKeyID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting API key %s: %s\n%s", apiKeyID, err, response)
+	}
+	return nil
+}
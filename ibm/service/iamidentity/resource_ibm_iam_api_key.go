@@ -17,11 +17,12 @@ import (
 )
 
 func ResourceIBMIAMApiKey() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: resourceIbmIamApiKeyCreate,
 		ReadContext:   resourceIbmIamApiKeyRead,
 		UpdateContext: resourceIbmIamApiKeyUpdate,
 		DeleteContext: resourceIbmIamApiKeyDelete,
+		CustomizeDiff: apiKeyRotationCustomizeDiff("apikey_id", "crn", "entity_tag", "created_at", "modified_at"),
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +107,10 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 			},
 		},
 	}
+	for key, value := range apiKeyRotationSchema() {
+		resource.Schema[key] = value
+	}
+	return resource
 }
 
 func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -114,31 +119,13 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	createApiKeyOptions := &iamidentityv1.CreateAPIKeyOptions{}
-
-	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	createApiKeyOptions, err := resourceIbmIamApiKeyCreateOptions(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	iamID := userDetails.UserID
-	accountID := userDetails.UserAccount
-
-	createApiKeyOptions.SetName(d.Get("name").(string))
-	createApiKeyOptions.SetIamID(iamID)
-	createApiKeyOptions.SetAccountID(accountID)
-
-	if _, ok := d.GetOk("description"); ok {
-		createApiKeyOptions.SetDescription(d.Get("description").(string))
-	}
 	if _, ok := d.GetOk("apikey"); ok {
 		createApiKeyOptions.SetApikey(d.Get("apikey").(string))
 	}
-	if _, ok := d.GetOk("store_value"); ok {
-		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
-	}
-	if _, ok := d.GetOk("locked"); ok {
-		createApiKeyOptions.SetEntityLock(d.Get("locked").(string))
-	}
 
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createApiKeyOptions)
 	if err != nil {
@@ -158,6 +145,33 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	return resourceIbmIamApiKeyRead(context, d, meta)
 }
 
+// resourceIbmIamApiKeyCreateOptions returns the options an API key is created with, on create and on rotation
+func resourceIbmIamApiKeyCreateOptions(d *schema.ResourceData, meta interface{}) (*iamidentityv1.CreateAPIKeyOptions, error) {
+	createApiKeyOptions := &iamidentityv1.CreateAPIKeyOptions{}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	iamID := userDetails.UserID
+	accountID := userDetails.UserAccount
+
+	createApiKeyOptions.SetName(d.Get("name").(string))
+	createApiKeyOptions.SetIamID(iamID)
+	createApiKeyOptions.SetAccountID(accountID)
+
+	if _, ok := d.GetOk("description"); ok {
+		createApiKeyOptions.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("store_value"); ok {
+		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
+	}
+	if _, ok := d.GetOk("locked"); ok {
+		createApiKeyOptions.SetEntityLock(d.Get("locked").(string))
+	}
+	return createApiKeyOptions, nil
+}
+
 func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -221,6 +235,13 @@ func resourceIbmIamApiKeyUpdate(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	err = updateAPIKeyRotation(iamIdentityClient, d, func() (*iamidentityv1.CreateAPIKeyOptions, error) {
+		return resourceIbmIamApiKeyCreateOptions(d, meta)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updateApiKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{}
 
 	updateApiKeyOptions.SetIfMatch("*")
@@ -244,6 +265,10 @@ func resourceIbmIamApiKeyDelete(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if err = deletePreviousAPIKey(iamIdentityClient, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deleteApiKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{}
 
 	deleteApiKeyOptions.SetID(d.Id())
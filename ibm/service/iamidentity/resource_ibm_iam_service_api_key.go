@@ -4,6 +4,7 @@
 package iamidentity
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -14,12 +15,13 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func ResourceIBMIAMServiceAPIKey() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create:   resourceIBMIAMServiceAPIkeyCreate,
 		Read:     resourceIBMIAMServiceAPIKeyRead,
 		Update:   resourceIBMIAMServiceAPIKeyUpdate,
@@ -27,6 +29,14 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 		Exists:   resourceIBMIAMServiceAPIKeyExists,
 		Importer: &schema.ResourceImporter{},
 
+		// apikey is not ForceNew in the schema, a rotation plans it as computed which would replace the resource
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ForceNewIfChange("apikey", func(ctx context.Context, old, new, meta interface{}) bool {
+				return true
+			}),
+			apiKeyRotationCustomizeDiff("crn", "entity_tag", "created_by", "created_at", "modified_at"),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -61,7 +71,6 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Sensitive:   true,
-				ForceNew:    true,
 				Description: "API key value for this API key",
 			},
 
@@ -117,6 +126,10 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 			},
 		},
 	}
+	for key, value := range apiKeyRotationSchema() {
+		resource.Schema[key] = value
+	}
+	return resource
 }
 func ResourceIBMIAMServiceAPIKeyValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -147,6 +160,36 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	createAPIKeyOptions, err := resourceIBMIAMServiceAPIKeyCreateOptions(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if key, ok := d.GetOk("apikey"); ok {
+		apikeyString := key.(string)
+		createAPIKeyOptions.Apikey = &apikeyString
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+	}
+
+	d.SetId(*apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+
+	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+}
+
+// resourceIBMIAMServiceAPIKeyCreateOptions returns the options a service API key is created with, on create and on
+// rotation
+func resourceIBMIAMServiceAPIKeyCreateOptions(d *schema.ResourceData, meta interface{}) (*iamidentityv1.CreateAPIKeyOptions, error) {
 	name := d.Get("name").(string)
 	iamID := d.Get("iam_service_id").(string)
 
@@ -162,15 +205,10 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	createAPIKeyOptions.AccountID = &userDetails.UserAccount
 
-	if key, ok := d.GetOk("apikey"); ok {
-		apikeyString := key.(string)
-		createAPIKeyOptions.Apikey = &apikeyString
-	}
-
 	if strvalue, ok := d.GetOk("store_value"); ok {
 		value := strvalue.(bool)
 		createAPIKeyOptions.StoreValue = &value
@@ -180,22 +218,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		elockstr := strconv.FormatBool(lock.(bool))
 		createAPIKeyOptions.EntityLock = &elockstr
 	}
-
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
-	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
-	}
-
-	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
-
-	if keyfile, ok := d.GetOk("file"); ok {
-		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
-			log.Printf("Error writing API Key Details to file: %s", err)
-		}
-	}
-
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return createAPIKeyOptions, nil
 }
 
 func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -260,6 +283,14 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+
+	err = updateAPIKeyRotation(iamIdentityClient, d, func() (*iamidentityv1.CreateAPIKeyOptions, error) {
+		return resourceIBMIAMServiceAPIKeyCreateOptions(d, meta)
+	})
+	if err != nil {
+		return err
+	}
+
 	apiKeyID := d.Id()
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
@@ -320,6 +351,10 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
 	}
 
+	if err = deletePreviousAPIKey(iamIdentityClient, d); err != nil {
+		return err
+	}
+
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
 		ID: &apiKeyID,
 	}
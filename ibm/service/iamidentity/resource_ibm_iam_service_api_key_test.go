@@ -4,11 +4,14 @@
 package iamidentity_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 
@@ -54,6 +57,143 @@ func TestAccIBMIAMServiceAPIKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceAPIKey_Rotation(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key.testacc_apiKey", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key.testacc_apiKey", "previous_apikey_id", ""),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key.testacc_apiKey", "name", name),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "previous_apikey_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "previous_apikey"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "previous_apikey_expires_at"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "rotated_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestIBMIAMServiceAPIKeyRotationDiff(t *testing.T) {
+	now := time.Now().UTC()
+	state := func(attributes map[string]string) *terraform.InstanceState {
+		base := map[string]string{
+			"id":                          "ApiKey-1",
+			"name":                        "key",
+			"iam_service_id":              "iam-ServiceId-1",
+			"apikey":                      "current",
+			"created_at":                  now.Add(-48 * time.Hour).Format("2006-01-02T15:04:05.000Z"),
+			"rotation.#":                  "1",
+			"rotation.0.interval_days":    "0",
+			"rotation.0.rotation_trigger": "1",
+			"rotation.0.overlap_hours":    "24",
+		}
+		for k, v := range attributes {
+			base[k] = v
+		}
+		return &terraform.InstanceState{ID: "ApiKey-1", Attributes: base}
+	}
+	config := func(rotation map[string]interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":           "key",
+			"iam_service_id": "iam-ServiceId-1",
+			"rotation":       []interface{}{rotation},
+		})
+	}
+
+	testCases := []struct {
+		name           string
+		state          *terraform.InstanceState
+		config         *terraform.ResourceConfig
+		rotate         bool
+		expirePrevious bool
+	}{
+		{
+			name:   "unchanged",
+			state:  state(nil),
+			config: config(map[string]interface{}{"rotation_trigger": "1"}),
+		},
+		{
+			name:   "trigger changed",
+			state:  state(nil),
+			config: config(map[string]interface{}{"rotation_trigger": "2"}),
+			rotate: true,
+		},
+		{
+			name:   "interval not elapsed",
+			state:  state(map[string]string{"rotation.0.interval_days": "3"}),
+			config: config(map[string]interface{}{"rotation_trigger": "1", "interval_days": 3}),
+		},
+		{
+			name:   "interval elapsed",
+			state:  state(map[string]string{"rotation.0.interval_days": "1"}),
+			config: config(map[string]interface{}{"rotation_trigger": "1", "interval_days": 1}),
+			rotate: true,
+		},
+		{
+			name: "interval elapsed since rotation",
+			state: state(map[string]string{
+				"rotation.0.interval_days": "1",
+				"rotated_at":               now.Add(-time.Hour).Format(time.RFC3339),
+			}),
+			config: config(map[string]interface{}{"rotation_trigger": "1", "interval_days": 1}),
+		},
+		{
+			name: "overlap window open",
+			state: state(map[string]string{
+				"previous_apikey_id":         "ApiKey-0",
+				"previous_apikey":            "previous",
+				"previous_apikey_expires_at": now.Add(time.Hour).Format(time.RFC3339),
+			}),
+			config: config(map[string]interface{}{"rotation_trigger": "1"}),
+		},
+		{
+			name: "overlap window elapsed",
+			state: state(map[string]string{
+				"previous_apikey_id":         "ApiKey-0",
+				"previous_apikey":            "previous",
+				"previous_apikey_expires_at": now.Add(-time.Hour).Format(time.RFC3339),
+			}),
+			config:         config(map[string]interface{}{"rotation_trigger": "1"}),
+			expirePrevious: true,
+		},
+	}
+
+	r := iamidentity.ResourceIBMIAMServiceAPIKey()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := r.Diff(context.Background(), tc.state, tc.config, nil)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			rotated := diff != nil && diff.Attributes["apikey"] != nil && diff.Attributes["apikey"].NewComputed
+			if rotated != tc.rotate {
+				t.Fatalf("expected rotation %t, got %t: %v", tc.rotate, rotated, diff)
+			}
+			if diff != nil && diff.RequiresNew() {
+				t.Fatalf("expected an in-place update: %v", diff)
+			}
+			expired := diff != nil && diff.Attributes["previous_apikey_id"] != nil
+			if !tc.rotate && expired != tc.expirePrevious {
+				t.Fatalf("expected the previous key expiry %t, got %t: %v", tc.expirePrevious, expired, diff)
+			}
+		})
+	}
+}
+
 func TestAccIBMIAMServiceAPIKey_import(t *testing.T) {
 	var apiKey string
 	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
@@ -146,6 +286,22 @@ func testAccCheckIBMIAMServiceAPIKeyBasic(serviceName, name string) string {
 	`, serviceName, name)
 }
 
+func testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, trigger string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+		resource "ibm_iam_service_api_key" "testacc_apiKey" {
+			name           = "%s"
+			iam_service_id = ibm_iam_service_id.serviceID.iam_id
+			rotation {
+				rotation_trigger = "%s"
+				overlap_hours    = 1
+			}
+		}
+	`, serviceName, name, trigger)
+}
+
 func testAccCheckIBMIAMServiceAPIKeyUpdateWithSameName(serviceName, name string) string {
 	return fmt.Sprintf(`
 		
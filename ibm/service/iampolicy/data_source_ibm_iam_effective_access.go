@@ -0,0 +1,513 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIAMEffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMEffectiveAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"subject_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"user", "service_id", "trusted_profile"}),
+				Description:  "Type of the subject, user, service_id or trusted_profile",
+			},
+
+			"subject_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IBMid or IAM ID of the user, ID or IAM ID of the service ID or trusted profile",
+			},
+
+			"target_crn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"target_crn", "target_attributes"},
+				Description:  "CRN of the resource the subject accesses",
+			},
+
+			"target_attributes": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"target_crn", "target_attributes"},
+				Description:  "Attributes of the resource the subject accesses, in addition to or in place of the attributes of the CRN, such as resourceGroupId",
+			},
+
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the subject",
+			},
+
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names granted to the subject on the target by all the matching policies",
+			},
+
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies of the subject and of its access groups which grant access to the target",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the policy",
+						},
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the access group the policy is inherited from, empty for a policy of the subject",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the policy",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Resource attributes of the policy",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+						"resource_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Access management tags of the policy, which are not evaluated against the target",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"access_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Access groups the subject is a member of, directly or through the dynamic rules of the access group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the access group",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the access group",
+						},
+					},
+				},
+			},
+
+			"cbr_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Context-based restriction rules which restrict the access to the target",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the rule",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the rule",
+						},
+						"enforcement_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Enforcement mode of the rule, the requests outside the rule contexts are denied when enabled and only reported when report",
+						},
+						"network_zone_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Network zones the requests are allowed from",
+						},
+						"endpoint_types": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Endpoint types the requests are allowed on, all endpoint types when empty",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMEffectiveAccessRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cbrClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	accountID := userDetails.UserAccount
+
+	subjectType := d.Get("subject_type").(string)
+	iamID := d.Get("subject_id").(string)
+	switch {
+	case subjectType == "user" && strings.Contains(iamID, "@"):
+		iamID, err = flex.GetIBMUniqueId(accountID, iamID, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	case subjectType != "user" && !strings.HasPrefix(iamID, "iam-"):
+		iamID = "iam-" + iamID
+	}
+
+	target, err := effectiveAccessTarget(d.Get("target_crn").(string), d.Get("target_attributes").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, ok := target["accountId"]; !ok {
+		target["accountId"] = accountID
+	}
+
+	// Policies of the subject
+	policies := []map[string]interface{}{}
+	roles := map[string]bool{}
+	subjectPolicies, err := listIAMEffectiveAccessPolicies(context, iamPolicyManagementClient, &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+		IamID:     core.StringPtr(iamID),
+		Type:      core.StringPtr("access"),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policies = append(policies, flattenIAMEffectiveAccessPolicies(subjectPolicies, target, "", roles)...)
+
+	// Policies of the access groups of the subject
+	groupsOptions := &iamaccessgroupsv2.ListAccessGroupsOptions{
+		AccountID: core.StringPtr(accountID),
+		IamID:     core.StringPtr(iamID),
+		Limit:     core.Int64Ptr(100),
+		Offset:    core.Int64Ptr(0),
+	}
+	if subjectType == "user" {
+		groupsOptions.MembershipType = core.StringPtr("all")
+	}
+	accessGroups := []map[string]interface{}{}
+	for {
+		groupsList, resp, err := iamAccessGroupsClient.ListAccessGroups(groupsOptions)
+		if err != nil || groupsList == nil {
+			return diag.Errorf("[ERROR] Error listing access groups of %s: %s\n%s", iamID, err, resp)
+		}
+		for _, group := range groupsList.Groups {
+			accessGroups = append(accessGroups, map[string]interface{}{
+				"id":   group.ID,
+				"name": group.Name,
+			})
+			groupPolicies, err := listIAMEffectiveAccessPolicies(context, iamPolicyManagementClient, &iampolicymanagementv1.ListPoliciesOptions{
+				AccountID:     core.StringPtr(accountID),
+				AccessGroupID: group.ID,
+				Type:          core.StringPtr("access"),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			policies = append(policies, flattenIAMEffectiveAccessPolicies(groupPolicies, target, *group.ID, roles)...)
+		}
+		*groupsOptions.Offset += int64(len(groupsList.Groups))
+		if len(groupsList.Groups) == 0 || groupsList.TotalCount == nil || *groupsOptions.Offset >= *groupsList.TotalCount {
+			break
+		}
+	}
+
+	// Context-based restriction rules of the target
+	ruleList, resp, err := cbrClient.ListRules(&contextbasedrestrictionsv1.ListRulesOptions{
+		AccountID: core.StringPtr(target["accountId"]),
+	})
+	if err != nil || ruleList == nil {
+		return diag.Errorf("[ERROR] Error listing context-based restriction rules: %s\n%s", err, resp)
+	}
+	cbrRules := []map[string]interface{}{}
+	for _, rule := range ruleList.Rules {
+		if !cbrRuleMatchesTarget(rule, target) {
+			continue
+		}
+		networkZoneIDs, endpointTypes := []string{}, []string{}
+		for _, ruleContext := range rule.Contexts {
+			for _, attribute := range ruleContext.Attributes {
+				switch *attribute.Name {
+				case "networkZoneId":
+					networkZoneIDs = append(networkZoneIDs, *attribute.Value)
+				case "endpointType":
+					endpointTypes = append(endpointTypes, *attribute.Value)
+				}
+			}
+		}
+		cbrRules = append(cbrRules, map[string]interface{}{
+			"id":               rule.ID,
+			"description":      rule.Description,
+			"enforcement_mode": rule.EnforcementMode,
+			"network_zone_ids": networkZoneIDs,
+			"endpoint_types":   endpointTypes,
+		})
+	}
+
+	roleNames := make([]string, 0, len(roles))
+	for role := range roles {
+		roleNames = append(roleNames, role)
+	}
+	sort.Strings(roleNames)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("iam_id", iamID)
+	d.Set("roles", roleNames)
+	d.Set("policies", policies)
+	d.Set("access_groups", accessGroups)
+	d.Set("cbr_rules", cbrRules)
+
+	return nil
+}
+
+// listIAMEffectiveAccessPolicies lists all the policies of the options, following the pages of the listing which the
+// options of the SDK do not expose
+func listIAMEffectiveAccessPolicies(context context.Context, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, listPoliciesOptions *iampolicymanagementv1.ListPoliciesOptions) ([]iampolicymanagementv1.Policy, error) {
+	policies := []iampolicymanagementv1.Policy{}
+	query := map[string]string{
+		"account_id": *listPoliciesOptions.AccountID,
+		"limit":      "100",
+	}
+	if listPoliciesOptions.IamID != nil {
+		query["iam_id"] = *listPoliciesOptions.IamID
+	}
+	if listPoliciesOptions.AccessGroupID != nil {
+		query["access_group_id"] = *listPoliciesOptions.AccessGroupID
+	}
+	if listPoliciesOptions.Type != nil {
+		query["type"] = *listPoliciesOptions.Type
+	}
+	for {
+		var page struct {
+			Policies []iampolicymanagementv1.Policy `json:"policies"`
+			Next     *struct {
+				Start string `json:"start"`
+			} `json:"next"`
+		}
+		resp, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.GET, "/v1/policies", nil, query, listPoliciesOptions.Headers, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing policies: %s\n%s", err, resp)
+		}
+		policies = append(policies, page.Policies...)
+		if page.Next == nil || page.Next.Start == "" || len(page.Policies) == 0 {
+			break
+		}
+		query["start"] = page.Next.Start
+	}
+	return policies, nil
+}
+
+// flattenIAMEffectiveAccessPolicies flattens the policies which grant access to the target and collects their roles
+func flattenIAMEffectiveAccessPolicies(policies []iampolicymanagementv1.Policy, target map[string]string, accessGroupID string, roles map[string]bool) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, policy := range policies {
+		matches := false
+		for _, policyResource := range policy.Resources {
+			if iamPolicyResourceMatchesTarget(policyResource, target) {
+				matches = true
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+		policyRoles := make([]string, 0, len(policy.Roles))
+		for _, role := range policy.Roles {
+			if role.DisplayName != nil {
+				policyRoles = append(policyRoles, *role.DisplayName)
+				roles[*role.DisplayName] = true
+			}
+		}
+		p := map[string]interface{}{
+			"id":                  policy.ID,
+			"access_group_id":     accessGroupID,
+			"roles":               policyRoles,
+			"resource_attributes": flex.FlattenPolicyResourceAttributes(policy.Resources),
+			"resource_tags":       flex.FlattenPolicyResourceTags(policy.Resources),
+		}
+		if policy.Description != nil {
+			p["description"] = policy.Description
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// effectiveAccessTarget returns the attributes of the target of an access, parsed from its CRN and overridden by the
+// given attributes, with the names of the policy resource attributes
+func effectiveAccessTarget(crn string, attributes map[string]interface{}) (map[string]string, error) {
+	target := map[string]string{}
+	if crn != "" {
+		segments := strings.Split(crn, ":")
+		if len(segments) != 10 || segments[0] != "crn" {
+			return nil, fmt.Errorf("[ERROR] The target CRN %s is not a CRN of the form crn:v1:<cloud>:<type>:<service>:<location>:<scope>:<instance>:<resource type>:<resource>", crn)
+		}
+		for name, segment := range map[string]int{
+			"serviceName":     4,
+			"region":          5,
+			"serviceInstance": 7,
+			"resourceType":    8,
+			"resource":        9,
+		} {
+			if segments[segment] != "" {
+				target[name] = segments[segment]
+			}
+		}
+		if strings.HasPrefix(segments[6], "a/") {
+			target["accountId"] = strings.TrimPrefix(segments[6], "a/")
+		}
+	}
+	for name, value := range attributes {
+		target[name] = value.(string)
+	}
+	return target, nil
+}
+
+// iamPolicyResourceMatchesTarget reports whether the resource of a policy grants access to the target, which is the
+// case when each attribute of the policy resource matches the attribute of the target with the same name. A policy
+// resource without a service name and with the service service type grants access to all IAM enabled services, the
+// service type of a target is only compared when it is set.
+func iamPolicyResourceMatchesTarget(policyResource iampolicymanagementv1.PolicyResource, target map[string]string) bool {
+	serviceType := *flex.GetResourceAttribute("serviceType", policyResource)
+	if targetServiceType, ok := target["serviceType"]; ok && serviceType != "" && serviceType != targetServiceType {
+		return false
+	}
+	for _, attribute := range policyResource.Attributes {
+		if attribute.Name == nil || attribute.Value == nil || *attribute.Name == "serviceType" {
+			continue
+		}
+		if !effectiveAccessAttributeMatches(*attribute.Name, attribute.Operator, *attribute.Value, target) {
+			return false
+		}
+	}
+	return true
+}
+
+// cbrRuleMatchesTarget reports whether one of the resources of a context-based restriction rule is the target
+func cbrRuleMatchesTarget(rule contextbasedrestrictionsv1.Rule, target map[string]string) bool {
+	for _, ruleResource := range rule.Resources {
+		matches := true
+		for _, attribute := range ruleResource.Attributes {
+			if attribute.Name == nil || attribute.Value == nil {
+				continue
+			}
+			if !effectiveAccessAttributeMatches(*attribute.Name, attribute.Operator, *attribute.Value, target) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// effectiveAccessAttributeMatches compares an attribute of a policy or rule with the attribute of the target, with the
+// stringEquals, stringMatch or stringExists operator
+func effectiveAccessAttributeMatches(name string, operator *string, value string, target map[string]string) bool {
+	targetValue, ok := target[name]
+	if operator == nil {
+		operator = core.StringPtr("stringEquals")
+	}
+	switch *operator {
+	case "stringExists":
+		return (value == "true") == (ok && targetValue != "")
+	case "stringMatch":
+		if !ok {
+			return false
+		}
+		pattern := regexp.QuoteMeta(value)
+		pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+		pattern = strings.ReplaceAll(pattern, `\?`, ".")
+		matched, err := regexp.MatchString("^"+pattern+"$", targetValue)
+		return err == nil && matched
+	default:
+		return ok && targetValue == value
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+func TestListIAMEffectiveAccessPolicies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/v1/policies" || query.Get("account_id") != "1234abcd" || query.Get("access_group_id") != "AccessGroupId-1" || query.Get("limit") != "100" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if r.Header.Get("User-Agent") != common.GetUserAgentInfo() {
+			t.Errorf("unexpected user agent %s", r.Header.Get("User-Agent"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("start") == "" {
+			fmt.Fprint(w, `{"policies": [{"id": "policy-1"}], "next": {"start": "page-2"}}`)
+			return
+		}
+		if query.Get("start") != "page-2" {
+			t.Errorf("unexpected start %s", query.Get("start"))
+		}
+		fmt.Fprint(w, `{"policies": [{"id": "policy-2"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	policies, err := listIAMEffectiveAccessPolicies(context.Background(), client, &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID:     core.StringPtr("1234abcd"),
+		AccessGroupID: core.StringPtr("AccessGroupId-1"),
+		Type:          core.StringPtr("access"),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(policies) != 2 || *policies[0].ID != "policy-1" || *policies[1].ID != "policy-2" {
+		t.Errorf("unexpected policies %v", policies)
+	}
+}
+
+func TestIBMIAMEffectiveAccessTarget(t *testing.T) {
+	target, err := effectiveAccessTarget("crn:v1:bluemix:public:cloud-object-storage:global:a/1234abcd:5678efgh:bucket:my-bucket", map[string]interface{}{
+		"resourceGroupId": "9012ijkl",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expected := map[string]string{
+		"serviceName":     "cloud-object-storage",
+		"region":          "global",
+		"accountId":       "1234abcd",
+		"serviceInstance": "5678efgh",
+		"resourceType":    "bucket",
+		"resource":        "my-bucket",
+		"resourceGroupId": "9012ijkl",
+	}
+	if len(target) != len(expected) {
+		t.Errorf("expected the target %v, got %v", expected, target)
+	}
+	for name, value := range expected {
+		if target[name] != value {
+			t.Errorf("expected the attribute %s of the target to be %q, got %q", name, value, target[name])
+		}
+	}
+
+	if _, err := effectiveAccessTarget("cloud-object-storage:bucket", nil); err == nil {
+		t.Errorf("expected an error for a target which is not a CRN")
+	}
+}
+
+func TestIBMIAMPolicyResourceMatchesTarget(t *testing.T) {
+	target := map[string]string{
+		"accountId":       "1234abcd",
+		"serviceName":     "cloud-object-storage",
+		"serviceInstance": "5678efgh",
+		"resourceType":    "bucket",
+		"resource":        "logs-2022",
+	}
+	attribute := func(name, operator, value string) iampolicymanagementv1.ResourceAttribute {
+		return iampolicymanagementv1.ResourceAttribute{
+			Name:     core.StringPtr(name),
+			Operator: core.StringPtr(operator),
+			Value:    core.StringPtr(value),
+		}
+	}
+	for _, c := range []struct {
+		name       string
+		attributes []iampolicymanagementv1.ResourceAttribute
+		matches    bool
+	}{
+		{"all IAM enabled services", []iampolicymanagementv1.ResourceAttribute{
+			attribute("accountId", "stringEquals", "1234abcd"),
+			attribute("serviceType", "stringEquals", "service"),
+		}, true},
+		{"service", []iampolicymanagementv1.ResourceAttribute{
+			attribute("accountId", "stringEquals", "1234abcd"),
+			attribute("serviceName", "stringEquals", "cloud-object-storage"),
+		}, true},
+		{"other service", []iampolicymanagementv1.ResourceAttribute{
+			attribute("accountId", "stringEquals", "1234abcd"),
+			attribute("serviceName", "stringEquals", "kms"),
+		}, false},
+		{"other account", []iampolicymanagementv1.ResourceAttribute{
+			attribute("accountId", "stringEquals", "9012ijkl"),
+			attribute("serviceName", "stringEquals", "cloud-object-storage"),
+		}, false},
+		{"wildcard resource", []iampolicymanagementv1.ResourceAttribute{
+			attribute("accountId", "stringEquals", "1234abcd"),
+			attribute("serviceName", "stringEquals", "cloud-object-storage"),
+			attribute("resource", "stringMatch", "logs-*"),
+		}, true},
+		{"unknown resource group", []iampolicymanagementv1.ResourceAttribute{
+			attribute("accountId", "stringEquals", "1234abcd"),
+			attribute("resourceGroupId", "stringEquals", "3456mnop"),
+		}, false},
+	} {
+		matches := iamPolicyResourceMatchesTarget(iampolicymanagementv1.PolicyResource{Attributes: c.attributes}, target)
+		if matches != c.matches {
+			t.Errorf("%s: expected the policy to match the target %t, got %t", c.name, c.matches, matches)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMEffectiveAccessDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMEffectiveAccessDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.access", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.access", "access_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.access", "roles.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.access", "roles.0", "Reader"),
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.access", "roles.1", "Viewer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMEffectiveAccessDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_iam_access_group" "accgrp" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_iam_access_group_members" "accgrpmem" {
+		access_group_id = ibm_iam_access_group.accgrp.id
+		iam_service_ids = [ibm_iam_service_id.serviceID.id]
+	}
+
+	resource "ibm_iam_service_policy" "policy" {
+		iam_service_id = ibm_iam_service_id.serviceID.id
+		roles          = ["Viewer"]
+		resources {
+			service = "cloud-object-storage"
+		}
+	}
+
+	resource "ibm_iam_access_group_policy" "policy" {
+		access_group_id = ibm_iam_access_group.accgrp.id
+		roles           = ["Reader"]
+		resources {
+			service = "cloud-object-storage"
+		}
+	}
+
+	data "ibm_iam_effective_access" "access" {
+		subject_type = "service_id"
+		subject_id   = ibm_iam_service_id.serviceID.iam_id
+		target_attributes = {
+			serviceName = "cloud-object-storage"
+		}
+		depends_on = [ibm_iam_access_group_members.accgrpmem, ibm_iam_service_policy.policy, ibm_iam_access_group_policy.policy]
+	}
+	`, name)
+}
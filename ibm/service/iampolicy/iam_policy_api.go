@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// iamPolicyAssignmentAPIVersion is the version of the policy assignment requests, sent in the version header
+const iamPolicyAssignmentAPIVersion = "1.0"
+
+// Statuses of a policy assignment and of the policies it creates in the target accounts
+const (
+	iamPolicyAssignmentStatusInProgress        = "in_progress"
+	iamPolicyAssignmentStatusSucceeded         = "succeeded"
+	iamPolicyAssignmentStatusSucceedWithErrors = "succeed_with_errors"
+	iamPolicyAssignmentStatusFailed            = "failed"
+)
+
+// iamPolicyAPIRequest makes a request of the IAM Policy Management API with the service of the IAM Policy Management
+// client and unmarshals the response into result, for the policy template and policy assignment APIs and the listing
+// options which are not part of the version of the Platform Services SDK the provider uses
+func iamPolicyAPIRequest(ctx context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, method, path string, pathParams map[string]string, query map[string]string, headers map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	for headerName, headerValue := range common.GetSdkHeaders("iam_policy_management", "V1", "Request") {
+		builder.AddHeader(headerName, headerValue)
+	}
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	for queryName, queryValue := range query {
+		builder.AddQuery(queryName, queryValue)
+	}
+	if body != nil {
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Content-Type", "application/json")
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// iamPolicyTemplate is a version of a policy template
+type iamPolicyTemplate struct {
+	ID          *string            `json:"id,omitempty"`
+	Name        *string            `json:"name,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	AccountID   *string            `json:"account_id,omitempty"`
+	Version     *string            `json:"version,omitempty"`
+	Committed   *bool              `json:"committed,omitempty"`
+	State       *string            `json:"state,omitempty"`
+	Policy      *iamTemplatePolicy `json:"policy,omitempty"`
+}
+
+// iamTemplatePolicy is the policy a policy template creates in the target accounts
+type iamTemplatePolicy struct {
+	Type        *string                    `json:"type"`
+	Description *string                    `json:"description,omitempty"`
+	Resource    *iamTemplatePolicyResource `json:"resource,omitempty"`
+	Control     *iamTemplatePolicyControl  `json:"control,omitempty"`
+}
+
+type iamTemplatePolicyResource struct {
+	Attributes []iamTemplatePolicyAttribute `json:"attributes"`
+	Tags       []iamTemplatePolicyAttribute `json:"tags,omitempty"`
+}
+
+type iamTemplatePolicyAttribute struct {
+	Key      *string `json:"key"`
+	Operator *string `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+type iamTemplatePolicyControl struct {
+	Grant *iamTemplatePolicyGrant `json:"grant"`
+}
+
+type iamTemplatePolicyGrant struct {
+	Roles []iamTemplatePolicyRole `json:"roles"`
+}
+
+type iamTemplatePolicyRole struct {
+	RoleID *string `json:"role_id"`
+}
+
+// iamPolicyAssignment is the assignment of a policy template version to an account or an account group of an
+// enterprise, with the policies it created in the target accounts
+type iamPolicyAssignment struct {
+	ID        *string                       `json:"id,omitempty"`
+	AccountID *string                       `json:"account_id,omitempty"`
+	Target    *iamPolicyAssignmentTarget    `json:"target,omitempty"`
+	Template  *iamPolicyAssignmentTemplate  `json:"template,omitempty"`
+	Status    *string                       `json:"status,omitempty"`
+	Resources []iamPolicyAssignmentResource `json:"resources,omitempty"`
+}
+
+type iamPolicyAssignmentTarget struct {
+	Type *string `json:"type"`
+	ID   *string `json:"id"`
+}
+
+type iamPolicyAssignmentTemplate struct {
+	ID      *string `json:"id"`
+	Version *string `json:"version"`
+}
+
+// iamPolicyAssignmentResource is the policy an assignment created in one of the target accounts
+type iamPolicyAssignmentResource struct {
+	Target *iamPolicyAssignmentTarget         `json:"target,omitempty"`
+	Policy *iamPolicyAssignmentResourcePolicy `json:"policy,omitempty"`
+}
+
+type iamPolicyAssignmentResourcePolicy struct {
+	ResourceCreated *iamPolicyAssignmentResourceCreated `json:"resource_created,omitempty"`
+	Status          *string                             `json:"status,omitempty"`
+	ErrorMessage    *iamPolicyAssignmentError           `json:"error_message,omitempty"`
+}
+
+type iamPolicyAssignmentResourceCreated struct {
+	ID *string `json:"id"`
+}
+
+type iamPolicyAssignmentError struct {
+	Name      *string `json:"name,omitempty"`
+	ErrorCode *string `json:"errorCode,omitempty"`
+	Message   *string `json:"message,omitempty"`
+}
+
+type iamPolicyAssignmentPrototype struct {
+	Target    iamPolicyAssignmentTarget     `json:"target"`
+	Options   iamPolicyAssignmentOptions    `json:"options"`
+	Templates []iamPolicyAssignmentTemplate `json:"templates"`
+}
+
+type iamPolicyAssignmentOptions struct {
+	Root iamPolicyAssignmentRootOptions `json:"root"`
+}
+
+type iamPolicyAssignmentRootOptions struct {
+	RequesterID *string `json:"requester_id"`
+}
+
+type iamPolicyAssignmentCollection struct {
+	PolicyAssignments []iamPolicyAssignment `json:"policy_assignments"`
+}
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -17,7 +18,7 @@ import (
 )
 
 func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create: resourceIBMIAMAccessGroupPolicyCreate,
 		Read:   resourceIBMIAMAccessGroupPolicyRead,
 		Update: resourceIBMIAMAccessGroupPolicyUpdate,
@@ -196,7 +197,12 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, resourceIBMIAMAccessGroupPolicyStateUpgradeV0)
+}
+
+// resourceIBMIAMAccessGroupPolicyStateUpgradeV0 prefixes the ID of policies stored with the policy ID only with the access group
+func resourceIBMIAMAccessGroupPolicyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return flex.UpgradeIDWithParent(rawState, "access_group_id")
 }
 
 func ResourceIBMIAMAccessGroupPolicyValidator() *validate.ResourceValidator {
@@ -4,12 +4,15 @@
 package iampolicy_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iampolicy"
 
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -828,3 +831,27 @@ func testAccCheckIBMIAMAccessGroupPolicyTransactionIdUpdate(name string) string
 	  	}
 	`, name)
 }
+
+func TestIBMIAMAccessGroupPolicyStateUpgradeV0(t *testing.T) {
+	r := iampolicy.ResourceIBMIAMAccessGroupPolicy()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("bad schema version %d with %d state upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	cases := map[string]string{
+		`{"id": "f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a", "access_group_id": "AccessGroupId-9a1b2c3d", "roles": ["Viewer"]}`:                        "AccessGroupId-9a1b2c3d/f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a",
+		`{"id": "AccessGroupId-9a1b2c3d/f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a", "access_group_id": "AccessGroupId-9a1b2c3d", "roles": ["Viewer"]}`: "AccessGroupId-9a1b2c3d/f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a",
+	}
+	for raw, id := range cases {
+		var rawState map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &rawState); err != nil {
+			t.Fatalf("Error decoding the raw state: %s", err)
+		}
+		actual, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+		if err != nil {
+			t.Fatalf("Error upgrading the state %s: %s", raw, err)
+		}
+		if actual["id"] != id {
+			t.Fatalf("bad id %q, expected %q", actual["id"], id)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMIAMPolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMPolicyAssignmentCreate,
+		ReadContext:   resourceIBMIAMPolicyAssignmentRead,
+		UpdateContext: resourceIBMIAMPolicyAssignmentUpdate,
+		DeleteContext: resourceIBMIAMPolicyAssignmentDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the policy template to assign",
+			},
+
+			"template_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Committed version of the policy template to assign, a change updates the policies in the target accounts",
+			},
+
+			"target_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"Account", "AccountGroup"}),
+				Description:  "Type of the target of the assignment, Account or AccountGroup",
+			},
+
+			"target_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the child account or account group of the enterprise the template is assigned to",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the enterprise account the assignment belongs to",
+			},
+
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the assignment, in_progress, succeeded, succeed_with_errors or failed",
+			},
+
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies created by the assignment in the target accounts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the target the policy was created in",
+						},
+						"target_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the account the policy was created in",
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the policy created in the account",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the policy in the account",
+						},
+						"error_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error the policy failed with in the account",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMIAMPolicyAssignmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prototype := &iamPolicyAssignmentPrototype{
+		Target: iamPolicyAssignmentTarget{
+			Type: core.StringPtr(d.Get("target_type").(string)),
+			ID:   core.StringPtr(d.Get("target_id").(string)),
+		},
+		Options: iamPolicyAssignmentOptions{
+			Root: iamPolicyAssignmentRootOptions{
+				RequesterID: &userDetails.UserID,
+			},
+		},
+		Templates: []iamPolicyAssignmentTemplate{
+			{
+				ID:      core.StringPtr(d.Get("template_id").(string)),
+				Version: core.StringPtr(d.Get("template_version").(string)),
+			},
+		},
+	}
+
+	assignments := &iamPolicyAssignmentCollection{}
+	headers := map[string]string{"version": iamPolicyAssignmentAPIVersion}
+	res, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_assignments", nil, nil, headers, prototype, assignments)
+	if err != nil || len(assignments.PolicyAssignments) == 0 || assignments.PolicyAssignments[0].ID == nil {
+		return diag.Errorf("[ERROR] Error creating policy assignment: %s\n%s", err, res)
+	}
+	d.SetId(*assignments.PolicyAssignments[0].ID)
+
+	_, err = waitForIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIAMPolicyAssignmentRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyAssignmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignment, res, err := getIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id())
+	if err != nil {
+		if res != nil && res.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving policy assignment: %s\n%s", err, res)
+	}
+
+	if assignment.Template != nil {
+		d.Set("template_id", assignment.Template.ID)
+		d.Set("template_version", assignment.Template.Version)
+	}
+	if assignment.Target != nil {
+		d.Set("target_type", assignment.Target.Type)
+		d.Set("target_id", assignment.Target.ID)
+	}
+	d.Set("account_id", assignment.AccountID)
+	d.Set("status", assignment.Status)
+	d.Set("resources", flattenIAMPolicyAssignmentResources(assignment.Resources))
+
+	return nil
+}
+
+func resourceIBMIAMPolicyAssignmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("template_version") {
+		_, res, err := getIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id())
+		if err != nil {
+			return diag.Errorf("[ERROR] Error retrieving policy assignment: %s\n%s", err, res)
+		}
+		headers := map[string]string{
+			"version":  iamPolicyAssignmentAPIVersion,
+			"If-Match": res.Headers.Get("ETag"),
+		}
+		body := map[string]interface{}{
+			"template_version": d.Get("template_version").(string),
+		}
+		pathParams := map[string]string{"assignment_id": d.Id()}
+		res, err = iamPolicyAPIRequest(context, iamPolicyManagementClient, core.PATCH, "/v1/policy_assignments/{assignment_id}", pathParams, nil, headers, body, &iamPolicyAssignment{})
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating policy assignment %s: %s\n%s", d.Id(), err, res)
+		}
+
+		_, err = waitForIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMPolicyAssignmentRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyAssignmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the assignment deletes the policies it created in the target accounts
+	headers := map[string]string{"version": iamPolicyAssignmentAPIVersion}
+	pathParams := map[string]string{"assignment_id": d.Id()}
+	res, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.DELETE, "/v1/policy_assignments/{assignment_id}", pathParams, nil, headers, nil, nil)
+	if err != nil && (res == nil || res.StatusCode != 404) {
+		return diag.Errorf("[ERROR] Error deleting policy assignment %s: %s\n%s", d.Id(), err, res)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getIAMPolicyAssignment(context context.Context, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, assignmentID string) (*iamPolicyAssignment, *core.DetailedResponse, error) {
+	headers := map[string]string{"version": iamPolicyAssignmentAPIVersion}
+	pathParams := map[string]string{"assignment_id": assignmentID}
+	assignment := &iamPolicyAssignment{}
+	res, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.GET, "/v1/policy_assignments/{assignment_id}", pathParams, nil, headers, nil, assignment)
+	return assignment, res, err
+}
+
+// waitForIAMPolicyAssignment waits for the policies of the assignment to be created or updated in all the target
+// accounts, an assignment which failed in some of the accounts only is kept with the status of each account
+func waitForIAMPolicyAssignment(context context.Context, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, assignmentID string, timeout time.Duration) (*iamPolicyAssignment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iamPolicyAssignmentStatusInProgress},
+		Target:  []string{iamPolicyAssignmentStatusSucceeded, iamPolicyAssignmentStatusSucceedWithErrors, iamPolicyAssignmentStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			assignment, res, err := getIAMPolicyAssignment(context, iamPolicyManagementClient, assignmentID)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving policy assignment %s: %s\n%s", assignmentID, err, res)
+			}
+			if assignment.Status == nil {
+				return assignment, iamPolicyAssignmentStatusInProgress, nil
+			}
+			return assignment, *assignment.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error waiting for policy assignment %s: %s", assignmentID, err)
+	}
+	assignment := result.(*iamPolicyAssignment)
+	switch *assignment.Status {
+	case iamPolicyAssignmentStatusFailed:
+		return assignment, fmt.Errorf("[ERROR] Policy assignment %s failed: %s", assignmentID, iamPolicyAssignmentErrors(assignment))
+	case iamPolicyAssignmentStatusSucceedWithErrors:
+		log.Printf("[WARN] Policy assignment %s failed in some of the target accounts: %s", assignmentID, iamPolicyAssignmentErrors(assignment))
+	}
+	return assignment, nil
+}
+
+// iamPolicyAssignmentErrors lists the accounts the policy of an assignment failed in, with their error
+func iamPolicyAssignmentErrors(assignment *iamPolicyAssignment) string {
+	errors := []string{}
+	for _, policyResource := range assignment.Resources {
+		if policyResource.Policy == nil || policyResource.Policy.Status == nil || *policyResource.Policy.Status != iamPolicyAssignmentStatusFailed {
+			continue
+		}
+		target := ""
+		if policyResource.Target != nil && policyResource.Target.ID != nil {
+			target = *policyResource.Target.ID
+		}
+		errors = append(errors, fmt.Sprintf("%s: %s", target, iamPolicyAssignmentErrorMessage(policyResource.Policy.ErrorMessage)))
+	}
+	return strings.Join(errors, ", ")
+}
+
+func iamPolicyAssignmentErrorMessage(errorMessage *iamPolicyAssignmentError) string {
+	if errorMessage == nil {
+		return ""
+	}
+	if errorMessage.Message != nil {
+		return *errorMessage.Message
+	}
+	if errorMessage.ErrorCode != nil {
+		return *errorMessage.ErrorCode
+	}
+	return ""
+}
+
+func flattenIAMPolicyAssignmentResources(resources []iamPolicyAssignmentResource) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, policyResource := range resources {
+		r := map[string]interface{}{}
+		if policyResource.Target != nil {
+			r["target_type"] = policyResource.Target.Type
+			r["target_id"] = policyResource.Target.ID
+		}
+		if policyResource.Policy != nil {
+			if policyResource.Policy.ResourceCreated != nil {
+				r["policy_id"] = policyResource.Policy.ResourceCreated.ID
+			}
+			r["status"] = policyResource.Policy.Status
+			r["error_message"] = iamPolicyAssignmentErrorMessage(policyResource.Policy.ErrorMessage)
+		}
+		result = append(result, r)
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMPolicyAssignment_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterpriseAccountImport(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyAssignmentConfig(name, "Viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "target_id", acc.Account_to_be_imported),
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "status", "succeeded"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "resources.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_iam_policy_assignment.assignment", "resources.0.policy_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyAssignmentConfig(name, "Editor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "template_version", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "status", "succeeded"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_policy_assignment.assignment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyAssignmentConfig(name, role string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_policy_template" "template" {
+		name      = "%s"
+		roles     = ["%s"]
+		resources {
+			service = "kms"
+		}
+		committed = true
+	}
+
+	resource "ibm_iam_policy_assignment" "assignment" {
+		template_id      = ibm_iam_policy_template.template.id
+		template_version = ibm_iam_policy_template.template.version
+		target_type      = "Account"
+		target_id        = "%s"
+	}
+	`, name, role, acc.Account_to_be_imported)
+}
@@ -0,0 +1,531 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMIAMPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMPolicyTemplateCreate,
+		ReadContext:   resourceIBMIAMPolicyTemplateRead,
+		UpdateContext: resourceIBMIAMPolicyTemplateUpdate,
+		DeleteContext: resourceIBMIAMPolicyTemplateDelete,
+		CustomizeDiff: resourceIBMIAMPolicyTemplateValidateCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMIAMPolicyTemplateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the policy template, shared by all its versions",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the policy template version",
+			},
+
+			"committed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Commit the policy template version, a committed version cannot be changed and any change creates a new version",
+			},
+
+			"roles": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names of the policy definition",
+			},
+
+			"resources": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_management"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service name of the policy definition",
+						},
+
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of resource instance of the policy definition",
+						},
+
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the policy definition",
+						},
+
+						"resource_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource type of the policy definition",
+						},
+
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource of the policy definition",
+						},
+
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the resource group.",
+						},
+
+						"service_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service type of the policy definition",
+						},
+
+						"attributes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Set resource attributes in the form of 'name=value,name=value....",
+							Elem:        schema.TypeString,
+						},
+					},
+				},
+			},
+
+			"account_management": {
+				Type:          schema.TypeBool,
+				Default:       false,
+				Optional:      true,
+				Description:   "Give access to all account management services",
+				ConflictsWith: []string{"resources"},
+			},
+
+			"resource_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set access management tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "stringEquals",
+							Description: "Operator of attribute.",
+						},
+					},
+				},
+			},
+
+			"policy_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the policies created from the template",
+			},
+
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the policy template",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the enterprise account the policy template belongs to",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the policy template version",
+			},
+		},
+	}
+}
+
+// resourceIBMIAMPolicyTemplateValidateCustomizeDiff checks the shape of the template roles and resources, the custom
+// attributes of the resources must not be system defined as FlattenPolicyResource reads them back into their own
+// argument, and the account of the policies is the target account of each assignment
+func resourceIBMIAMPolicyTemplateValidateCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	roles := map[string]bool{}
+	for _, role := range diff.Get("roles").([]interface{}) {
+		if role == nil {
+			continue
+		}
+		if roles[role.(string)] {
+			return fmt.Errorf("[ERROR] The role %s is set more than once in the roles of the policy template", role)
+		}
+		roles[role.(string)] = true
+	}
+
+	attributes, ok := diff.GetOk("resources.0.attributes")
+	if !ok {
+		return nil
+	}
+	for name := range attributes.(map[string]interface{}) {
+		if name == "accountId" {
+			return fmt.Errorf("[ERROR] The attribute accountId cannot be set in the resources of a policy template, the policies are created in the target accounts of the policy assignments")
+		}
+		if flex.IsPolicyResourceSystemAttribute(name) {
+			return fmt.Errorf("[ERROR] The attribute %s of the policy template resources is system defined, set it with the matching argument of resources", name)
+		}
+	}
+	return nil
+}
+
+func resourceIBMIAMPolicyTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := expandIAMPolicyTemplate(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	template.Name = core.StringPtr(d.Get("name").(string))
+	template.AccountID = &userDetails.UserAccount
+
+	policyTemplate := &iamPolicyTemplate{}
+	res, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_templates", nil, nil, nil, template, policyTemplate)
+	if err != nil || policyTemplate.ID == nil {
+		return diag.Errorf("[ERROR] Error creating policy template: %s\n%s", err, res)
+	}
+	d.SetId(*policyTemplate.ID)
+	if policyTemplate.Version != nil {
+		d.Set("version", *policyTemplate.Version)
+	}
+
+	return resourceIBMIAMPolicyTemplateRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyTemplate, res, err := getIAMPolicyTemplate(context, iamPolicyManagementClient, d.Id(), d.Get("version").(string))
+	if err != nil {
+		if res != nil && res.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving policy template: %s\n%s", err, res)
+	}
+
+	d.Set("name", policyTemplate.Name)
+	d.Set("description", policyTemplate.Description)
+	d.Set("version", policyTemplate.Version)
+	d.Set("account_id", policyTemplate.AccountID)
+	d.Set("state", policyTemplate.State)
+	if policyTemplate.Committed != nil {
+		d.Set("committed", *policyTemplate.Committed)
+	}
+
+	if policyTemplate.Policy != nil {
+		d.Set("policy_description", policyTemplate.Policy.Description)
+
+		policyResource := flattenIAMTemplatePolicyResource(policyTemplate.Policy.Resource)
+		if _, ok := d.GetOk("resources"); ok {
+			d.Set("resources", flex.FlattenPolicyResource([]iampolicymanagementv1.PolicyResource{policyResource}))
+		}
+		if _, ok := d.GetOk("resource_tags"); ok {
+			d.Set("resource_tags", flex.FlattenPolicyResourceTags([]iampolicymanagementv1.PolicyResource{policyResource}))
+		}
+		switch *flex.GetResourceAttribute("serviceType", policyResource) {
+		case "service":
+			d.Set("account_management", false)
+		case "platform_service":
+			d.Set("account_management", true)
+		}
+
+		if policyTemplate.Policy.Control != nil && policyTemplate.Policy.Control.Grant != nil {
+			roles, err := flattenIAMTemplatePolicyRoles(iamPolicyManagementClient, d.Get("account_id").(string), policyResource, policyTemplate.Policy.Control.Grant.Roles)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("roles", roles)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIAMPolicyTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateID := d.Id()
+	version := d.Get("version").(string)
+	pathParams := map[string]string{
+		"policy_template_id": templateID,
+		"version":            version,
+	}
+	policyChanges := []string{"description", "roles", "resources", "account_management", "resource_tags", "policy_description"}
+	oldCommitted, _ := d.GetChange("committed")
+
+	if oldCommitted.(bool) && d.HasChanges(append(policyChanges, "committed")...) {
+		// A committed version cannot be changed anymore, the changes are made in a new version of the template
+		template, err := expandIAMPolicyTemplate(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		template.Name = core.StringPtr(d.Get("name").(string))
+
+		policyTemplate := &iamPolicyTemplate{}
+		res, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_templates/{policy_template_id}/versions", pathParams, nil, nil, template, policyTemplate)
+		if err != nil || policyTemplate.Version == nil {
+			return diag.Errorf("[ERROR] Error creating version of policy template %s: %s\n%s", templateID, err, res)
+		}
+		d.Set("version", *policyTemplate.Version)
+	} else if d.HasChanges(policyChanges...) {
+		template, err := expandIAMPolicyTemplate(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		template.Name = core.StringPtr(d.Get("name").(string))
+
+		_, res, err := getIAMPolicyTemplate(context, iamPolicyManagementClient, templateID, version)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error retrieving policy template %s: %s\n%s", templateID, err, res)
+		}
+		headers := map[string]string{"If-Match": res.Headers.Get("ETag")}
+		res, err = iamPolicyAPIRequest(context, iamPolicyManagementClient, core.PUT, "/v1/policy_templates/{policy_template_id}/versions/{version}", pathParams, nil, headers, template, &iamPolicyTemplate{})
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating version %s of policy template %s: %s\n%s", version, templateID, err, res)
+		}
+	} else if d.HasChange("committed") {
+		_, res, err := getIAMPolicyTemplate(context, iamPolicyManagementClient, templateID, version)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error retrieving policy template %s: %s\n%s", templateID, err, res)
+		}
+		headers := map[string]string{"If-Match": res.Headers.Get("ETag")}
+		res, err = iamPolicyAPIRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_templates/{policy_template_id}/versions/{version}/commit", pathParams, nil, headers, nil, nil)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error committing version %s of policy template %s: %s\n%s", version, templateID, err, res)
+		}
+	}
+
+	return resourceIBMIAMPolicyTemplateRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the template deletes all its versions
+	pathParams := map[string]string{"policy_template_id": d.Id()}
+	res, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.DELETE, "/v1/policy_templates/{policy_template_id}", pathParams, nil, nil, nil, nil)
+	if err != nil && (res == nil || res.StatusCode != 404) {
+		return diag.Errorf("[ERROR] Error deleting policy template %s: %s\n%s", d.Id(), err, res)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMIAMPolicyTemplateImport imports a policy template with an ID of the form templateID/version, or the
+// latest version of the template with the template ID only
+func resourceIBMIAMPolicyTemplateImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) > 2 || parts[0] == "" {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a policy template ID or a combination of templateID/version", d.Id())
+	}
+	version := ""
+	if len(parts) == 2 {
+		version = parts[1]
+	}
+
+	policyTemplate, res, err := getIAMPolicyTemplate(context, iamPolicyManagementClient, parts[0], version)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving policy template: %s\n%s", err, res)
+	}
+	d.SetId(parts[0])
+	d.Set("version", policyTemplate.Version)
+	if policyTemplate.Policy != nil {
+		policyResource := flattenIAMTemplatePolicyResource(policyTemplate.Policy.Resource)
+		d.Set("resources", flex.FlattenPolicyResource([]iampolicymanagementv1.PolicyResource{policyResource}))
+		d.Set("resource_tags", flex.FlattenPolicyResourceTags([]iampolicymanagementv1.PolicyResource{policyResource}))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getIAMPolicyTemplate gets a version of a policy template, or its latest version without a version
+func getIAMPolicyTemplate(context context.Context, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, templateID, version string) (*iamPolicyTemplate, *core.DetailedResponse, error) {
+	path := "/v1/policy_templates/{policy_template_id}"
+	pathParams := map[string]string{"policy_template_id": templateID}
+	if version != "" {
+		path = "/v1/policy_templates/{policy_template_id}/versions/{version}"
+		pathParams["version"] = version
+	}
+	policyTemplate := &iamPolicyTemplate{}
+	res, err := iamPolicyAPIRequest(context, iamPolicyManagementClient, core.GET, path, pathParams, nil, nil, nil, policyTemplate)
+	return policyTemplate, res, err
+}
+
+// expandIAMPolicyTemplate builds the policy template version from the roles and resources, which are resolved the
+// same way as the ones of the other policies
+func expandIAMPolicyTemplate(d *schema.ResourceData, meta interface{}) (*iamPolicyTemplate, error) {
+	policyOptions, err := flex.GeneratePolicyOptions(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &iamTemplatePolicy{
+		Type: core.StringPtr("access"),
+		Resource: &iamTemplatePolicyResource{
+			Attributes: []iamTemplatePolicyAttribute{},
+		},
+		Control: &iamTemplatePolicyControl{
+			Grant: &iamTemplatePolicyGrant{
+				Roles: make([]iamTemplatePolicyRole, 0, len(policyOptions.Roles)),
+			},
+		},
+	}
+	if desc, ok := d.GetOk("policy_description"); ok {
+		policy.Description = core.StringPtr(desc.(string))
+	}
+	for _, attribute := range policyOptions.Resources[0].Attributes {
+		policy.Resource.Attributes = append(policy.Resource.Attributes, iamTemplatePolicyAttribute{
+			Key:      attribute.Name,
+			Operator: attribute.Operator,
+			Value:    attribute.Value,
+		})
+	}
+	for _, tag := range flex.SetTags(d) {
+		policy.Resource.Tags = append(policy.Resource.Tags, iamTemplatePolicyAttribute{
+			Key:      tag.Name,
+			Operator: tag.Operator,
+			Value:    tag.Value,
+		})
+	}
+	for _, role := range policyOptions.Roles {
+		policy.Control.Grant.Roles = append(policy.Control.Grant.Roles, iamTemplatePolicyRole{RoleID: role.RoleID})
+	}
+
+	template := &iamPolicyTemplate{
+		Committed: core.BoolPtr(d.Get("committed").(bool)),
+		Policy:    policy,
+	}
+	if desc, ok := d.GetOk("description"); ok {
+		template.Description = core.StringPtr(desc.(string))
+	}
+	return template, nil
+}
+
+// flattenIAMTemplatePolicyResource converts the resource of a template policy to a policy resource, so that it is
+// flattened like the resources of the other policies
+func flattenIAMTemplatePolicyResource(resource *iamTemplatePolicyResource) iampolicymanagementv1.PolicyResource {
+	policyResource := iampolicymanagementv1.PolicyResource{
+		Attributes: []iampolicymanagementv1.ResourceAttribute{},
+		Tags:       []iampolicymanagementv1.ResourceTag{},
+	}
+	if resource == nil {
+		return policyResource
+	}
+	for _, attribute := range resource.Attributes {
+		if attribute.Key == nil || attribute.Value == nil {
+			continue
+		}
+		policyResource.Attributes = append(policyResource.Attributes, iampolicymanagementv1.ResourceAttribute{
+			Name:     attribute.Key,
+			Value:    attribute.Value,
+			Operator: attribute.Operator,
+		})
+	}
+	for _, tag := range resource.Tags {
+		if tag.Key == nil || tag.Value == nil {
+			continue
+		}
+		policyResource.Tags = append(policyResource.Tags, iampolicymanagementv1.ResourceTag{
+			Name:     tag.Key,
+			Value:    tag.Value,
+			Operator: tag.Operator,
+		})
+	}
+	return policyResource
+}
+
+// flattenIAMTemplatePolicyRoles returns the names of the roles of a template policy, which only holds the role CRNs
+func flattenIAMTemplatePolicyRoles(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, accountID string, policyResource iampolicymanagementv1.PolicyResource, roles []iamTemplatePolicyRole) ([]string, error) {
+	serviceToQuery := *flex.GetResourceAttribute("serviceName", policyResource)
+	if serviceToQuery == "" && *flex.GetResourceAttribute("serviceType", policyResource) != "platform_service" {
+		serviceToQuery = "alliamserviceroles"
+	}
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &accountID,
+		ServiceName: &serviceToQuery,
+	}
+	roleList, res, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil || roleList == nil {
+		return nil, fmt.Errorf("[ERROR] Error listing roles: %s\n%s", err, res)
+	}
+	roleNames := map[string]string{}
+	for _, role := range flex.MapRoleListToPolicyRoles(*roleList) {
+		if role.RoleID != nil && role.DisplayName != nil {
+			roleNames[*role.RoleID] = *role.DisplayName
+		}
+	}
+
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role.RoleID == nil {
+			continue
+		}
+		if name, ok := roleNames[*role.RoleID]; ok {
+			names = append(names, name)
+		} else {
+			names = append(names, (*role.RoleID)[strings.LastIndex(*role.RoleID, ":")+1:])
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iampolicy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMPolicyTemplate_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Viewer", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "resources.0.service", "kms"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Editor", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "roles.0", "Editor"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "committed", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Viewer", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "version", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "roles.0", "Viewer"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_policy_template.template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIBMIAMPolicyTemplateValidation(t *testing.T) {
+	r := iampolicy.ResourceIBMIAMPolicyTemplate()
+	for _, c := range []struct {
+		roles      []interface{}
+		attributes map[string]interface{}
+		err        string
+	}{
+		{[]interface{}{"Viewer"}, map[string]interface{}{"keyRing": "default"}, ""},
+		{[]interface{}{"Viewer", "Viewer"}, nil, "more than once"},
+		{[]interface{}{"Viewer"}, map[string]interface{}{"serviceInstance": "1234abcd"}, "system defined"},
+		{[]interface{}{"Viewer"}, map[string]interface{}{"accountId": "1234abcd"}, "target accounts"},
+	} {
+		resources := map[string]interface{}{
+			"service": "kms",
+		}
+		if c.attributes != nil {
+			resources["attributes"] = c.attributes
+		}
+		raw := map[string]interface{}{
+			"name":      "template",
+			"roles":     c.roles,
+			"resources": []interface{}{resources},
+		}
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+		if c.err == "" && err != nil {
+			t.Errorf("roles %v attributes %v: unexpected error %s", c.roles, c.attributes, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("roles %v attributes %v: expected an error containing %q, got %v", c.roles, c.attributes, c.err, err)
+		}
+	}
+}
+
+func testAccCheckIBMIAMPolicyTemplateConfig(name, role string, committed bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_policy_template" "template" {
+		name        = "%s"
+		description = "Policy template for the enterprise accounts"
+		roles       = ["%s"]
+		resources {
+			service = "kms"
+		}
+		committed = %t
+	}
+	`, name, role, committed)
+}
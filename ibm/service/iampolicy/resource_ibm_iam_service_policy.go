@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -19,7 +20,7 @@ import (
 )
 
 func ResourceIBMIAMServicePolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create: resourceIBMIAMServicePolicyCreate,
 		Read:   resourceIBMIAMServicePolicyRead,
 		Update: resourceIBMIAMServicePolicyUpdate,
@@ -200,7 +201,12 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				Description: "Set transactionID for debug",
 			},
 		},
-	}
+	}, resourceIBMIAMServicePolicyStateUpgradeV0)
+}
+
+// resourceIBMIAMServicePolicyStateUpgradeV0 prefixes the ID of policies stored with the policy ID only with the service ID
+func resourceIBMIAMServicePolicyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return flex.UpgradeIDWithParent(rawState, "iam_service_id", "iam_id")
 }
 
 func ResourceIBMIAMServicePolicyValidator() *validate.ResourceValidator {
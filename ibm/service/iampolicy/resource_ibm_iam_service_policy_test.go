@@ -4,12 +4,15 @@
 package iampolicy_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iampolicy"
 
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -750,3 +753,36 @@ func testAccCheckIBMIAMServicePolicyResourceTransactionIdUpdate(name string) str
 	  }
 	`, name)
 }
+
+func TestIBMIAMServicePolicyStateUpgradeV0(t *testing.T) {
+	r := iampolicy.ResourceIBMIAMServicePolicy()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("bad schema version %d with %d state upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	cases := map[string]string{
+		`{"id": "f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a", "iam_service_id": "ServiceId-1c2d3e4f", "iam_id": ""}`:                      "ServiceId-1c2d3e4f/f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a",
+		`{"id": "f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a", "iam_id": "iam-ServiceId-1c2d3e4f"}`:                                        "iam-ServiceId-1c2d3e4f/f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a",
+		`{"id": "ServiceId-1c2d3e4f/f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a", "iam_service_id": "ServiceId-1c2d3e4f", "iam_id": null}`: "ServiceId-1c2d3e4f/f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a",
+	}
+	for raw, id := range cases {
+		var rawState map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &rawState); err != nil {
+			t.Fatalf("Error decoding the raw state: %s", err)
+		}
+		actual, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+		if err != nil {
+			t.Fatalf("Error upgrading the state %s: %s", raw, err)
+		}
+		if actual["id"] != id {
+			t.Fatalf("bad id %q, expected %q", actual["id"], id)
+		}
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"id": "f3e6e5c2-0d3a-4f4e-b5d5-1d2a9f8c7b6a"}`), &rawState); err != nil {
+		t.Fatalf("Error decoding the raw state: %s", err)
+	}
+	if _, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil); err == nil {
+		t.Fatalf("expected an error when neither iam_service_id nor iam_id is set")
+	}
+}
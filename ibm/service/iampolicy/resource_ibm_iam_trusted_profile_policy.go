@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -19,7 +20,7 @@ import (
 )
 
 func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create: resourceIBMIAMTrustedProfilePolicyCreate,
 		Read:   resourceIBMIAMTrustedProfilePolicyRead,
 		Update: resourceIBMIAMTrustedProfilePolicyUpdate,
@@ -200,7 +201,12 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				Description: "Set transactionID for debug",
 			},
 		},
-	}
+	}, resourceIBMIAMTrustedProfilePolicyStateUpgradeV0)
+}
+
+// resourceIBMIAMTrustedProfilePolicyStateUpgradeV0 prefixes the ID of policies stored with the policy ID only with the trusted profile
+func resourceIBMIAMTrustedProfilePolicyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return flex.UpgradeIDWithParent(rawState, "profile_id", "iam_id")
 }
 
 func ResourceIBMIAMTrustedProfilePolicyValidator() *validate.ResourceValidator {
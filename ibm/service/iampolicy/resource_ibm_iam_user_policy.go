@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -16,7 +17,7 @@ import (
 )
 
 func ResourceIBMIAMUserPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create: resourceIBMIAMUserPolicyCreate,
 		Read:   resourceIBMIAMUserPolicyRead,
 		Update: resourceIBMIAMUserPolicyUpdate,
@@ -187,7 +188,12 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				Description: "Set transactionID for debug",
 			},
 		},
-	}
+	}, resourceIBMIAMUserPolicyStateUpgradeV0)
+}
+
+// resourceIBMIAMUserPolicyStateUpgradeV0 prefixes the ID of policies stored with the policy ID only with the user
+func resourceIBMIAMUserPolicyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return flex.UpgradeIDWithParent(rawState, "ibm_id")
 }
 
 func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) error {
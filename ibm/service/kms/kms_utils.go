@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+)
+
+// ValidateKMSRootKeyMaterial checks that the key material of a root key is a 128, 192 or 256 bits AES key
+func ValidateKMSRootKeyMaterial(keyMaterial []byte) error {
+	switch len(keyMaterial) {
+	case 16, 24, 32:
+		return nil
+	}
+	return fmt.Errorf("[ERROR] The key material of a root key must be 16, 24 or 32 bytes long, got %d bytes", len(keyMaterial))
+}
+
+// WrapKMSKeyMaterial encrypts the key material with RSA-OAEP and SHA-256 using the public key of an import token,
+// which is the base64 encoded PEM returned by the service, and returns the base64 encoded payload of the key
+func WrapKMSKeyMaterial(publicKey string, keyMaterial []byte) (string, error) {
+	decodedPublicKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error decoding the public key of the import token: %s", err)
+	}
+	block, _ := pem.Decode(decodedPublicKey)
+	if block == nil {
+		return "", fmt.Errorf("[ERROR] The public key of the import token is not PEM encoded")
+	}
+	parsedPublicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error parsing the public key of the import token: %s", err)
+	}
+	rsaPublicKey, ok := parsedPublicKey.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("[ERROR] The public key of the import token is not an RSA key")
+	}
+	payload, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPublicKey, keyMaterial, nil)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error wrapping the key material: %s", err)
+	}
+	return base64.StdEncoding.EncodeToString(payload), nil
+}
+
+// EncryptKMSImportTokenNonce encrypts the base64 encoded nonce of an import token with the key material, with
+// AES-GCM for Key Protect or with AES-CBC and PKCS#7 padding for Hyper Protect Crypto Services, and returns the
+// base64 encoded encrypted nonce and initialization vector
+func EncryptKMSImportTokenNonce(keyMaterial []byte, nonce string, cbc bool) (encryptedNonce string, iv string, err error) {
+	decodedNonce, err := base64.StdEncoding.DecodeString(nonce)
+	if err != nil {
+		return "", "", fmt.Errorf("[ERROR] Error decoding the nonce of the import token: %s", err)
+	}
+	block, err := aes.NewCipher(keyMaterial)
+	if err != nil {
+		return "", "", fmt.Errorf("[ERROR] Error creating the cipher of the key material: %s", err)
+	}
+
+	var cipherText, ivBytes []byte
+	if cbc {
+		padding := aes.BlockSize - len(decodedNonce)%aes.BlockSize
+		padded := append(decodedNonce, bytes.Repeat([]byte{byte(padding)}, padding)...)
+		ivBytes = make([]byte, aes.BlockSize)
+		if _, err := io.ReadFull(rand.Reader, ivBytes); err != nil {
+			return "", "", fmt.Errorf("[ERROR] Error generating the initialization vector: %s", err)
+		}
+		cipherText = make([]byte, len(padded))
+		cipher.NewCBCEncrypter(block, ivBytes).CryptBlocks(cipherText, padded)
+	} else {
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return "", "", fmt.Errorf("[ERROR] Error creating the cipher of the key material: %s", err)
+		}
+		ivBytes = make([]byte, gcm.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, ivBytes); err != nil {
+			return "", "", fmt.Errorf("[ERROR] Error generating the initialization vector: %s", err)
+		}
+		cipherText = gcm.Seal(nil, ivBytes, decodedNonce, nil)
+	}
+	return base64.StdEncoding.EncodeToString(cipherText), base64.StdEncoding.EncodeToString(ivBytes), nil
+}
@@ -0,0 +1,104 @@
+package kms_test
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kms"
+)
+
+func TestWrapKMSKeyMaterial(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}))
+	keyMaterial := bytes.Repeat([]byte{0x2a}, 32)
+
+	payload, err := kms.WrapKMSKeyMaterial(publicKey, keyMaterial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unwrapped, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privateKey, wrapped, nil)
+	if err != nil {
+		t.Fatalf("the payload is not RSA-OAEP SHA-256 encrypted: %s", err)
+	}
+	if !bytes.Equal(unwrapped, keyMaterial) {
+		t.Errorf("expected the key material %x, got %x", keyMaterial, unwrapped)
+	}
+
+	if _, err := kms.WrapKMSKeyMaterial(base64.StdEncoding.EncodeToString([]byte("not a pem")), keyMaterial); err == nil {
+		t.Error("expected an error for a public key which is not PEM encoded")
+	}
+}
+
+func TestEncryptKMSImportTokenNonce(t *testing.T) {
+	keyMaterial := bytes.Repeat([]byte{0x2a}, 32)
+	nonce := []byte("0123456789ab")
+	block, err := aes.NewCipher(keyMaterial)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encryptedNonce, iv, err := kms.EncryptKMSImportTokenNonce(keyMaterial, base64.StdEncoding.EncodeToString(nonce), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cipherText, _ := base64.StdEncoding.DecodeString(encryptedNonce)
+	ivBytes, _ := base64.StdEncoding.DecodeString(iv)
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := gcm.Open(nil, ivBytes, cipherText, nil)
+	if err != nil {
+		t.Fatalf("the nonce is not AES-GCM encrypted: %s", err)
+	}
+	if !bytes.Equal(decrypted, nonce) {
+		t.Errorf("expected the nonce %x, got %x", nonce, decrypted)
+	}
+
+	encryptedNonce, iv, err = kms.EncryptKMSImportTokenNonce(keyMaterial, base64.StdEncoding.EncodeToString(nonce), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cipherText, _ = base64.StdEncoding.DecodeString(encryptedNonce)
+	ivBytes, _ = base64.StdEncoding.DecodeString(iv)
+	if len(ivBytes) != aes.BlockSize || len(cipherText)%aes.BlockSize != 0 {
+		t.Fatalf("unexpected AES-CBC iv length %d and ciphertext length %d", len(ivBytes), len(cipherText))
+	}
+	decrypted = make([]byte, len(cipherText))
+	cipher.NewCBCDecrypter(block, ivBytes).CryptBlocks(decrypted, cipherText)
+	padding := int(decrypted[len(decrypted)-1])
+	if !bytes.Equal(decrypted[:len(decrypted)-padding], nonce) {
+		t.Errorf("expected the nonce %x, got %x", nonce, decrypted[:len(decrypted)-padding])
+	}
+}
+
+func TestValidateKMSRootKeyMaterial(t *testing.T) {
+	for length, valid := range map[int]bool{16: true, 24: true, 32: true, 0: false, 20: false, 64: false} {
+		err := kms.ValidateKMSRootKeyMaterial(make([]byte, length))
+		if valid && err != nil {
+			t.Errorf("%d bytes: unexpected error %s", length, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("%d bytes: expected an error", length)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsImportToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsImportTokenCreate,
+		ReadContext:   resourceIBMKmsImportTokenRead,
+		DeleteContext: resourceIBMKmsImportTokenDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      600,
+				ValidateFunc: validate.ValidateAllowedRangeInt(300, 86400),
+				Description:  "The time in seconds from the creation of the import token that determines how long its associated public key remains valid.",
+			},
+			"max_allowed_retrievals": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 500),
+				Description:  "The number of times the public key of the import token can be retrieved, each key import retrieves it once.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token was created. The date format follows RFC 3339.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token expires. The date format follows RFC 3339.",
+			},
+			"remaining_retrievals": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of retrievals of the public key left when the import token was created.",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsImportTokenCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	token, err := kpAPI.CreateImportToken(context, d.Get("expiration").(int), d.Get("max_allowed_retrievals").(int))
+	if err != nil {
+		return diag.Errorf("[ERROR] Error while creating the import token: %s", err)
+	}
+	d.SetId(instanceID)
+	d.Set("instance_id", instanceID)
+	if token.CreationDate != nil {
+		d.Set("creation_date", token.CreationDate.Format(time.RFC3339))
+	}
+	if token.ExpirationDate != nil {
+		d.Set("expiration_date", token.ExpirationDate.Format(time.RFC3339))
+	}
+	d.Set("remaining_retrievals", token.RemainingRetrievals)
+
+	return resourceIBMKmsImportTokenRead(context, d, meta)
+}
+
+func resourceIBMKmsImportTokenRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Retrieving the import token consumes one of its retrievals and the client has no call for its status, so the
+	// token is not read back from the service. An expired token is removed from the state so that it is created again
+	// by the next apply, the keys only read the import token when they are imported.
+	expirationDate, err := time.Parse(time.RFC3339, d.Get("expiration_date").(string))
+	if err == nil && time.Now().After(expirationDate) {
+		log.Printf("[WARN] The import token of the instance %s expired at %s, removing it from the state", d.Id(), expirationDate)
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceIBMKmsImportTokenDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	//Do not support delete Import Token
+	log.Println("Warning:  `terraform destroy` does not remove the import token of the instance but only clears the state file. The import token expires at its expiration date.")
+	d.SetId("")
+	return nil
+}
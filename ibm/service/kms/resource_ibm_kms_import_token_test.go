@@ -0,0 +1,56 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSImportToken_import_key_check(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	// base64 encoded 256 bits key material
+	keyMaterial := "wsY6Z5xNCq2xCSJj4zgOPuRcVb4hDxIGRU9PkSDp4rc="
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, keyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.token", "expiration_date"),
+					resource.TestCheckResourceAttr("ibm_kms_import_token.token", "max_allowed_retrievals", "1"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_name", keyName),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "standard_key", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsImportTokenConfig(instanceName, KeyName, keyMaterial string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	  }
+
+	  resource "ibm_kms_import_token" "token" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+	  }
+
+	  resource "ibm_kms_key" "test" {
+		instance_id     = ibm_resource_instance.kp_instance.guid
+		key_name        = "%s"
+		standard_key    = false
+		key_material    = "%s"
+		import_token_id = ibm_kms_import_token.token.id
+	  }
+`, instanceName, KeyName, keyMaterial)
+}
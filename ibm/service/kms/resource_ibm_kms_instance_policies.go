@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var kmsInstancePolicyNames = []string{"dual_auth_delete", "allowed_network", "allowed_ip", "key_create_import_access", "metrics"}
+
+func ResourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsInstancePoliciesCreate,
+		ReadContext:   resourceIBMKmsInstancePoliciesRead,
+		UpdateContext: resourceIBMKmsInstancePoliciesUpdate,
+		DeleteContext: resourceIBMKmsInstancePoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"dual_auth_delete": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyNames,
+				Description:  "Data associated with the dual authorization delete policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect enables a dual authorization policy for the deletion of all the keys of the instance.",
+						},
+					},
+				},
+			},
+			"allowed_network": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyNames,
+				Description:  "Data associated with the allowed network policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect restricts the network access to the instance.",
+						},
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"public-and-private", "private-only"}),
+							Description:  "The network the instance is accessible from, public-and-private or private-only.",
+						},
+					},
+				},
+			},
+			"allowed_ip": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyNames,
+				Description:  "Data associated with the allowed IP policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect only accepts requests from the allowed IP addresses.",
+						},
+						"ip_addresses": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The IPv4 or IPv6 addresses and CIDR ranges the requests are accepted from.",
+						},
+					},
+				},
+			},
+			"key_create_import_access": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyNames,
+				Description:  "Data associated with the key create import access policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect restricts the keys which can be created or imported in the instance.",
+						},
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be created in the instance.",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be created in the instance.",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be imported in the instance.",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be imported in the instance.",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, keys can only be imported with an import token.",
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyNames,
+				Description:  "Data associated with the metrics policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect sends the operational metrics of the instance to IBM Cloud Monitoring.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsInstancePoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceHandleInstancePolicies(context, d, kpAPI, kmsInstancePolicyNames)
+	if err != nil {
+		return diag.Errorf("Could not create instance policies: %s", err)
+	}
+	d.SetId(instanceID)
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := kpAPI.GetInstancePolicies(context)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read instance policies: %s", err)
+	}
+
+	d.Set("instance_id", instanceID)
+	if strings.Contains((kpAPI.URL).String(), "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	for name, policy := range flattenKmsInstancePolicies(policies) {
+		d.Set(name, policy)
+	}
+
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	changed := make([]string, 0, len(kmsInstancePolicyNames))
+	for _, name := range kmsInstancePolicyNames {
+		if d.HasChange(name) {
+			changed = append(changed, name)
+		}
+	}
+
+	if len(changed) > 0 {
+		kpAPI, _, err := populateKPClient(d, meta, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = resourceHandleInstancePolicies(context, d, kpAPI, changed)
+		if err != nil {
+			return diag.Errorf("Could not update instance policies: %s", err)
+		}
+	}
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	//Do not support delete Policies
+	log.Println("Warning:  `terraform destroy` does not remove the policies of the instance but only clears the state file. Instance policies get deleted when the associated instance is destroyed.")
+	d.SetId("")
+	return nil
+}
+
+// resourceHandleInstancePolicies sets the given instance policies in one request
+func resourceHandleInstancePolicies(context context.Context, d *schema.ResourceData, kpAPI *kp.Client, names []string) error {
+	policies := expandKmsInstancePolicies(d, names)
+	if policies.DualAuthDelete == nil && policies.AllowedNetwork == nil && policies.AllowedIP == nil &&
+		policies.KeyCreateImportAccess == nil && policies.Metrics == nil {
+		return nil
+	}
+	err := kpAPI.SetInstancePolicies(context, policies)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while setting instance policies: %s", err)
+	}
+	return nil
+}
+
+func expandKmsInstancePolicies(d *schema.ResourceData, names []string) kp.MultiplePolicies {
+	var policies kp.MultiplePolicies
+	for _, name := range names {
+		policyList, ok := d.Get(name).([]interface{})
+		if !ok || len(policyList) == 0 || policyList[0] == nil {
+			continue
+		}
+		policy := policyList[0].(map[string]interface{})
+		enabled := policy["enabled"].(bool)
+		switch name {
+		case "dual_auth_delete":
+			policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: enabled}
+		case "allowed_network":
+			policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+				Enabled: enabled,
+				Network: policy["network"].(string),
+			}
+		case "allowed_ip":
+			policies.AllowedIP = &kp.AllowedIPPolicyData{
+				Enabled:     enabled,
+				IPAddresses: flex.ExpandStringList(policy["ip_addresses"].(*schema.Set).List()),
+			}
+		case "key_create_import_access":
+			policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
+				Enabled:           enabled,
+				CreateRootKey:     policy["create_root_key"].(bool),
+				CreateStandardKey: policy["create_standard_key"].(bool),
+				ImportRootKey:     policy["import_root_key"].(bool),
+				ImportStandardKey: policy["import_standard_key"].(bool),
+				EnforceToken:      policy["enforce_token"].(bool),
+			}
+		case "metrics":
+			policies.Metrics = &kp.BasicPolicyData{Enabled: enabled}
+		}
+	}
+	return policies
+}
+
+func flattenKmsInstancePolicies(policies []kp.InstancePolicy) map[string][]map[string]interface{} {
+	flattened := map[string][]map[string]interface{}{}
+	for _, policy := range policies {
+		enabled := policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+		attributes := policy.PolicyData.Attributes
+		if attributes == nil {
+			attributes = &kp.Attributes{}
+		}
+		switch policy.PolicyType {
+		case kp.DualAuthDelete:
+			flattened["dual_auth_delete"] = []map[string]interface{}{{"enabled": enabled}}
+		case kp.AllowedNetwork:
+			network := ""
+			if attributes.AllowedNetwork != nil {
+				network = *attributes.AllowedNetwork
+			}
+			flattened["allowed_network"] = []map[string]interface{}{{"enabled": enabled, "network": network}}
+		case kp.AllowedIP:
+			flattened["allowed_ip"] = []map[string]interface{}{{"enabled": enabled, "ip_addresses": flex.NewStringSet(schema.HashString, attributes.AllowedIP)}}
+		case kp.KeyCreateImportAccess:
+			flattened["key_create_import_access"] = []map[string]interface{}{{
+				"enabled":             enabled,
+				"create_root_key":     attributes.CreateRootKey != nil && *attributes.CreateRootKey,
+				"create_standard_key": attributes.CreateStandardKey != nil && *attributes.CreateStandardKey,
+				"import_root_key":     attributes.ImportRootKey != nil && *attributes.ImportRootKey,
+				"import_standard_key": attributes.ImportStandardKey != nil && *attributes.ImportStandardKey,
+				"enforce_token":       attributes.EnforceToken != nil && *attributes.EnforceToken,
+			}}
+		case kp.Metrics:
+			flattened["metrics"] = []map[string]interface{}{{"enabled": enabled}}
+		}
+	}
+	return flattened
+}
@@ -0,0 +1,65 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSInstancePolicies_basic_check(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "dual_auth_delete.0.enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.create_root_key", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.import_standard_key", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "dual_auth_delete.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "metrics.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, dual_auth_delete, metrics bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	  }
+
+	  resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		dual_auth_delete {
+			enabled = %t
+		}
+		metrics {
+			enabled = %t
+		}
+		key_create_import_access {
+			enabled             = true
+			create_root_key     = true
+			create_standard_key = true
+			import_standard_key = false
+		}
+	  }
+`, instanceName, dual_auth_delete, metrics)
+}
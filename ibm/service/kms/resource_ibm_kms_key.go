@@ -4,8 +4,13 @@
 package kms
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -93,10 +98,32 @@ func ResourceIBMKmskey() *schema.Resource {
 				Description: "Standard key type",
 			},
 			"payload": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key_material", "key_material_file"},
+			},
+			"key_material": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"payload", "key_material_file"},
+				Description:   "The base64 encoded key material to import, wrapped by the provider when an import token is used",
+			},
+			"key_material_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"payload", "key_material"},
+				Description:   "The path of a file with the raw key material to import, wrapped by the provider when an import token is used",
+			},
+			"import_token_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"payload", "encrypted_nonce", "iv_value"},
+				Description:   "The ID of the ibm_kms_import_token of the instance, the key material is wrapped with the current import token of the instance when it is set. Only for imported root key",
 			},
 			"encrypted_nonce": {
 				Type:        schema.TypeString,
@@ -117,6 +144,12 @@ func ResourceIBMKmskey() *schema.Resource {
 				ForceNew:    false,
 				Default:     false,
 			},
+			"restore": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to restore the imported key with its key material when it is destroyed, instead of creating a new key",
+			},
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -190,11 +223,15 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		expiration = nil
 	}
 
+	payload, encryptedNonce, iv, err := expandKmsKeyMaterial(d, kpAPI, standardKey)
+	if err != nil {
+		return err
+	}
+
 	var keyCRN string
 	if standardKey {
-		if v, ok := d.GetOk("payload"); ok {
+		if payload != "" {
 			//import standard key
-			payload := v.(string)
 			stkey, err := kpAPI.CreateImportedStandardKey(context.Background(), name, expiration, payload)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error while creating standard key with payload: %s", err)
@@ -213,10 +250,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 		}
 	} else {
-		if v, ok := d.GetOk("payload"); ok {
-			payload := v.(string)
-			encryptedNonce := d.Get("encrypted_nonce").(string)
-			iv := d.Get("iv_value").(string)
+		if payload != "" {
 			stkey, err := kpAPI.CreateImportedRootKey(context.Background(), name, expiration, payload, encryptedNonce, iv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error while creating Root key with payload: %s", err)
@@ -252,9 +286,13 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		return fmt.Errorf("[ERROR] Get Key failed with error while reading Key: %s", err)
-	} else if key.State == 5 { //Refers to Deleted state of the Key
-		d.SetId("")
-		return nil
+	} else if key.State == kmsKeyStateDestroyed {
+		if !key.Imported || !d.Get("restore").(bool) {
+			d.SetId("")
+			return nil
+		}
+		// The destroyed key is kept so that the update restores it with its key material
+		d.Set("restore", false)
 	}
 
 	err = setKeyDetails(d, meta, instanceID, instanceCRN, key, kpAPI)
@@ -271,10 +309,96 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	if d.HasChange("restore") && d.Get("restore").(bool) {
+		err := resourceIBMKmsKeyRestore(d, meta)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMKmsKeyRead(d, meta)
 
 }
 
+// resourceIBMKmsKeyRestore restores a destroyed imported key with its key material, wrapped again with the import
+// token of the instance when one is used
+func resourceIBMKmsKeyRestore(d *schema.ResourceData, meta interface{}) error {
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+	key, err := kpAPI.GetKey(context.Background(), keyid)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Get Key failed with error while restoring Key: %s", err)
+	}
+	if key.State != kmsKeyStateDestroyed {
+		return nil
+	}
+
+	payload, encryptedNonce, iv, err := expandKmsKeyMaterial(d, kpAPI, key.Extractable)
+	if err != nil {
+		return err
+	}
+	if payload == "" {
+		return fmt.Errorf("[ERROR] The key %s cannot be restored without its key material, set key_material, key_material_file or payload", keyid)
+	}
+	_, err = restoreKMSImportedKey(context.Background(), kpAPI, keyid, payload, encryptedNonce, iv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while restoring the key %s: %s", keyid, err)
+	}
+	d.Set("restore", true)
+	return nil
+}
+
+// restoreKMSImportedKey restores a key with its key material through the restore call of the client. The client sends
+// the restore without a body, so the key material is added by the transport of a copy of the client, which keeps the
+// token refresh, retries and error decoding of the client.
+func restoreKMSImportedKey(context context.Context, kpAPI *kp.Client, keyID, payload, encryptedNonce, iv string) (*kp.Key, error) {
+	resource := map[string]interface{}{
+		"payload": payload,
+	}
+	if encryptedNonce != "" {
+		resource["encryptedNonce"] = encryptedNonce
+		resource["iv"] = iv
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"collectionType":  "application/vnd.ibm.kms.key+json",
+			"collectionTotal": 1,
+		},
+		"resources": []interface{}{resource},
+	})
+	if err != nil {
+		return nil, err
+	}
+	restoreClient := *kpAPI
+	restoreClient.HttpClient.Transport = &kmsKeyRestoreTransport{
+		base: kpAPI.HttpClient.Transport,
+		body: body,
+	}
+	return restoreClient.RestoreKey(context, keyID)
+}
+
+// kmsKeyRestoreTransport adds the key material to the restore requests sent by the client
+type kmsKeyRestoreTransport struct {
+	base http.RoundTripper
+	body []byte
+}
+
+func (t *kmsKeyRestoreTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method == http.MethodPost && strings.HasSuffix(request.URL.Path, "/restore") {
+		request = request.Clone(request.Context())
+		request.Body = ioutil.NopCloser(bytes.NewReader(t.body))
+		request.ContentLength = int64(len(t.body))
+		request.Header.Set("content-type", "application/vnd.ibm.kms.key+json")
+	}
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(request)
+}
+
 func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
 	kpAPI, _, err := populateKPClient(d, meta, instanceID)
@@ -316,6 +440,61 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 
 }
 
+// expandKmsKeyMaterial returns the payload of an imported key, with the key material wrapped with the public key of
+// the import token of the instance along with the encrypted nonce and iv when an import token is used
+func expandKmsKeyMaterial(d *schema.ResourceData, kpAPI *kp.Client, standardKey bool) (payload, encryptedNonce, iv string, err error) {
+	var keyMaterial []byte
+	if v, ok := d.GetOk("key_material"); ok {
+		keyMaterial, err = base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return "", "", "", fmt.Errorf("[ERROR] Error decoding the key material: %s", err)
+		}
+	} else if v, ok := d.GetOk("key_material_file"); ok {
+		keyMaterial, err = ioutil.ReadFile(v.(string))
+		if err != nil {
+			return "", "", "", fmt.Errorf("[ERROR] Error reading the key material file: %s", err)
+		}
+	} else {
+		return d.Get("payload").(string), d.Get("encrypted_nonce").(string), d.Get("iv_value").(string), nil
+	}
+
+	// The instance has a single import token, the ID of the import token is the ID of its instance and orders the
+	// import of the key after the creation of the import token
+	importTokenID, ok := d.GetOk("import_token_id")
+	if !ok {
+		return base64.StdEncoding.EncodeToString(keyMaterial), "", "", nil
+	}
+	if getInstanceIDFromCRN(importTokenID.(string)) != kpAPI.Config.InstanceID {
+		return "", "", "", fmt.Errorf("[ERROR] The import token %s is not the import token of the instance %s", importTokenID, kpAPI.Config.InstanceID)
+	}
+	if standardKey {
+		return "", "", "", fmt.Errorf("[ERROR] Standard keys cannot be imported with an import token")
+	}
+	err = ValidateKMSRootKeyMaterial(keyMaterial)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	transportKey, err := kpAPI.GetImportTokenTransportKey(context.Background())
+	if err != nil {
+		return "", "", "", fmt.Errorf("[ERROR] Error while retrieving the import token: %s", err)
+	}
+	if transportKey.ExpirationDate != nil && time.Now().After(*transportKey.ExpirationDate) {
+		return "", "", "", fmt.Errorf("[ERROR] The import token of the instance %s expired at %s, replace it before importing the key", kpAPI.Config.InstanceID, transportKey.ExpirationDate.Format(time.RFC3339))
+	}
+	payload, err = WrapKMSKeyMaterial(transportKey.Payload, keyMaterial)
+	if err != nil {
+		return "", "", "", err
+	}
+	// Hyper Protect Crypto Services only supports CBC for the encryption of the nonce
+	cbc := strings.Contains(kpAPI.URL.String(), "hs-crypto")
+	encryptedNonce, iv, err = EncryptKMSImportTokenNonce(keyMaterial, transportKey.Nonce, cbc)
+	if err != nil {
+		return "", "", "", err
+	}
+	return payload, encryptedNonce, iv, nil
+}
+
 // Populate KP Client using info from schema
 func populateKPClient(d *schema.ResourceData, meta interface{}, instanceID string) (kpAPI *kp.Client, instanceCRN *string, err error) {
 	kpAPI, err = meta.(conns.ClientSession).KeyManagementAPI()
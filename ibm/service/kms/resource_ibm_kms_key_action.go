@@ -0,0 +1,310 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// States of a key as returned by the KP API
+const (
+	kmsKeyStatePreActivation = 0
+	kmsKeyStateActive        = 1
+	kmsKeyStateSuspended     = 2
+	kmsKeyStateDeactivated   = 3
+	kmsKeyStateDestroyed     = 5
+)
+
+var kmsKeyStateNames = map[int]string{
+	kmsKeyStatePreActivation: "pre_activation",
+	kmsKeyStateActive:        "active",
+	kmsKeyStateSuspended:     "suspended",
+	kmsKeyStateDeactivated:   "deactivated",
+	kmsKeyStateDestroyed:     "destroyed",
+}
+
+func ResourceIBMKmsKeyAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyActionCreate,
+		ReadContext:   resourceIBMKmsKeyActionRead,
+		UpdateContext: resourceIBMKmsKeyActionUpdate,
+		DeleteContext: resourceIBMKmsKeyActionDelete,
+		CustomizeDiff: resourceIBMKmsKeyActionRewrapCustomizeDiff,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key ID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"active", "suspended"}),
+				Description:  "The state of the key, active or suspended. A destroyed key is restored when it is read back as destroyed.",
+			},
+			"schedule_deletion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the key is authorized for deletion by a second user, the key must be protected by a dual authorization delete policy.",
+			},
+			"rewrap_ciphertext": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A ciphertext wrapped by the key, which is rewrapped with the latest version of the key.",
+			},
+			"rewrap_aad": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The additional authentication data the ciphertext was wrapped with.",
+			},
+			"rewrapped_ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The ciphertext rewrapped with the latest version of the key.",
+			},
+			"rewrapped_key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the key the ciphertext was rewrapped with.",
+			},
+			"key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest version of the key.",
+			},
+			"key_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key as reported by the service.",
+			},
+			flex.ResourceName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the resource",
+			},
+			flex.ResourceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the resource",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+	key, err := kpAPI.GetKeyMetadata(context, keyID)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error while creating key action: %s", err)
+	}
+	d.SetId(key.CRN)
+
+	err = resourceHandleKeyActions(context, d, kpAPI, key, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMKmsKeyActionRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, instanceID, keyID := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKeyMetadata(context, keyID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Get Key failed with error while reading key action: %s", err)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyID)
+	if strings.Contains((kpAPI.URL).String(), "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set(flex.ResourceName, key.Name)
+	d.Set(flex.ResourceCRN, key.CRN)
+	keyState := kmsKeyStateName(key.State)
+	d.Set("key_state", keyState)
+	// Only the states the resource drives are recorded, so that a key which got suspended or destroyed outside of
+	// Terraform shows up as a change
+	if key.State != kmsKeyStatePreActivation && key.State != kmsKeyStateDeactivated {
+		d.Set("state", keyState)
+	}
+	if key.KeyVersion != nil {
+		d.Set("key_version_id", key.KeyVersion.ID)
+	}
+
+	return nil
+}
+
+func resourceIBMKmsKeyActionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, instanceID, keyID := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKeyMetadata(context, keyID)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error while updating key action: %s", err)
+	}
+
+	err = resourceHandleKeyActions(context, d, kpAPI, key, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMKmsKeyActionRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	//Do not support undoing the actions
+	log.Println("Warning:  `terraform destroy` does not revert the actions on the Key but only clears the state file.")
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMKmsKeyActionRewrapCustomizeDiff plans a rewrap of the ciphertext once the key is rotated
+func resourceIBMKmsKeyActionRewrapCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("rewrap_ciphertext").(string) == "" {
+		return nil
+	}
+	if diff.Get("key_version_id").(string) != diff.Get("rewrapped_key_version_id").(string) {
+		for _, key := range []string{"rewrapped_ciphertext", "rewrapped_key_version_id"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resourceHandleKeyActions moves the key to the configured state, sets or unsets it for deletion and rewraps the
+// ciphertext
+func resourceHandleKeyActions(context context.Context, d *schema.ResourceData, kpAPI *kp.Client, key *kp.Key, create bool) error {
+	keyID := key.ID
+	state := d.Get("state").(string)
+
+	if create || d.HasChange("state") {
+		if key.State == kmsKeyStateDestroyed {
+			if key.Imported {
+				return fmt.Errorf("[ERROR] The key %s was imported and cannot be restored without its key material, set restore on the ibm_kms_key resource of the key", keyID)
+			}
+			restored, err := kpAPI.RestoreKey(context, keyID)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error while restoring the key %s: %s", keyID, err)
+			}
+			key = restored
+		}
+		if state == "suspended" && key.State == kmsKeyStateActive {
+			err := kpAPI.DisableKey(context, keyID)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error while disabling the key %s: %s", keyID, err)
+			}
+		}
+		if state == "active" && key.State == kmsKeyStateSuspended {
+			err := kpAPI.EnableKey(context, keyID)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error while enabling the key %s: %s", keyID, err)
+			}
+		}
+	}
+
+	if d.HasChange("schedule_deletion") {
+		var err error
+		if d.Get("schedule_deletion").(bool) {
+			err = kpAPI.InitiateDualAuthDelete(context, keyID)
+		} else if !create {
+			err = kpAPI.CancelDualAuthDelete(context, keyID)
+		}
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while setting the deletion of the key %s: %s", keyID, err)
+		}
+	}
+
+	ciphertext := d.Get("rewrap_ciphertext").(string)
+	if ciphertext == "" {
+		d.Set("rewrapped_ciphertext", "")
+		d.Set("rewrapped_key_version_id", "")
+		return nil
+	}
+	if create || d.HasChanges("rewrap_ciphertext", "rewrap_aad") || d.Get("rewrapped_key_version_id").(string) == "" ||
+		key.KeyVersion == nil || key.KeyVersion.ID != d.Get("rewrapped_key_version_id").(string) {
+		var aad *[]string
+		if v, ok := d.GetOk("rewrap_aad"); ok {
+			aadList := flex.ExpandStringList(v.([]interface{}))
+			aad = &aadList
+		}
+		// The unwrap action returns the ciphertext rewrapped with the latest version of the key, the plaintext is
+		// dropped
+		_, rewrapped, err := kpAPI.UnwrapV2(context, keyID, []byte(ciphertext), aad)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while rewrapping the ciphertext with the key %s: %s", keyID, err)
+		}
+		if len(rewrapped) == 0 {
+			// The ciphertext is only returned once the key was rotated after the wrap
+			rewrapped = []byte(ciphertext)
+		}
+		d.Set("rewrapped_ciphertext", string(rewrapped))
+		if key.KeyVersion != nil {
+			d.Set("rewrapped_key_version_id", key.KeyVersion.ID)
+		}
+	}
+	return nil
+}
+
+func kmsKeyStateName(state int) string {
+	if name, ok := kmsKeyStateNames[state]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", state)
+}
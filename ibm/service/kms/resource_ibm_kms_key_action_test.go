@@ -0,0 +1,59 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyAction_state_check(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "state", "suspended"),
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "key_state", "suspended"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "state", "active"),
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "key_state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_action.action", "key_version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyActionConfig(instanceName, KeyName, state string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	  }
+
+	  resource "ibm_kms_key" "test" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		key_name       = "%s"
+		standard_key   = false
+	  }
+	  resource "ibm_kms_key_action" "action" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+		state       = "%s"
+	  }
+`, instanceName, KeyName, state)
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+)
+
+func TestRestoreKMSImportedKey(t *testing.T) {
+	var body struct {
+		Resources []map[string]string `json:"resources"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v2/keys/key/restore" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if r.Header.Get("authorization") != "Bearer token" || r.Header.Get("bluemix-instance") != "instance" ||
+			r.Header.Get("content-type") != "application/vnd.ibm.kms.key+json" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("err: %s", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resources": [{"id": "key", "state": 1}]}`)
+	}))
+	defer server.Close()
+
+	kpAPI, err := kp.New(kp.ClientConfig{BaseURL: server.URL, InstanceID: "instance", Authorization: "Bearer token"}, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	key, err := restoreKMSImportedKey(context.Background(), kpAPI, "key", "payload", "nonce", "iv")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if key.ID != "key" || key.State != 1 {
+		t.Errorf("unexpected key %v", key)
+	}
+	if len(body.Resources) != 1 || body.Resources[0]["payload"] != "payload" ||
+		body.Resources[0]["encryptedNonce"] != "nonce" || body.Resources[0]["iv"] != "iv" {
+		t.Errorf("unexpected body %v", body)
+	}
+	if _, ok := kpAPI.HttpClient.Transport.(*kmsKeyRestoreTransport); ok {
+		t.Errorf("the transport of the client was changed")
+	}
+
+	body.Resources = nil
+	if _, err := restoreKMSImportedKey(context.Background(), kpAPI, "key", "payload", "", ""); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, ok := body.Resources[0]["encryptedNonce"]; ok {
+		t.Errorf("unexpected encrypted nonce without an import token %v", body)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -214,28 +215,9 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 			d.Set("config_file_path", clusterKeyDetails.FilePath)
 
 		} else {
-			var clusterKeyDetails v1.ClusterKeyInfo
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				var err error
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
-				if err != nil {
-					log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
-					if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
-						return resource.RetryableError(err)
-					}
-					if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
-						// Intermittent error resulting from synchronisation delay
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if conns.IsResourceTimeoutError(err) {
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
-			}
+			clusterKeyDetails, err := downloadClusterConfig(csAPI, name, configDir, admin, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+				return err
 			}
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
 			d.Set("admin_certificate", clusterKeyDetails.Admin)
@@ -250,3 +232,32 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+// downloadClusterConfig downloads the config of the cluster to configDir and retries the intermittent failures of
+// the download
+func downloadClusterConfig(csAPI v2.Clusters, name, configDir string, admin bool, targetEnv v2.ClusterTargetHeader) (v1.ClusterKeyInfo, error) {
+	var clusterKeyDetails v1.ClusterKeyInfo
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
+	}
+	if err != nil {
+		return clusterKeyDetails, fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+	}
+	return clusterKeyDetails, nil
+}
@@ -67,7 +67,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -436,6 +436,13 @@ func ResourceIBMContainerCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the resource",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			"worker_pools": {
 				Type:     schema.TypeList,
@@ -890,7 +897,8 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1291,8 +1299,8 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -46,8 +47,9 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
+			workerUpgradeCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +169,10 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"upgrade_strategy": workerUpgradeStrategySchema(),
+
+			"upgrade_progress": workerUpgradeProgressSchema(),
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -240,6 +246,13 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			"wait_till": {
 				Type:             schema.TypeString,
@@ -612,8 +625,8 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 	clusterID := d.Id()
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
@@ -661,7 +674,9 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	}
 
-	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+	strategy, rollingUpgrade := expandWorkerUpgradeStrategy(d)
+	resumeUpgrade := rollingUpgrade && priorWorkerUpgradeProgress(d).resumable()
+	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || resumeUpgrade) && !d.IsNewResource() {
 
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
@@ -713,7 +728,12 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		workersInfo := make(map[string]int)
 
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		if rollingUpgrade && (updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || resumeUpgrade) {
+			err = rollVpcWorkerUpdates(d, meta, targetEnv, clusterID, "", cls.MasterKubeVersion, strategy)
+			if err != nil {
+				return err
+			}
+		} else if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 
 			// patchVersion := d.Get("patch_version").(string)
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
@@ -1024,7 +1044,8 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1470,3 +1491,423 @@ func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.Clust
 	}
 	return "", -1, fmt.Errorf("[ERROR] no new node found")
 }
+
+const (
+	workerUpgradeScopePool = "pool"
+	workerUpgradeScopeZone = "zone"
+
+	workerUpgradeCompleted  = "completed"
+	workerUpgradePending    = "pending"
+	workerUpgradeInProgress = "in_progress"
+	workerUpgradePaused     = "paused"
+)
+
+func workerUpgradeStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Rolls the worker nodes to a new version in batches instead of replacing them one after the other",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of worker nodes of a worker pool or zone which are replaced at the same time",
+				},
+				"max_unavailable_scope": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      workerUpgradeScopePool,
+					ValidateFunc: validation.StringInSlice([]string{workerUpgradeScopePool, workerUpgradeScopeZone}, false),
+					Description:  "Whether max_unavailable applies per worker pool or per zone of a worker pool",
+				},
+				"drain_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      30,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Minutes to wait for a replaced worker node to be drained and deleted",
+				},
+				"pause_on_failure": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Stops the rollout at the first worker node which fails to update, otherwise the remaining worker nodes are updated before the failures are reported",
+				},
+			},
+		},
+	}
+}
+
+func workerUpgradeProgressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Progress of the rollout of the worker nodes to a new version, a pending or paused rollout is resumed by the next apply",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Status of the rollout, one of pending, in_progress, paused or completed",
+				},
+				"target_version": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Version the worker nodes are rolled to",
+				},
+				"updated_workers": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "IDs of the worker nodes which replaced the outdated worker nodes",
+				},
+				"failed_workers": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "IDs of the worker nodes which failed to update",
+				},
+				"remaining_workers": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of worker nodes which are not updated yet",
+				},
+			},
+		},
+	}
+}
+
+type workerUpgradeStrategy struct {
+	maxUnavailable int
+	scope          string
+	drainTimeout   time.Duration
+	pauseOnFailure bool
+}
+
+func expandWorkerUpgradeStrategy(d workerUpgradeData) (workerUpgradeStrategy, bool) {
+	l, ok := d.Get("upgrade_strategy").([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return workerUpgradeStrategy{}, false
+	}
+	m := l[0].(map[string]interface{})
+	return workerUpgradeStrategy{
+		maxUnavailable: m["max_unavailable"].(int),
+		scope:          m["max_unavailable_scope"].(string),
+		drainTimeout:   time.Duration(m["drain_timeout"].(int)) * time.Minute,
+		pauseOnFailure: m["pause_on_failure"].(bool),
+	}, true
+}
+
+// workerUpgradeData is implemented by schema.ResourceData and schema.ResourceDiff
+type workerUpgradeData interface {
+	Get(string) interface{}
+	GetChange(string) (interface{}, interface{})
+}
+
+type workerUpgradeProgress struct {
+	status         string
+	targetVersion  string
+	updatedWorkers []string
+	failedWorkers  []string
+	remaining      int
+}
+
+// priorWorkerUpgradeProgress returns the progress of the rollout which is recorded in the state
+func priorWorkerUpgradeProgress(d workerUpgradeData) workerUpgradeProgress {
+	o, _ := d.GetChange("upgrade_progress")
+	return expandWorkerUpgradeProgress(o)
+}
+
+func expandWorkerUpgradeProgress(v interface{}) workerUpgradeProgress {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return workerUpgradeProgress{}
+	}
+	m := l[0].(map[string]interface{})
+	return workerUpgradeProgress{
+		status:         m["status"].(string),
+		targetVersion:  m["target_version"].(string),
+		updatedWorkers: flex.ExpandStringList(m["updated_workers"].([]interface{})),
+		failedWorkers:  flex.ExpandStringList(m["failed_workers"].([]interface{})),
+		remaining:      m["remaining_workers"].(int),
+	}
+}
+
+func (p workerUpgradeProgress) resumable() bool {
+	return p.status == workerUpgradePending || p.status == workerUpgradeInProgress || p.status == workerUpgradePaused
+}
+
+func (p workerUpgradeProgress) flatten() []map[string]interface{} {
+	if p.status == "" {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"status":            p.status,
+			"target_version":    p.targetVersion,
+			"updated_workers":   p.updatedWorkers,
+			"failed_workers":    p.failedWorkers,
+			"remaining_workers": p.remaining,
+		},
+	}
+}
+
+// workerUpgradeCustomizeDiff plans an update of the upgrade progress when the state records a rollout which
+// is not completed, so that the next apply resumes it
+func workerUpgradeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if _, ok := expandWorkerUpgradeStrategy(diff); !ok {
+		return nil
+	}
+	if priorWorkerUpgradeProgress(diff).resumable() {
+		return diff.SetNewComputed("upgrade_progress")
+	}
+	return nil
+}
+
+func workerUpgradeGroup(worker v2.Worker, scope string) string {
+	if scope == workerUpgradeScopeZone {
+		return worker.PoolID + "/" + worker.Location
+	}
+	return worker.PoolID
+}
+
+func isWorkerOutdated(worker v2.Worker) bool {
+	return worker.KubeVersion.Target != "" && worker.KubeVersion.Actual != worker.KubeVersion.Target
+}
+
+// nextWorkerUpgradeBatch returns the outdated workers which can be replaced at the same time without more than
+// max unavailable workers in a worker pool or zone, workers which are not normal already count as unavailable
+func nextWorkerUpgradeBatch(workers []v2.Worker, strategy workerUpgradeStrategy, skip map[string]bool) []v2.Worker {
+	unavailable := make(map[string]int)
+	for _, worker := range workers {
+		if worker.Health.State != workerNormal {
+			unavailable[workerUpgradeGroup(worker, strategy.scope)]++
+		}
+	}
+
+	batch := make([]v2.Worker, 0)
+	for _, worker := range workers {
+		if !isWorkerOutdated(worker) || skip[worker.ID] || worker.LifeCycle.ActualState != workerDesired {
+			continue
+		}
+		group := workerUpgradeGroup(worker, strategy.scope)
+		if worker.Health.State == workerNormal {
+			if unavailable[group] >= strategy.maxUnavailable {
+				continue
+			}
+			unavailable[group]++
+		}
+		batch = append(batch, worker)
+	}
+	return batch
+}
+
+func countOutdatedWorkers(workers []v2.Worker) int {
+	count := 0
+	for _, worker := range workers {
+		if isWorkerOutdated(worker) {
+			count++
+		}
+	}
+	return count
+}
+
+// rollVpcWorkerUpdates replaces the outdated workers of the cluster, or of one of its worker pools, in the batches
+// of the upgrade strategy. The progress is recorded in upgrade_progress and a paused rollout is resumed by the next
+// apply, the workers which are already updated are not replaced again.
+func rollVpcWorkerUpdates(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, clusterID, workerPool, masterVersion string, strategy workerUpgradeStrategy) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	listWorkers := func() ([]v2.Worker, error) {
+		if workerPool == "" {
+			return csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+		}
+		return csClient.Workers().ListByWorkerPool(clusterID, workerPool, false, targetEnv)
+	}
+
+	progress := priorWorkerUpgradeProgress(d)
+	if !progress.resumable() || progress.targetVersion != masterVersion {
+		progress = workerUpgradeProgress{targetVersion: masterVersion}
+	}
+	progress.status = workerUpgradeInProgress
+	progress.failedWorkers = nil
+
+	failed := make(map[string]bool)
+	failures := make([]string, 0)
+	for {
+		workers, err := listWorkers()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		}
+		progress.remaining = countOutdatedWorkers(workers)
+		batch := nextWorkerUpgradeBatch(workers, strategy, failed)
+		if len(batch) == 0 {
+			break
+		}
+
+		known := make(map[string]bool)
+		expected := make(map[string]int)
+		for _, worker := range workers {
+			known[worker.ID] = true
+			expected[workerUpgradeGroup(worker, workerUpgradeScopeZone)]++
+		}
+
+		log.Printf("[INFO] Replacing %d outdated workers of cluster %s", len(batch), clusterID)
+		replaced := make([]v2.Worker, 0, len(batch))
+		for _, worker := range batch {
+			_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+			// As API returns http response 204 NO CONTENT, error raised will be exempted.
+			if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+				failed[worker.ID] = true
+				failures = append(failures, fmt.Sprintf("%s: %s", worker.ID, err))
+				continue
+			}
+			replaced = append(replaced, worker)
+		}
+
+		for _, worker := range replaced {
+			_, err := waitForVpcWorkerDrained(csClient.Workers(), clusterID, worker.ID, strategy.drainTimeout, targetEnv)
+			if err != nil {
+				failed[worker.ID] = true
+				failures = append(failures, fmt.Sprintf("%s: %s", worker.ID, err))
+			}
+		}
+
+		newWorkers, err := waitForVpcWorkerReplacements(listWorkers, known, expected, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			for _, worker := range replaced {
+				failed[worker.ID] = true
+			}
+			failures = append(failures, err.Error())
+		}
+		for _, workerID := range newWorkers {
+			_, err := waitForVpcWorkerNormal(csClient.Workers(), clusterID, workerID, d.Timeout(schema.TimeoutUpdate), targetEnv)
+			if err != nil {
+				failed[workerID] = true
+				failures = append(failures, fmt.Sprintf("%s: %s", workerID, err))
+				continue
+			}
+			progress.updatedWorkers = append(progress.updatedWorkers, workerID)
+		}
+
+		if len(failures) > 0 && strategy.pauseOnFailure {
+			break
+		}
+	}
+
+	for workerID := range failed {
+		progress.failedWorkers = append(progress.failedWorkers, workerID)
+	}
+	sort.Strings(progress.failedWorkers)
+	if len(failures) > 0 {
+		if workers, err := listWorkers(); err == nil {
+			progress.remaining = countOutdatedWorkers(workers)
+		}
+		progress.status = workerUpgradePaused
+		d.Set("upgrade_progress", progress.flatten())
+		return fmt.Errorf("[ERROR] The rollout of the workers of cluster %s to %s is paused, %d workers are not updated yet, the next apply resumes it: %s", clusterID, masterVersion, progress.remaining, strings.Join(failures, "; "))
+	}
+	progress.status = workerUpgradeCompleted
+	progress.remaining = 0
+	d.Set("upgrade_progress", progress.flatten())
+	return nil
+}
+
+func waitForVpcWorkerDrained(client v2.Workers, clusterID, workerID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+	log.Printf("Waiting for worker (%s) to be drained and deleted.", workerID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerDeletePending},
+		Target:  []string{workerDeleteState},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := client.Get(clusterID, workerID, target)
+			if err != nil {
+				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+					return worker, workerDeleteState, nil
+				}
+				return worker, workerDeletePending, nil
+			}
+			if worker.LifeCycle.ActualState == workerDeleteState {
+				return worker, workerDeleteState, nil
+			}
+			return worker, workerDeletePending, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+// waitForVpcWorkerReplacements waits for every zone of every worker pool to have as many workers as before the
+// batch was replaced and returns the IDs of the new workers
+func waitForVpcWorkerReplacements(listWorkers func() ([]v2.Worker, error), known map[string]bool, expected map[string]int, timeout time.Duration) ([]string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"created"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := listWorkers()
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes: %s", err)
+			}
+			current := make(map[string]int)
+			newWorkers := make([]string, 0)
+			for _, worker := range workers {
+				if worker.LifeCycle.ActualState == workerDeleteState || worker.LifeCycle.ActualState == workerDeletePending {
+					continue
+				}
+				current[workerUpgradeGroup(worker, workerUpgradeScopeZone)]++
+				if !known[worker.ID] {
+					newWorkers = append(newWorkers, worker.ID)
+				}
+			}
+			for group, count := range expected {
+				if current[group] < count {
+					return newWorkers, "creating", nil
+				}
+			}
+			return newWorkers, "created", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	newWorkers, err := stateConf.WaitForState()
+	if newWorkers == nil {
+		return nil, err
+	}
+	return newWorkers.([]string), err
+}
+
+func waitForVpcWorkerNormal(client v2.Workers, clusterID, workerID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", versionUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := client.Get(clusterID, workerID, target)
+			if err != nil {
+				return nil, "retry", fmt.Errorf("[ERROR] Error retrieving worker of container vpc cluster: %s", err)
+			}
+			if worker.Health.State == workerNormal && !isWorkerOutdated(worker) {
+				return worker, workerNormal, nil
+			}
+			return worker, versionUpdating, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 5,
+	}
+	return stateConf.WaitForState()
+}
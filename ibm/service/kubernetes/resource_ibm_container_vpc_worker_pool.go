@@ -35,9 +35,12 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
+		CustomizeDiff: workerUpgradeCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"cluster": {
 				Type:        schema.TypeString,
@@ -181,6 +184,10 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Description:      "Account ID of kms instance holder - if not provided, defaults to the account in use",
 				RequiredWith:     []string{"kms_instance_id", "crk"},
 			},
+
+			"upgrade_strategy": workerUpgradeStrategySchema(),
+
+			"upgrade_progress": workerUpgradeProgressSchema(),
 		},
 	}
 }
@@ -422,6 +429,27 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 			}
 		}
 	}
+
+	if strategy, ok := expandWorkerUpgradeStrategy(d); ok && priorWorkerUpgradeProgress(d).resumable() && !d.IsNewResource() {
+		clusterID := d.Get("cluster").(string)
+		workerPoolName := d.Get("worker_pool_name").(string)
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+		cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
+		}
+		err = rollVpcWorkerUpdates(d, meta, targetEnv, clusterID, workerPoolName, cls.MasterKubeVersion, strategy)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -506,6 +534,27 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	d.Set("cluster", cluster)
 	d.Set("vpc_id", workerPool.VpcID)
 	d.Set("host_pool_id", workerPool.HostPoolID)
+	// The workers of the pool are rolled to the version of the master by the next apply when they are outdated
+	if _, ok := expandWorkerUpgradeStrategy(d); ok {
+		workers, err := wpClient.Workers().ListByWorkerPool(cluster, workerPoolID, false, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving workers of worker pool (%s): %s", workerPoolID, err)
+		}
+		progress := expandWorkerUpgradeProgress(d.Get("upgrade_progress"))
+		outdated := countOutdatedWorkers(workers)
+		if outdated > 0 && !progress.resumable() {
+			progress = workerUpgradeProgress{
+				status:        workerUpgradePending,
+				targetVersion: cls.MasterKubeVersion,
+				remaining:     outdated,
+			}
+			d.Set("upgrade_progress", progress.flatten())
+		} else if outdated == 0 && progress.status == workerUpgradePending {
+			progress.status = workerUpgradeCompleted
+			progress.remaining = 0
+			d.Set("upgrade_progress", progress.flatten())
+		}
+	}
 	if workerPool.Taints != nil {
 		d.Set("taints", flattenWorkerPoolTaints(workerPool))
 	}
@@ -356,3 +356,54 @@ func testAccCheckIBMVpcContainerWorkerPoolKmsAccountEnvvar(name string) string {
 	}
 		`, name, acc.IksClusterID, acc.IksClusterVpcID, acc.IksClusterSubnetID, acc.KmsInstanceID, acc.CrkID, acc.KmsAccountID)
 }
+
+func TestAccIBMContainerVpcClusterWorkerPoolUpgradeStrategy(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-worker-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpgradeStrategy(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "upgrade_strategy.0.max_unavailable", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "upgrade_strategy.0.max_unavailable_scope", "zone"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "upgrade_progress.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpgradeStrategy(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "upgrade_strategy.0.max_unavailable", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolUpgradeStrategy(name string, maxUnavailable int) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = "%[2]s"
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "bx2.4x16"
+	  vpc_id            = "%[3]s"
+	  worker_count      = 2
+	  zones {
+		subnet_id = "%[4]s"
+		name      = "us-south-1"
+	  }
+	  upgrade_strategy {
+		max_unavailable       = %[5]d
+		max_unavailable_scope = "zone"
+		drain_timeout         = 20
+	  }
+	}
+		`, name, acc.IksClusterID, acc.IksClusterVpcID, acc.IksClusterSubnetID, maxUnavailable)
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func TestNextWorkerUpgradeBatch(t *testing.T) {
+	worker := func(id, pool, zone, health, version string) v2.Worker {
+		w := v2.Worker{ID: id, PoolID: pool, Location: zone}
+		w.Health.State = health
+		w.LifeCycle.ActualState = workerDesired
+		w.KubeVersion.Actual = version
+		w.KubeVersion.Target = "1.25.4"
+		return w
+	}
+	outdated := func(id, pool, zone string) v2.Worker {
+		return worker(id, pool, zone, workerNormal, "1.24.8")
+	}
+	deleting := outdated("w7", "pool-a", "us-south-1")
+	deleting.LifeCycle.ActualState = "deleting"
+
+	testcases := []struct {
+		name     string
+		workers  []v2.Worker
+		strategy workerUpgradeStrategy
+		skip     map[string]bool
+		batch    []string
+	}{
+		{
+			name:     "one worker per pool",
+			workers:  []v2.Worker{outdated("w1", "pool-a", "us-south-1"), outdated("w2", "pool-a", "us-south-2"), outdated("w3", "pool-b", "us-south-1")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 1, scope: workerUpgradeScopePool},
+			batch:    []string{"w1", "w3"},
+		},
+		{
+			name:     "one worker per zone",
+			workers:  []v2.Worker{outdated("w1", "pool-a", "us-south-1"), outdated("w2", "pool-a", "us-south-2"), outdated("w3", "pool-a", "us-south-1")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 1, scope: workerUpgradeScopeZone},
+			batch:    []string{"w1", "w2"},
+		},
+		{
+			name:     "two workers per pool",
+			workers:  []v2.Worker{outdated("w1", "pool-a", "us-south-1"), outdated("w2", "pool-a", "us-south-2"), outdated("w3", "pool-a", "us-south-3")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 2, scope: workerUpgradeScopePool},
+			batch:    []string{"w1", "w2"},
+		},
+		{
+			name:     "updated workers",
+			workers:  []v2.Worker{worker("w1", "pool-a", "us-south-1", workerNormal, "1.25.4"), outdated("w2", "pool-a", "us-south-2")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 1, scope: workerUpgradeScopePool},
+			batch:    []string{"w2"},
+		},
+		{
+			name:     "unavailable worker counted",
+			workers:  []v2.Worker{worker("w1", "pool-a", "us-south-1", "critical", "1.25.4"), outdated("w2", "pool-a", "us-south-2"), outdated("w3", "pool-b", "us-south-1")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 1, scope: workerUpgradeScopePool},
+			batch:    []string{"w3"},
+		},
+		{
+			name:     "outdated unavailable worker replaced",
+			workers:  []v2.Worker{worker("w1", "pool-a", "us-south-1", "critical", "1.24.8"), outdated("w2", "pool-a", "us-south-2")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 1, scope: workerUpgradeScopePool},
+			batch:    []string{"w1"},
+		},
+		{
+			name:     "failed and deleting workers skipped",
+			workers:  []v2.Worker{outdated("w1", "pool-a", "us-south-1"), deleting, outdated("w2", "pool-a", "us-south-2")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 1, scope: workerUpgradeScopePool},
+			skip:     map[string]bool{"w1": true},
+			batch:    []string{"w2"},
+		},
+		{
+			name:     "nothing to update",
+			workers:  []v2.Worker{worker("w1", "pool-a", "us-south-1", workerNormal, "1.25.4")},
+			strategy: workerUpgradeStrategy{maxUnavailable: 1, scope: workerUpgradeScopePool},
+			batch:    []string{},
+		},
+	}
+	for _, tc := range testcases {
+		batch := []string{}
+		for _, w := range nextWorkerUpgradeBatch(tc.workers, tc.strategy, tc.skip) {
+			batch = append(batch, w.ID)
+		}
+		if !reflect.DeepEqual(batch, tc.batch) {
+			t.Errorf("%s: got batch %v, expected %v", tc.name, batch, tc.batch)
+		}
+	}
+}
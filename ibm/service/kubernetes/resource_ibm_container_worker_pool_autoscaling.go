@@ -0,0 +1,423 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	autoscalerConfigMapName      = "iks-ca-configmap"
+	autoscalerConfigMapNamespace = "kube-system"
+	autoscalerWorkerPoolsConfig  = "workerPoolsConfig.json"
+	autoscalerExpander           = "expander"
+)
+
+// autoscalerWorkerPool is an entry of the worker pools config of the cluster autoscaler add-on
+type autoscalerWorkerPool struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func ResourceIBMContainerWorkerPoolAutoscaling() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerWorkerPoolAutoscalingCreate,
+		Read:     resourceIBMContainerWorkerPoolAutoscalingRead,
+		Update:   resourceIBMContainerWorkerPoolAutoscalingUpdate,
+		Delete:   resourceIBMContainerWorkerPoolAutoscalingDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceIBMContainerWorkerPoolAutoscalingSizeValidate,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_worker_pool_autoscaling",
+					"cluster"),
+			},
+			"worker_pool_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the worker pool of a VPC, classic or Satellite cluster",
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Minimum number of worker nodes per zone of the worker pool",
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of worker nodes per zone of the worker pool",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the cluster autoscaler scales the worker pool",
+			},
+			"expander": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"random", "least-waste", "most-pods", "priority"}, false),
+				Description:  "Expander the cluster autoscaler uses to select the worker pool to scale up, this setting applies to all the worker pools of the cluster",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the resource group.",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerWorkerPoolAutoscalingValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMContainerWorkerPoolAutoscalingValidator := validate.ResourceValidator{ResourceName: "ibm_container_worker_pool_autoscaling", Schema: validateSchema}
+	return &iBMContainerWorkerPoolAutoscalingValidator
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	workerPoolName := d.Get("worker_pool_name").(string)
+
+	err := checkAutoscalerWorkerPool(d, meta, cluster, workerPoolName)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the worker pool %s of cluster %s: %s", workerPoolName, cluster, err)
+	}
+
+	err = updateWorkerPoolAutoscaling(d, meta, cluster, workerPoolName, false, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", cluster, workerPoolName))
+
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameOrID/workerPoolName", d.Id())
+	}
+	cluster := parts[0]
+	workerPoolName := parts[1]
+
+	clientset, cleanup, err := autoscalerClientset(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	configMap, err := clientset.CoreV1().ConfigMaps(autoscalerConfigMapNamespace).Get(context.TODO(), autoscalerConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[WARN] The cluster autoscaler add-on is not enabled on cluster %s, removing the autoscaling of worker pool %s from state", cluster, workerPoolName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving the autoscaler config map of cluster %s: %s", cluster, err)
+	}
+
+	workerPools, err := expandAutoscalerWorkerPools(configMap)
+	if err != nil {
+		return err
+	}
+	var workerPool *autoscalerWorkerPool
+	for i := range workerPools {
+		if workerPools[i].Name == workerPoolName {
+			workerPool = &workerPools[i]
+			break
+		}
+	}
+	if workerPool == nil {
+		log.Printf("[WARN] The worker pool %s is not configured in the autoscaler config map of cluster %s, removing it from state", workerPoolName, cluster)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("worker_pool_name", workerPool.Name)
+	d.Set("min_size", workerPool.MinSize)
+	d.Set("max_size", workerPool.MaxSize)
+	d.Set("enabled", workerPool.Enabled)
+	d.Set("expander", configMap.Data[autoscalerExpander])
+
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("min_size") || d.HasChange("max_size") || d.HasChange("enabled") || d.HasChange("expander") {
+		cluster := d.Get("cluster").(string)
+		workerPoolName := d.Get("worker_pool_name").(string)
+
+		err := updateWorkerPoolAutoscaling(d, meta, cluster, workerPoolName, false, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingDelete(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	workerPoolName := d.Get("worker_pool_name").(string)
+
+	err := updateWorkerPoolAutoscaling(d, meta, cluster, workerPoolName, true, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingSizeValidate(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("min_size") || !diff.NewValueKnown("max_size") {
+		return nil
+	}
+	minSize := diff.Get("min_size").(int)
+	maxSize := diff.Get("max_size").(int)
+	if minSize > maxSize {
+		return fmt.Errorf("min_size %d of the worker pool must not be greater than max_size %d", minSize, maxSize)
+	}
+	return nil
+}
+
+// autoscalerWorkerPoolAPI returns the API the worker pools of a cluster of the provider are retrieved with, the
+// VPC API is the default
+func autoscalerWorkerPoolAPI(provider string) string {
+	switch provider {
+	case "classic", "satellite":
+		return provider
+	}
+	return "vpc"
+}
+
+// checkAutoscalerWorkerPool checks that the worker pool exists in the cluster, with the worker pool API of the
+// provider of the cluster
+func checkAutoscalerWorkerPool(d *schema.ResourceData, meta interface{}, cluster, workerPoolName string) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	clusterInfo, err := csClient.Clusters().GetCluster(cluster, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	switch autoscalerWorkerPoolAPI(clusterInfo.Provider) {
+	case "classic":
+		csClassicClient, err := meta.(conns.ClientSession).ContainerAPI()
+		if err != nil {
+			return err
+		}
+		classicTargetEnv, err := getWorkerPoolTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		_, err = csClassicClient.WorkerPools().GetWorkerPool(cluster, workerPoolName, classicTargetEnv)
+		return err
+	case "satellite":
+		satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+		if err != nil {
+			return err
+		}
+		getWorkerPoolOptions := &kubernetesserviceapiv1.GetWorkerPoolOptions{
+			Cluster:    &cluster,
+			Workerpool: &workerPoolName,
+		}
+		_, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+		if err != nil {
+			return fmt.Errorf("%s\n%s", err, response)
+		}
+		return nil
+	}
+	_, err = csClient.WorkerPools().GetWorkerPool(cluster, workerPoolName, targetEnv)
+	return err
+}
+
+// autoscalerClientset downloads the admin config of the cluster to a temporary directory and returns a clientset
+// for it, the returned function removes the config
+func autoscalerClientset(d *schema.ResourceData, meta interface{}, cluster string) (*kubernetes.Clientset, func(), error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, nil, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configDir, err := os.MkdirTemp("", "ibm-container-autoscaling")
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Error creating the directory for the cluster config: %s", err)
+	}
+	cleanup := func() {
+		os.RemoveAll(configDir)
+	}
+
+	clusterKeyDetails, err := downloadClusterConfig(csClient.Clusters(), cluster, configDir, true, targetEnv)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	config, err := clientcmd.BuildConfigFromFlags("", clusterKeyDetails.FilePath)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("[ERROR] Invalid kubeconfig, failed to set context: %s", err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("[ERROR] Invalid kubeconfig, failed to create clientset: %s", err)
+	}
+	return clientset, cleanup, nil
+}
+
+// updateWorkerPoolAutoscaling sets or removes the entry of the worker pool in the autoscaler config map, the other
+// entries are kept as they are
+func updateWorkerPoolAutoscaling(d *schema.ResourceData, meta interface{}, cluster, workerPoolName string, remove bool, timeout time.Duration) error {
+	// The worker pools of a cluster share the config map
+	lockKey := "Cluster_Autoscaler_" + cluster
+	conns.IbmMutexKV.Lock(lockKey)
+	defer conns.IbmMutexKV.Unlock(lockKey)
+
+	clientset, cleanup, err := autoscalerClientset(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	configMaps := clientset.CoreV1().ConfigMaps(autoscalerConfigMapNamespace)
+	return resource.Retry(timeout, func() *resource.RetryError {
+		configMap, err := configMaps.Get(context.TODO(), autoscalerConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				if remove {
+					return nil
+				}
+				// The add-on creates the config map once it is deployed
+				return resource.RetryableError(fmt.Errorf("[ERROR] The autoscaler config map of cluster %s is not found, enable the cluster-autoscaler add-on: %s", cluster, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] Error retrieving the autoscaler config map of cluster %s: %s", cluster, err))
+		}
+
+		workerPools, err := expandAutoscalerWorkerPools(configMap)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		var workerPool *autoscalerWorkerPool
+		if !remove {
+			workerPool = &autoscalerWorkerPool{
+				Name:    workerPoolName,
+				MinSize: d.Get("min_size").(int),
+				MaxSize: d.Get("max_size").(int),
+				Enabled: d.Get("enabled").(bool),
+			}
+			if expander, ok := d.GetOk("expander"); ok {
+				configMap.Data[autoscalerExpander] = expander.(string)
+			}
+		}
+		config, err := json.Marshal(mergeAutoscalerWorkerPools(workerPools, workerPoolName, workerPool))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		configMap.Data[autoscalerWorkerPoolsConfig] = string(config)
+
+		_, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+		if err != nil {
+			// The config map was changed since it was read, for example by the add-on
+			if apierrors.IsConflict(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] Error updating the autoscaler config map of cluster %s: %s", cluster, err))
+		}
+		return nil
+	})
+}
+
+// mergeAutoscalerWorkerPools replaces the entry of the worker pool with workerPool, or removes it when workerPool is
+// nil, the entries of the other worker pools are kept in their order
+func mergeAutoscalerWorkerPools(workerPools []autoscalerWorkerPool, workerPoolName string, workerPool *autoscalerWorkerPool) []autoscalerWorkerPool {
+	merged := make([]autoscalerWorkerPool, 0, len(workerPools)+1)
+	for _, entry := range workerPools {
+		if entry.Name != workerPoolName {
+			merged = append(merged, entry)
+		}
+	}
+	if workerPool != nil {
+		merged = append(merged, *workerPool)
+	}
+	return merged
+}
+
+func expandAutoscalerWorkerPools(configMap *corev1.ConfigMap) ([]autoscalerWorkerPool, error) {
+	workerPools := make([]autoscalerWorkerPool, 0)
+	config, ok := configMap.Data[autoscalerWorkerPoolsConfig]
+	if !ok || config == "" {
+		return workerPools, nil
+	}
+	err := json.Unmarshal([]byte(config), &workerPools)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing %s of the autoscaler config map: %s", autoscalerWorkerPoolsConfig, err)
+	}
+	return workerPools, nil
+}
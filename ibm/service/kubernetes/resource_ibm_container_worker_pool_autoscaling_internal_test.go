@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestAutoscalerWorkerPoolAPI(t *testing.T) {
+	cases := map[string]string{
+		"classic":     "classic",
+		"satellite":   "satellite",
+		"vpc-gen2":    "vpc",
+		"vpc-classic": "vpc",
+		"":            "vpc",
+	}
+	for provider, expected := range cases {
+		if api := autoscalerWorkerPoolAPI(provider); api != expected {
+			t.Errorf("%q: got %q, expected %q", provider, api, expected)
+		}
+	}
+}
+
+func TestExpandAutoscalerWorkerPools(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     map[string]string
+		expected []autoscalerWorkerPool
+		err      bool
+	}{
+		{
+			name:     "no config",
+			data:     nil,
+			expected: []autoscalerWorkerPool{},
+		},
+		{
+			name:     "empty config",
+			data:     map[string]string{autoscalerWorkerPoolsConfig: ""},
+			expected: []autoscalerWorkerPool{},
+		},
+		{
+			name: "config",
+			data: map[string]string{autoscalerWorkerPoolsConfig: `[{"name": "default", "minSize": 1, "maxSize": 3, "enabled": true}, {"name": "edge", "minSize": 2, "maxSize": 2, "enabled": false}]`},
+			expected: []autoscalerWorkerPool{
+				{Name: "default", MinSize: 1, MaxSize: 3, Enabled: true},
+				{Name: "edge", MinSize: 2, MaxSize: 2, Enabled: false},
+			},
+		},
+		{
+			name: "invalid config",
+			data: map[string]string{autoscalerWorkerPoolsConfig: `{"name": "default"}`},
+			err:  true,
+		},
+	}
+	for _, tc := range cases {
+		workerPools, err := expandAutoscalerWorkerPools(&corev1.ConfigMap{Data: tc.data})
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: err: %s", tc.name, err)
+		} else if !reflect.DeepEqual(workerPools, tc.expected) {
+			t.Errorf("%s: got %v, expected %v", tc.name, workerPools, tc.expected)
+		}
+	}
+}
+
+func TestMergeAutoscalerWorkerPools(t *testing.T) {
+	workerPools := []autoscalerWorkerPool{
+		{Name: "default", MinSize: 1, MaxSize: 3, Enabled: true},
+		{Name: "edge", MinSize: 2, MaxSize: 2, Enabled: false},
+		{Name: "gpu", MinSize: 1, MaxSize: 1, Enabled: true},
+	}
+	edge := &autoscalerWorkerPool{Name: "edge", MinSize: 1, MaxSize: 5, Enabled: true}
+	cases := []struct {
+		name       string
+		workerPool string
+		entry      *autoscalerWorkerPool
+		expected   []autoscalerWorkerPool
+	}{
+		{
+			name:       "update",
+			workerPool: "edge",
+			entry:      edge,
+			expected:   []autoscalerWorkerPool{workerPools[0], workerPools[2], *edge},
+		},
+		{
+			name:       "add",
+			workerPool: "new",
+			entry:      &autoscalerWorkerPool{Name: "new", MinSize: 1, MaxSize: 2},
+			expected:   append(append([]autoscalerWorkerPool{}, workerPools...), autoscalerWorkerPool{Name: "new", MinSize: 1, MaxSize: 2}),
+		},
+		{
+			name:       "remove",
+			workerPool: "edge",
+			expected:   []autoscalerWorkerPool{workerPools[0], workerPools[2]},
+		},
+		{
+			name:       "remove missing",
+			workerPool: "new",
+			expected:   workerPools,
+		},
+	}
+	for _, tc := range cases {
+		merged := mergeAutoscalerWorkerPools(workerPools, tc.workerPool, tc.entry)
+		if !reflect.DeepEqual(merged, tc.expected) {
+			t.Errorf("%s: got %v, expected %v", tc.name, merged, tc.expected)
+		}
+	}
+	if len(workerPools) != 3 || workerPools[1].MaxSize != 2 {
+		t.Errorf("the entries read from the config map were changed: %v", workerPools)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerWorkerPoolAutoscalingBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMContainerWorkerPoolAutoscaling(3, 1, true),
+				ExpectError: regexp.MustCompile("must not be greater than max_size"),
+			},
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscaling(1, 3, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "min_size", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "max_size", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "expander", "least-waste"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscaling(2, 4, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "min_size", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "max_size", "4"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_worker_pool_autoscaling.autoscaling",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_group_id"},
+			},
+		},
+	})
+}
+
+func TestIBMContainerWorkerPoolAutoscalingSizeValidate(t *testing.T) {
+	r := kubernetes.ResourceIBMContainerWorkerPoolAutoscaling()
+	for _, c := range []struct {
+		minSize, maxSize int
+		valid            bool
+	}{
+		{1, 3, true},
+		{2, 2, true},
+		{3, 1, false},
+	} {
+		raw := map[string]interface{}{
+			"cluster":          "mycluster",
+			"worker_pool_name": "default",
+			"min_size":         c.minSize,
+			"max_size":         c.maxSize,
+		}
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+		if c.valid && err != nil {
+			t.Errorf("min_size %d and max_size %d: unexpected error %s", c.minSize, c.maxSize, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("min_size %d and max_size %d: expected an error", c.minSize, c.maxSize)
+		}
+	}
+}
+
+func testAccCheckIBMContainerWorkerPoolAutoscaling(minSize, maxSize int, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_worker_pool_autoscaling" "autoscaling" {
+		cluster          = "%[1]s"
+		worker_pool_name = "default"
+		min_size         = %[2]d
+		max_size         = %[3]d
+		enabled          = %[4]t
+		expander         = "least-waste"
+	}
+	`, acc.IksClusterID, minSize, maxSize, enabled)
+}
@@ -68,6 +68,7 @@ const (
 	Arg_PIInstanceSharedProcessorPool    = "pi_shared_processor_pool"
 	Attr_PIInstanceSharedProcessorPool   = "shared_processor_pool"
 	Attr_PIInstanceSharedProcessorPoolID = "shared_processor_pool_id"
+	Arg_PIInstanceAllowStopForUpdate     = "pi_allow_stop_for_update"
 
 	// Placement Group
 	PIPlacementGroupID      = "placement_group_id"
@@ -138,6 +139,40 @@ const (
 	Attr_SPPPlacementGroupPolicy  = "policy"
 	Attr_SPPPlacementGroupName    = "name"
 
+	// Volume Replication
+	Arg_VolumeReplicationVolumeID      = "pi_volume_id"
+	Arg_VolumeReplicationSite          = "pi_replication_site"
+	Arg_VolumeGroupFailoverPrimaryRole = "pi_primary_role"
+	Attr_ReplicationType               = "replication_type"
+	Attr_ReplicationStatus             = "replication_status"
+	Attr_ReplicationState              = "replication_state"
+	Attr_MirroringState                = "mirroring_state"
+	Attr_PrimaryRole                   = "primary_role"
+	Attr_MasterVolumeName              = "master_volume_name"
+	Attr_AuxiliaryVolumeName           = "auxiliary_volume_name"
+	Attr_ConsistencyGroupName          = "consistency_group_name"
+	Attr_RemoteCopyID                  = "remote_copy_id"
+	Attr_VolumeGroupStatus             = "volume_group_status"
+
+	// states of the remote copy relationships and consistency groups of replication enabled volumes
+	ReplicationStateConsistentCopying      = "consistent_copying"
+	ReplicationStateConsistentSynchronized = "consistent_synchronized"
+	ReplicationStateIdling                 = "idling"
+	ReplicationStateIdlingDisconnected     = "idling_disconnected"
+	ReplicationStatusEnabled               = "enabled"
+
+	// Workspace
+	Arg_WorkspaceName            = "pi_name"
+	Arg_WorkspaceDatacenter      = "pi_datacenter"
+	Arg_WorkspaceResourceGroupID = "pi_resource_group_id"
+	Attr_WorkspaceCRN            = "crn"
+	Attr_WorkspaceZone           = "zone"
+	Attr_WorkspaceStatus         = "status"
+
+	// service and plan in the resource catalog which provision a workspace
+	workspaceServiceName     = "power-iaas"
+	workspaceServicePlanName = "power-virtual-server-group"
+
 	// status
 	// common status states
 	StatusShutoff = "SHUTOFF"
@@ -29,6 +29,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceRead,
 		UpdateContext: resourceIBMPIInstanceUpdate,
 		DeleteContext: resourceIBMPIInstanceDelete,
+		CustomizeDiff: resourceIBMPIInstanceStopForUpdateValidate,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -313,6 +314,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Default:      "none",
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"none", "soft", "hard"}),
 			},
+			Arg_PIInstanceAllowStopForUpdate: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow the instance to be stopped and started again to apply changes which can not be applied while it is running",
+			},
 
 			// "reboot_for_resource_change": {
 			// 	Type:        schema.TypeString,
@@ -481,6 +488,9 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("max_processors", powervmdata.Maxproc)
 	d.Set("max_memory", powervmdata.Maxmem)
 	d.Set("pin_policy", powervmdata.PinPolicy)
+	if powervmdata.PinPolicy != "" {
+		d.Set(helpers.PIInstancePinPolicy, powervmdata.PinPolicy)
+	}
 	d.Set("operating_system", powervmdata.OperatingSystem)
 	if powervmdata.OsType != nil {
 		d.Set("os_type", powervmdata.OsType)
@@ -545,19 +555,39 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(helpers.PIInstanceProcType) {
+	// Virtual core will be updated only if service instance capability is enabled
+	if d.HasChange(helpers.PIVirtualCoresAssigned) {
+		body := &models.PVMInstanceUpdate{
+			VirtualCores: &models.VirtualCores{Assigned: &assignedVirtualCores},
+		}
+		_, err = client.Update(instanceID, body)
+		if err != nil {
+			return diag.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
+		}
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-		// Stop the lpar
-		if d.Get("status") == "SHUTOFF" {
-			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
-		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	// Changes outside of the soft limits of the instance are applied while it is stopped, it is only stopped and
+	// started again by the update when pi_allow_stop_for_update is set
+	stopReasons := instanceStopForUpdateReasons(d)
+	stopped := false
+	if len(stopReasons) > 0 && d.Get("status") != "SHUTOFF" {
+		if !d.Get(Arg_PIInstanceAllowStopForUpdate).(bool) {
+			return diag.Errorf("changing %s requires stopping the instance %s, set %s to allow it", strings.Join(stopReasons, ", "), instanceID, Arg_PIInstanceAllowStopForUpdate)
+		}
+		log.Printf("[INFO] Stopping the instance %s to change %s", instanceID, strings.Join(stopReasons, ", "))
+		err = stopLparForResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		stopped = true
+	}
+	off := stopped || d.Get("status") == "SHUTOFF"
 
-		// Modify
+	if d.HasChange(helpers.PIInstanceProcType) {
 		log.Printf("At this point the lpar should be off. Executing the Processor Update Change")
 		updatebody := &models.PVMInstanceUpdate{ProcType: processortype}
 		if cores_enabled {
@@ -574,109 +604,54 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
-		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID)
-		if err != nil {
-			return diag.FromErr(err)
-		}
 	}
 
-	// Virtual core will be updated only if service instance capability is enabled
-	if d.HasChange(helpers.PIVirtualCoresAssigned) {
+	// Start of the change for Memory and Processors
+	if d.HasChange(helpers.PIInstanceMemory) || d.HasChange(helpers.PIInstanceProcessors) || d.HasChange("pi_migratable") {
 		body := &models.PVMInstanceUpdate{
-			VirtualCores: &models.VirtualCores{Assigned: &assignedVirtualCores},
+			Memory:     mem,
+			Processors: procs,
 		}
-		_, err = client.Update(instanceID, body)
-		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
+		if m, ok := d.GetOk("pi_migratable"); ok {
+			migratable := m.(bool)
+			body.Migratable = &migratable
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	// Start of the change for Memory and Processors
-	if d.HasChange(helpers.PIInstanceMemory) || d.HasChange(helpers.PIInstanceProcessors) || d.HasChange("pi_migratable") {
-
-		maxMemLpar := d.Get("max_memory").(float64)
-		maxCPULpar := d.Get("max_processors").(float64)
-		//log.Printf("the required memory is set to [%d] and current max memory is set to  [%d] ", int(mem), int(maxMemLpar))
-
-		if mem > maxMemLpar || procs > maxCPULpar {
-			log.Printf("Will require a shutdown to perform the change")
+		if cores_enabled {
+			log.Printf("support for %s is enabled", CUSTOM_VIRTUAL_CORES)
+			body.VirtualCores = &models.VirtualCores{Assigned: &assignedVirtualCores}
 		} else {
-			log.Printf("maxMemLpar is set to %f", maxMemLpar)
-			log.Printf("maxCPULpar is set to %f", maxCPULpar)
+			log.Printf("no virtual cores support enabled for this customer..")
 		}
 
-		//if d.GetOkExists("reboot_for_resource_change")
-
-		if mem > maxMemLpar || procs > maxCPULpar {
-
-			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
+		_, err = client.Update(instanceID, body)
+		if err != nil {
+			return diag.Errorf("failed to update the lpar with the change %v", err)
+		}
+		if off {
+			_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID)
 		} else {
-
-			body := &models.PVMInstanceUpdate{
-				Memory:     mem,
-				Processors: procs,
-			}
-			if m, ok := d.GetOk("pi_migratable"); ok {
-				migratable := m.(bool)
-				body.Migratable = &migratable
-			}
-			if cores_enabled {
-				log.Printf("support for %s is enabled", CUSTOM_VIRTUAL_CORES)
-				body.VirtualCores = &models.VirtualCores{Assigned: &assignedVirtualCores}
-			} else {
-				log.Printf("no virtual cores support enabled for this customer..")
-			}
-
-			_, err = client.Update(instanceID, body)
-			if err != nil {
-				return diag.Errorf("failed to update the lpar with the change %v", err)
-			}
 			_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	// License repository capacity will be updated only if service instance is a vtl instance
-	// might need to check if lrc was set
-	if d.HasChange(helpers.PIInstanceLicenseRepositoryCapacity) {
-
-		lrc := d.Get(helpers.PIInstanceLicenseRepositoryCapacity).(int64)
+	if d.HasChange(helpers.PIInstancePinPolicy) {
 		body := &models.PVMInstanceUpdate{
-			LicenseRepositoryCapacity: lrc,
+			PinPolicy: models.PinPolicy(d.Get(helpers.PIInstancePinPolicy).(string)),
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
+			return diag.Errorf("failed to update the lpar with the change for pin policy: %v", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID)
 		if err != nil {
-			diag.FromErr(err)
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange(PISAPInstanceProfileID) {
-		// Stop the lpar
-		if d.Get("status") == "SHUTOFF" {
-			log.Printf("the lpar is in the shutoff state. Nothing to do... Moving on ")
-		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
-
 		// Update the profile id
 		profileID := d.Get(PISAPInstanceProfileID).(string)
 		body := &models.PVMInstanceUpdate{
@@ -692,13 +667,34 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.FromErr(err)
 		}
+	}
 
-		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID)
+	if stopped {
+		log.Printf("[INFO] Starting the instance %s after the change of %s", instanceID, strings.Join(stopReasons, ", "))
+		err = startLparAfterResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
+
+	// License repository capacity will be updated only if service instance is a vtl instance
+	// might need to check if lrc was set
+	if d.HasChange(helpers.PIInstanceLicenseRepositoryCapacity) {
+
+		lrc := int64(d.Get(helpers.PIInstanceLicenseRepositoryCapacity).(int))
+		body := &models.PVMInstanceUpdate{
+			LicenseRepositoryCapacity: lrc,
+		}
+		_, err = client.Update(instanceID, body)
+		if err != nil {
+			return diag.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
+		}
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange(PIInstanceStoragePoolAffinity) {
 		storagePoolAffinity := d.Get(PIInstanceStoragePoolAffinity).(bool)
 		body := &models.PVMInstanceUpdate{
@@ -886,79 +882,75 @@ func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id string) resou
 	}
 }
 
-func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string) error {
-	body := &models.PVMInstanceAction{
-		//Action: flex.PtrToString("stop"),
-		Action: flex.PtrToString("immediate-shutdown"),
-	}
-	err := client.Action(id, body)
+func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) error {
+	err := performInstanceAction(ctx, client, id, "immediate-shutdown", PVMInstanceHealthOk, timeout)
 	if err != nil {
 		return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
 	}
-
-	_, err = isWaitForPIInstanceStopped(ctx, client, id)
-
-	return err
+	return nil
 }
 
 // Start the lpar
 
-func startLparAfterResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string) error {
-	body := &models.PVMInstanceAction{
-		Action: flex.PtrToString("start"),
-	}
-	err := client.Action(id, body)
+func startLparAfterResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) error {
+	err := performInstanceAction(ctx, client, id, "start", PVMInstanceHealthOk, timeout)
 	if err != nil {
 		return fmt.Errorf("failed to perform the start action on the pvm instance %v", err)
 	}
-
-	_, err = isWaitForPIInstanceAvailable(ctx, client, id, "OK")
-
-	return err
+	return nil
 }
 
-// Stop / Modify / Start only when the lpar is off limits
-
-func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient, id, cloudInstanceID string, mem, procs float64) error {
-	/*
-		These are the steps
-		1. Stop the lpar - Check if the lpar is SHUTOFF
-		2. Once the lpar is SHUTOFF - Make the cpu / memory change - DUring this time , you can check for RESIZE and VERIFY_RESIZE as the transition states
-		3. If the change is successful , the lpar state will be back in SHUTOFF
-		4. Once the LPAR state is SHUTOFF , initiate the start again and check for ACTIVE + OK
-	*/
-	//Execute the stop
+// instanceChanges is implemented by both schema.ResourceData and schema.ResourceDiff
+type instanceChanges interface {
+	Get(key string) interface{}
+	HasChange(key string) bool
+}
 
-	log.Printf("Calling the stop lpar for Resource Change code ..")
-	err := stopLparForResourceChange(ctx, client, id)
-	if err != nil {
-		return err
+// instanceStopForUpdateReasons returns the arguments whose change can not be applied while the instance is running:
+// the processor type, the SAP profile, the pin policy, and memory or processors outside of the minimum and maximum
+// of the instance
+func instanceStopForUpdateReasons(d instanceChanges) []string {
+	reasons := []string{}
+	for _, arg := range []string{helpers.PIInstanceProcType, PISAPInstanceProfileID, helpers.PIInstancePinPolicy} {
+		if d.HasChange(arg) {
+			reasons = append(reasons, arg)
+		}
 	}
-
-	body := &models.PVMInstanceUpdate{
-		Memory:     mem,
-		Processors: procs,
+	limits := []struct {
+		arg, min, max string
+	}{
+		{helpers.PIInstanceMemory, "min_memory", "max_memory"},
+		{helpers.PIInstanceProcessors, "min_processors", "max_processors"},
 	}
-
-	_, updateErr := client.Update(id, body)
-	if updateErr != nil {
-		return fmt.Errorf("failed to update the lpar with the change, %s", updateErr)
+	for _, l := range limits {
+		if !d.HasChange(l.arg) {
+			continue
+		}
+		value := d.Get(l.arg).(float64)
+		min := d.Get(l.min).(float64)
+		max := d.Get(l.max).(float64)
+		if value < min || (max > 0 && value > max) {
+			log.Printf("[DEBUG] %s %v is outside of the soft limits %v - %v", l.arg, value, min, max)
+			reasons = append(reasons, l.arg)
+		}
 	}
+	return reasons
+}
 
-	_, err = isWaitforPIInstanceUpdate(ctx, client, id)
-	if err != nil {
-		return fmt.Errorf("failed to get an update from the Service after the resource change, %s", err)
+// resourceIBMPIInstanceStopForUpdateValidate fails the plan of changes which require the running instance to be
+// stopped when pi_allow_stop_for_update is not set, instead of failing the apply
+func resourceIBMPIInstanceStopForUpdateValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get(Arg_PIInstanceAllowStopForUpdate).(bool) || diff.Get("status") == "SHUTOFF" {
+		return nil
 	}
-
-	// Now we can start the lpar
-	log.Printf("Calling the start lpar After the  Resource Change code ..")
-	err = startLparAfterResourceChange(ctx, client, id)
-	if err != nil {
-		return err
+	// The soft limits are checked again by the update once the values are known
+	if !diff.NewValueKnown(helpers.PIInstanceMemory) || !diff.NewValueKnown(helpers.PIInstanceProcessors) {
+		return nil
+	}
+	if reasons := instanceStopForUpdateReasons(diff); len(reasons) > 0 {
+		return fmt.Errorf("changing %s requires stopping the instance, set %s to allow it", strings.Join(reasons, ", "), Arg_PIInstanceAllowStopForUpdate)
 	}
-
 	return nil
-
 }
 
 func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
@@ -123,7 +123,10 @@ func resourceIBMPIInstanceActionRead(ctx context.Context, d *schema.ResourceData
 func resourceIBMPIInstanceActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange(Arg_PVMInstanceActionType) {
-		takeInstanceAction(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+		adiag := takeInstanceAction(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if adiag != nil {
+			return adiag
+		}
 	}
 
 	return resourceIBMPIInstanceActionRead(ctx, d, meta)
@@ -146,14 +149,24 @@ func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta interf
 	action := d.Get(Arg_PVMInstanceActionType).(string)
 	targetHealthStatus := d.Get(Arg_PVMInstanceHealthStatus).(string)
 
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	err = performInstanceAction(ctx, client, id, action, targetHealthStatus, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// performInstanceAction performs the action on the instance and waits for the status the action leads to
+func performInstanceAction(ctx context.Context, client *st.IBMPIInstanceClient, id, action, targetHealthStatus string, timeout time.Duration) error {
 	body := &models.PVMInstanceAction{Action: &action}
 	log.Printf("Calling the IBM PI Action %s on the instance %s", action, id)
-	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 
-	err = client.Action(id, body)
+	err := client.Action(id, body)
 	if err != nil {
 		log.Printf("[ERROR] failed to perform the action on the instance %v", err)
-		return diag.FromErr(err)
+		return err
 	}
 
 	log.Printf("Executed the action on the instance")
@@ -171,11 +184,7 @@ func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta interf
 
 	log.Printf("Calling the check for %s opertion to check for status %s", action, targetStatus)
 	_, err = isWaitForPIInstanceActionStatus(ctx, client, id, timeout, targetStatus, targetHealthStatus)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	return err
 }
 
 func isWaitForPIInstanceActionStatus(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, targetStatus, targetHealthStatus string) (interface{}, error) {
@@ -187,7 +196,7 @@ func isWaitForPIInstanceActionStatus(ctx context.Context, client *st.IBMPIInstan
 		Refresh:    isPIActionRefreshFunc(client, id, targetStatus, targetHealthStatus),
 		Delay:      30 * time.Second,
 		MinTimeout: 2 * time.Minute,
-		Timeout:    15 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -195,11 +204,11 @@ func isWaitForPIInstanceActionStatus(ctx context.Context, client *st.IBMPIInstan
 
 func isPIActionRefreshFunc(client *st.IBMPIInstanceClient, id, targetStatus, targetHealthStatus string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		log.Printf("Waiting for the target status to be [ %s ]", targetStatus)
 		pvm, err := client.Get(id)
 		if err != nil {
 			return nil, "", err
 		}
+		log.Printf("[INFO] The instance %s is %s with progress %.0f%%, waiting for the target status to be [ %s ]", id, *pvm.Status, pvm.Progress, targetStatus)
 
 		if *pvm.Status == targetStatus && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == PVMInstanceHealthOk) {
 			log.Printf("The health status is now %s", pvm.Health.Status)
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"reflect"
+	"testing"
+)
+
+// fakeInstanceChanges are the values of an instance and the arguments changed by a plan or an update
+type fakeInstanceChanges struct {
+	values  map[string]interface{}
+	changed map[string]bool
+}
+
+func (f fakeInstanceChanges) Get(key string) interface{} {
+	return f.values[key]
+}
+
+func (f fakeInstanceChanges) HasChange(key string) bool {
+	return f.changed[key]
+}
+
+func TestInstanceStopForUpdateReasons(t *testing.T) {
+	instance := func(memory, processors float64, changed ...string) fakeInstanceChanges {
+		f := fakeInstanceChanges{
+			values: map[string]interface{}{
+				"pi_memory":      memory,
+				"pi_processors":  processors,
+				"min_memory":     2.0,
+				"max_memory":     8.0,
+				"min_processors": 0.25,
+				"max_processors": 2.0,
+			},
+			changed: map[string]bool{},
+		}
+		for _, arg := range changed {
+			f.changed[arg] = true
+		}
+		return f
+	}
+	noMaximum := instance(64, 1, "pi_memory")
+	noMaximum.values["max_memory"] = 0.0
+
+	testcases := []struct {
+		name     string
+		instance fakeInstanceChanges
+		reasons  []string
+	}{
+		{"no change", instance(4, 1), []string{}},
+		{"within the soft limits", instance(8, 0.5, "pi_memory", "pi_processors"), []string{}},
+		{"above the maximum memory", instance(16, 1, "pi_memory"), []string{"pi_memory"}},
+		{"below the minimum processors", instance(4, 0.1, "pi_processors"), []string{"pi_processors"}},
+		{"limits of unchanged arguments", instance(16, 0.1), []string{}},
+		{"no maximum memory", noMaximum, []string{}},
+		{"processor type and pin policy", instance(4, 1, "pi_proc_type", "pi_pin_policy"), []string{"pi_proc_type", "pi_pin_policy"}},
+		{"SAP profile and memory", instance(16, 1, PISAPInstanceProfileID, "pi_memory"), []string{PISAPInstanceProfileID, "pi_memory"}},
+	}
+	for _, tc := range testcases {
+		if reasons := instanceStopForUpdateReasons(tc.instance); !reflect.DeepEqual(reasons, tc.reasons) {
+			t.Errorf("%s: got %v, expected %v", tc.name, reasons, tc.reasons)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -328,6 +329,78 @@ func TestAccIBMPISAPInstance(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMPIInstanceResize(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMPIInstanceResizeConfig(name, "2", "shared", "none", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_memory", "2"),
+					resource.TestCheckResourceAttr(instanceRes, "status", "ACTIVE"),
+				),
+			},
+			{
+				// Within the soft limits the memory is changed while the instance is running
+				Config: testAccIBMPIInstanceResizeConfig(name, "4", "shared", "none", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_memory", "4"),
+					resource.TestCheckResourceAttr(instanceRes, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config:      testAccIBMPIInstanceResizeConfig(name, "4", "capped", "soft", false),
+				ExpectError: regexp.MustCompile("requires stopping the instance"),
+			},
+			{
+				Config: testAccIBMPIInstanceResizeConfig(name, "4", "capped", "soft", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_proc_type", "capped"),
+					resource.TestCheckResourceAttr(instanceRes, "pi_pin_policy", "soft"),
+					resource.TestCheckResourceAttr(instanceRes, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMPIInstanceResizeConfig(name, memory, procType, pinPolicy string, allowStop bool) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_image_name        = "%[3]s"
+		pi_cloud_instance_id = "%[1]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_memory                = "%[5]s"
+		pi_processors            = "0.25"
+		pi_instance_name         = "%[2]s"
+		pi_proc_type             = "%[6]s"
+		pi_pin_policy            = "%[7]s"
+		pi_allow_stop_for_update = %[8]t
+		pi_image_id              = data.ibm_pi_image.power_image.id
+		pi_sys_type              = "s922"
+		pi_cloud_instance_id     = "%[1]s"
+		pi_storage_type          = "tier1"
+		pi_health_status         = "OK"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, memory, procType, pinPolicy, allowStop)
+}
+
 func testAccIBMPISAPInstanceConfig(name, sapProfile string) string {
 	return fmt.Sprintf(`
 	resource "ibm_pi_network" "power_network" {
@@ -340,6 +413,7 @@ func testAccIBMPISAPInstanceConfig(name, sapProfile string) string {
 		pi_instance_name      	= "%[2]s"
 		pi_sap_profile_id       = "%[3]s"
 		pi_image_id           	= "%[4]s"
+		pi_allow_stop_for_update = true
 		pi_storage_type			= "tier1"
 		pi_network {
 			network_id = ibm_pi_network.power_network.network_id
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPIVolumeGroupFailover() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeGroupFailoverCreate,
+		ReadContext:   resourceIBMPIVolumeGroupFailoverRead,
+		UpdateContext: resourceIBMPIVolumeGroupFailoverUpdate,
+		DeleteContext: resourceIBMPIVolumeGroupFailoverDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "PI cloud instance ID",
+			},
+			PIVolumeGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "ID of the replication enabled volume group",
+			},
+			Arg_VolumeGroupFailoverPrimaryRole: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"master", "aux"}, false),
+				Description:  "Volumes which play the primary role of the consistency group after the failover, master for the primary site or aux for the disaster recovery site",
+			},
+
+			// Computed Attributes
+			Attr_PrimaryRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volumes which currently play the primary role of the consistency group",
+			},
+			Attr_ReplicationState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the remote copy relationships of the consistency group",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of the volume group",
+			},
+			Attr_ConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the consistency group at the storage controller level",
+			},
+			Attr_VolumeGroupStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the volume group",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeGroupFailoverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(PIVolumeGroupID).(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+
+	diags := resourceIBMPIVolumeGroupFailoverUpdate(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+func resourceIBMPIVolumeGroupFailoverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.GetDetails(vgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volume_groups.PcloudVolumegroupsGetDetailsNotFound:
+			log.Printf("[WARN] The volume group %s was not found, removing it from state", vgID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	details, err := client.GetVolumeGroupLiveDetails(vgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(PIVolumeGroupID, vgID)
+	d.Set(Arg_VolumeGroupFailoverPrimaryRole, details.PrimaryRole)
+	d.Set(Attr_PrimaryRole, details.PrimaryRole)
+	d.Set(Attr_ReplicationState, details.State)
+	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
+	d.Set(Attr_VolumeGroupStatus, vg.Status)
+	if details.ConsistencyGroupName != nil {
+		d.Set(Attr_ConsistencyGroupName, *details.ConsistencyGroupName)
+	}
+
+	return nil
+}
+
+func resourceIBMPIVolumeGroupFailoverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	role := d.Get(Arg_VolumeGroupFailoverPrimaryRole).(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	err = failoverIBMPIVolumeGroup(ctx, client, vgID, role, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeGroupFailoverRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupFailoverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no delete for the failover, the consistency group keeps its primary role
+	d.SetId("")
+	return nil
+}
+
+// failoverIBMPIVolumeGroup stops the consistency group of the volume group with access to the auxiliary volumes
+// and starts it again from the volumes which play the requested primary role
+func failoverIBMPIVolumeGroup(ctx context.Context, client *st.IBMPIVolumeGroupClient, id, role string, timeout time.Duration) error {
+	details, err := client.GetVolumeGroupLiveDetails(id)
+	if err != nil {
+		return err
+	}
+	if details.PrimaryRole == role && isIBMPIReplicationStarted(details.State) {
+		log.Printf("[INFO] The volume group %s is already replicating from %s", id, role)
+		return nil
+	}
+
+	if !isIBMPIReplicationStopped(details.State) {
+		access := true
+		_, err = client.VolumeGroupAction(id, &models.VolumeGroupAction{
+			Stop: &models.VolumeGroupActionStop{
+				Access: &access,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error stopping the replication of the volume group %s: %v", id, err)
+		}
+		_, err = isWaitForIBMPIVolumeGroupReplicationState(ctx, client, id, "", false, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = client.VolumeGroupAction(id, &models.VolumeGroupAction{
+		Start: &models.VolumeGroupActionStart{
+			Source: &role,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error starting the replication of the volume group %s from %s: %v", id, role, err)
+	}
+	_, err = isWaitForIBMPIVolumeGroupReplicationState(ctx, client, id, role, true, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, id, timeout)
+	return err
+}
+
+func isIBMPIReplicationStarted(state string) bool {
+	return state == ReplicationStateConsistentCopying || state == ReplicationStateConsistentSynchronized
+}
+
+func isIBMPIReplicationStopped(state string) bool {
+	return state == ReplicationStateIdling || state == ReplicationStateIdlingDisconnected
+}
+
+func isWaitForIBMPIVolumeGroupReplicationState(ctx context.Context, client *st.IBMPIVolumeGroupClient, id, role string, started bool, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the replication of Volume Group (%s) to settle.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{helpers.PIVolumeProvisioning},
+		Target:     []string{helpers.PIVolumeProvisioningDone},
+		Refresh:    isIBMPIVolumeGroupReplicationStateRefreshFunc(client, id, role, started),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupReplicationStateRefreshFunc(client *st.IBMPIVolumeGroupClient, id, role string, started bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		details, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] The consistency group of the volume group %s is %s with primary role %q", id, details.State, details.PrimaryRole)
+		if started && isIBMPIReplicationStarted(details.State) && details.PrimaryRole == role {
+			return details, helpers.PIVolumeProvisioningDone, nil
+		}
+		if !started && isIBMPIReplicationStopped(details.State) {
+			return details, helpers.PIVolumeProvisioningDone, nil
+		}
+		return details, helpers.PIVolumeProvisioning, nil
+	}
+}
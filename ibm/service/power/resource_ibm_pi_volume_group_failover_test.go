@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestAccIBMPIVolumeGroupFailoverbasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-group-failover-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailoverConfig(name, "aux"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupFailoverPrimaryRole("ibm_pi_volume_group_failover.power_volume_group_failover", "aux"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group_failover.power_volume_group_failover", "primary_role", "aux"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_group_failover.power_volume_group_failover", "consistency_group_name"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailoverConfig(name, "master"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupFailoverPrimaryRole("ibm_pi_volume_group_failover.power_volume_group_failover", "master"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group_failover.power_volume_group_failover", "primary_role", "master"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group_failover.power_volume_group_failover", "volume_group_status", "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeGroupFailoverPrimaryRole(n, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+
+		ids, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		cloudInstanceID, vgID := ids[0], ids[1]
+		client := st.NewIBMPIVolumeGroupClient(context.Background(), sess, cloudInstanceID)
+
+		details, err := client.GetVolumeGroupLiveDetails(vgID)
+		if err != nil {
+			return err
+		}
+		if details.PrimaryRole != role {
+			return fmt.Errorf("The primary role of the volume group %s is %s, expected %s", vgID, details.PrimaryRole, role)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIVolumeGroupFailoverConfig(name, role string) string {
+	return testAccCheckIBMPIVolumeGroupConfig(name) + fmt.Sprintf(`
+	resource "ibm_pi_volume_group_failover" "power_volume_group_failover" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_volume_group_id   = ibm_pi_volume_group.power_volume_group.volume_group_id
+		pi_primary_role      = "%[2]s"
+	}
+	`, acc.Pi_cloud_instance_id, role)
+}
@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPIVolumeReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeReplicationCreate,
+		ReadContext:   resourceIBMPIVolumeReplicationRead,
+		DeleteContext: resourceIBMPIVolumeReplicationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "PI cloud instance ID",
+			},
+			Arg_VolumeReplicationVolumeID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "ID of the volume to enable replication on",
+			},
+
+			// Optional Arguments
+			Arg_VolumeReplicationSite: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Location of the replication site the volume is expected to be paired with, checked against the active replication sites of the workspace",
+			},
+
+			// Computed Attributes
+			Attr_ReplicationType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication type of the volume",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of the volume",
+			},
+			Attr_MirroringState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mirroring state of the volume",
+			},
+			Attr_PrimaryRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the master or the auxiliary volume plays the primary role",
+			},
+			Attr_MasterVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the master volume",
+			},
+			Attr_AuxiliaryVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the auxiliary volume on the replication site",
+			},
+			Attr_ConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the consistency group of the volume",
+			},
+			Attr_RemoteCopyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the remote copy relationship of the volume",
+			},
+			Attr_ReplicationState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the remote copy relationship of the volume",
+			},
+			Attr_Progress: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Progress of the copy to the auxiliary volume",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	volumeID := d.Get(Arg_VolumeReplicationVolumeID).(string)
+
+	if v, ok := d.GetOk(Arg_VolumeReplicationSite); ok {
+		drClient := st.NewIBMPIDisasterRecoveryLocationClient(ctx, sess, cloudInstanceID)
+		drLocation, err := drClient.Get()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = checkIBMPIReplicationSite(drLocation, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	client := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	vol, err := client.Get(volumeID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !vol.ReplicationEnabled {
+		replicationEnabled := true
+		err = client.VolumeAction(volumeID, &models.VolumeAction{
+			ReplicationEnabled: &replicationEnabled,
+		})
+		if err != nil {
+			return diag.Errorf("error enabling replication on the volume %s: %v", volumeID, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, volumeID))
+
+	_, err = isWaitForIBMPIVolumeReplicationEnabled(ctx, client, volumeID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeReplicationRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, volumeID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	vol, err := client.Get(volumeID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volumes.PcloudCloudinstancesVolumesGetNotFound:
+			log.Printf("[WARN] The volume %s was not found, removing its replication from state", volumeID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if !vol.ReplicationEnabled {
+		log.Printf("[WARN] Replication is disabled on the volume %s, removing it from state", volumeID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_VolumeReplicationVolumeID, volumeID)
+	d.Set(Attr_ReplicationType, vol.ReplicationType)
+	d.Set(Attr_ReplicationStatus, vol.ReplicationStatus)
+	d.Set(Attr_MirroringState, vol.MirroringState)
+	d.Set(Attr_PrimaryRole, vol.PrimaryRole)
+	d.Set(Attr_MasterVolumeName, vol.MasterVolumeName)
+	d.Set(Attr_AuxiliaryVolumeName, vol.AuxVolumeName)
+	d.Set(Attr_ConsistencyGroupName, vol.ConsistencyGroupName)
+
+	relationship, err := client.GetVolumeRemoteCopyRelationships(volumeID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(Attr_RemoteCopyID, relationship.RemoteCopyID)
+	d.Set(Attr_ReplicationState, relationship.State)
+	d.Set(Attr_Progress, relationship.Progress)
+
+	return nil
+}
+
+func resourceIBMPIVolumeReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, volumeID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	replicationEnabled := false
+	err = client.VolumeAction(volumeID, &models.VolumeAction{
+		ReplicationEnabled: &replicationEnabled,
+	})
+	if err != nil {
+		return diag.Errorf("error disabling replication on the volume %s: %v", volumeID, err)
+	}
+
+	_, err = isWaitForIBMPIVolumeReplicationDisabled(ctx, client, volumeID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// checkIBMPIReplicationSite returns an error when the location is not an active replication site of the disaster
+// recovery location of the workspace
+func checkIBMPIReplicationSite(drLocation *models.DisasterRecoveryLocation, location string) error {
+	sites := make([]string, 0, len(drLocation.ReplicationSites))
+	for _, site := range drLocation.ReplicationSites {
+		if site == nil || !site.IsActive {
+			continue
+		}
+		if site.Location == location {
+			return nil
+		}
+		sites = append(sites, site.Location)
+	}
+	return fmt.Errorf("%s is not an active replication site of %s, the active replication sites are: %s", location, drLocation.Location, strings.Join(sites, ", "))
+}
+
+func isWaitForIBMPIVolumeReplicationEnabled(ctx context.Context, client *st.IBMPIVolumeClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the replication of Volume (%s) to be consistent.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{helpers.PIVolumeProvisioning},
+		Target:     []string{helpers.PIVolumeProvisioningDone},
+		Refresh:    isIBMPIVolumeReplicationRefreshFunc(client, id, true),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForIBMPIVolumeReplicationDisabled(ctx context.Context, client *st.IBMPIVolumeClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the replication of Volume (%s) to be disabled.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{helpers.PIVolumeProvisioning},
+		Target:     []string{helpers.PIVolumeProvisioningDone},
+		Refresh:    isIBMPIVolumeReplicationRefreshFunc(client, id, false),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeReplicationRefreshFunc(client *st.IBMPIVolumeClient, id string, enabled bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vol, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if vol.State == "error" {
+			return vol, vol.State, fmt.Errorf("the volume %s is in the error state", id)
+		}
+
+		log.Printf("[DEBUG] The volume %s is %s with replication status %q and mirroring state %q", id, vol.State, vol.ReplicationStatus, vol.MirroringState)
+		if vol.State != "available" && vol.State != "in-use" {
+			return vol, helpers.PIVolumeProvisioning, nil
+		}
+		if !enabled {
+			if !vol.ReplicationEnabled {
+				return vol, helpers.PIVolumeProvisioningDone, nil
+			}
+			return vol, helpers.PIVolumeProvisioning, nil
+		}
+		if vol.ReplicationEnabled && vol.ReplicationStatus == ReplicationStatusEnabled &&
+			(vol.MirroringState == ReplicationStateConsistentCopying || vol.MirroringState == ReplicationStateConsistentSynchronized) {
+			return vol, helpers.PIVolumeProvisioningDone, nil
+		}
+		return vol, helpers.PIVolumeProvisioning, nil
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestAccIBMPIVolumeReplicationbasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-replication-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeReplicationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeReplicationExists("ibm_pi_volume_replication.power_volume_replication"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_replication.power_volume_replication", "replication_status", "enabled"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_replication.power_volume_replication", "replication_type", "global"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_replication.power_volume_replication", "auxiliary_volume_name"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_replication.power_volume_replication", "remote_copy_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_pi_volume_replication.power_volume_replication",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeReplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+
+		ids, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		cloudInstanceID, volumeID := ids[0], ids[1]
+		client := st.NewIBMPIVolumeClient(context.Background(), sess, cloudInstanceID)
+
+		vol, err := client.Get(volumeID)
+		if err != nil {
+			return err
+		}
+		if !vol.ReplicationEnabled {
+			return fmt.Errorf("Replication is not enabled on the volume %s", volumeID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIVolumeReplicationDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_volume_replication" {
+			continue
+		}
+		ids, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		cloudInstanceID, volumeID := ids[0], ids[1]
+		client := st.NewIBMPIVolumeClient(context.Background(), sess, cloudInstanceID)
+		vol, err := client.Get(volumeID)
+		if err == nil && vol.ReplicationEnabled {
+			return fmt.Errorf("Replication is still enabled on the volume %s", volumeID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIVolumeReplicationConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_volume" "power_volume" {
+		pi_volume_size       = 20
+		pi_volume_name       = "%[1]s"
+		pi_volume_pool       = "%[3]s"
+		pi_volume_shareable  = true
+		pi_cloud_instance_id = "%[2]s"
+	}
+
+	resource "ibm_pi_volume_replication" "power_volume_replication" {
+		pi_cloud_instance_id = "%[2]s"
+		pi_volume_id         = ibm_pi_volume.power_volume.volume_id
+	}
+	`, name, acc.Pi_cloud_instance_id, acc.PiStoragePool)
+}
@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	workspaceDependentsPending = "pending"
+	workspaceDependentsDone    = "done"
+)
+
+func ResourceIBMPIWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIWorkspaceCreate,
+		ReadContext:   resourceIBMPIWorkspaceRead,
+		UpdateContext: resourceIBMPIWorkspaceUpdate,
+		DeleteContext: resourceIBMPIWorkspaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_WorkspaceName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Name of the workspace",
+			},
+			Arg_WorkspaceDatacenter: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Datacenter in which the workspace is provisioned, for example dal12",
+			},
+			Arg_WorkspaceResourceGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "ID of the resource group of the workspace",
+			},
+
+			// Computed Attributes
+			Attr_WorkspaceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the workspace",
+			},
+			Attr_WorkspaceZone: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Zone of the workspace, to set as the zone of a provider managing its resources",
+			},
+			Attr_WorkspaceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the workspace",
+			},
+		},
+	}
+}
+
+func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(Arg_WorkspaceName).(string)
+	datacenter := d.Get(Arg_WorkspaceDatacenter).(string)
+	resourceGroupID := d.Get(Arg_WorkspaceResourceGroupID).(string)
+	planID, err := getPIWorkspacePlanID(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance, _, err := client.CreateResourceInstanceWithContext(ctx, &rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		Target:         &datacenter,
+		ResourceGroup:  &resourceGroupID,
+		ResourcePlanID: &planID,
+	})
+	if err != nil {
+		return diag.Errorf("error creating the workspace %s: %v", name, err)
+	}
+	d.SetId(*instance.GUID)
+
+	_, err = isWaitForPIWorkspaceActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+// getPIWorkspacePlanID returns the ID of the plan of the workspaces in the resource catalog
+func getPIWorkspacePlanID(meta interface{}) (string, error) {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return "", err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	serviceOff, err := rsCatRepo.FindByName(workspaceServiceName, true)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving the %s service offering: %v", workspaceServiceName, err)
+	}
+	if len(serviceOff) == 0 {
+		return "", fmt.Errorf("the %s service offering was not found", workspaceServiceName)
+	}
+	planID, err := rsCatRepo.GetServicePlanID(serviceOff[0], workspaceServicePlanName)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving the %s plan of the %s service offering: %v", workspaceServicePlanName, workspaceServiceName, err)
+	}
+	return planID, nil
+}
+
+func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	instance, response, err := client.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting the workspace %s: %v", id, err)
+	}
+	if *instance.State == resourcecontroller.RsInstanceRemovedStatus || *instance.State == resourcecontroller.RsInstanceReclamation {
+		log.Printf("[WARN] Removing the workspace %s from state because it is %s", id, *instance.State)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(Arg_WorkspaceName, instance.Name)
+	d.Set(Arg_WorkspaceDatacenter, instance.RegionID)
+	d.Set(Arg_WorkspaceResourceGroupID, instance.ResourceGroupID)
+	d.Set(Attr_WorkspaceCRN, instance.CRN)
+	d.Set(Attr_WorkspaceZone, instance.RegionID)
+	d.Set(Attr_WorkspaceStatus, instance.State)
+
+	return nil
+}
+
+func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(Arg_WorkspaceName) {
+		id := d.Id()
+		name := d.Get(Arg_WorkspaceName).(string)
+		_, _, err = client.UpdateResourceInstanceWithContext(ctx, &rc.UpdateResourceInstanceOptions{
+			ID:   &id,
+			Name: &name,
+		})
+		if err != nil {
+			return diag.Errorf("error updating the workspace %s: %v", id, err)
+		}
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	instance, response, err := client.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		if response != nil && (response.StatusCode == 404 || response.StatusCode == 410) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting the workspace %s: %v", id, err)
+	}
+	if *instance.State == resourcecontroller.RsInstanceRemovedStatus || *instance.State == resourcecontroller.RsInstanceReclamation {
+		d.SetId("")
+		return nil
+	}
+
+	// Deleting the workspace while instances, networks or volumes are still being deleted, by this or another
+	// configuration, leaves them orphaned, so the workspace is only deleted once they are gone
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(*instance.RegionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = isWaitForPIWorkspaceDependentsDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	recursive := true
+	response, err = client.DeleteResourceInstanceWithContext(ctx, &rc.DeleteResourceInstanceOptions{
+		ID:        &id,
+		Recursive: &recursive,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 410 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error deleting the workspace %s: %v", id, err)
+	}
+
+	_, err = isWaitForPIWorkspaceDeleted(ctx, client, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForPIWorkspaceActive(ctx context.Context, client *rc.ResourceControllerV2, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the workspace (%s) to be active.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus, resourcecontroller.RsInstanceProvisioningStatus},
+		Target:     []string{resourcecontroller.RsInstanceSuccessStatus},
+		Refresh:    isPIWorkspaceRefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForPIWorkspaceDeleted(ctx context.Context, client *rc.ResourceControllerV2, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the workspace (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus, resourcecontroller.RsInstanceSuccessStatus},
+		Target:     []string{resourcecontroller.RsInstanceRemovedStatus, resourcecontroller.RsInstanceReclamation},
+		Refresh:    isPIWorkspaceRefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPIWorkspaceRefreshFunc(ctx context.Context, client *rc.ResourceControllerV2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, response, err := client.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+			ID: &id,
+		})
+		if err != nil {
+			if response != nil && (response.StatusCode == 404 || response.StatusCode == 410) {
+				return response, resourcecontroller.RsInstanceRemovedStatus, nil
+			}
+			return nil, "", fmt.Errorf("error getting the workspace %s: %v", id, err)
+		}
+		if *instance.State == resourcecontroller.RsInstanceFailStatus {
+			return instance, *instance.State, fmt.Errorf("the workspace %s failed", id)
+		}
+		return instance, *instance.State, nil
+	}
+}
+
+func isWaitForPIWorkspaceDependentsDeleted(ctx context.Context, sess *ibmpisession.IBMPISession, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the instances, networks and volumes of the workspace (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{workspaceDependentsPending},
+		Target:     []string{workspaceDependentsDone},
+		Refresh:    isPIWorkspaceDependentsRefreshFunc(ctx, sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPIWorkspaceDependentsRefreshFunc(ctx context.Context, sess *ibmpisession.IBMPISession, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instances, err := st.NewIBMPIInstanceClient(ctx, sess, id).GetAll()
+		if err != nil {
+			return nil, "", fmt.Errorf("error listing the instances of the workspace %s: %v", id, err)
+		}
+		networks, err := st.NewIBMPINetworkClient(ctx, sess, id).GetAll()
+		if err != nil {
+			return nil, "", fmt.Errorf("error listing the networks of the workspace %s: %v", id, err)
+		}
+		volumes, err := st.NewIBMPIVolumeClient(ctx, sess, id).GetAll()
+		if err != nil {
+			return nil, "", fmt.Errorf("error listing the volumes of the workspace %s: %v", id, err)
+		}
+
+		remaining := len(instances.PvmInstances) + len(networks.Networks) + len(volumes.Volumes)
+		if remaining > 0 {
+			log.Printf("[DEBUG] The workspace %s still has %d instances, %d networks and %d volumes", id, len(instances.PvmInstances), len(networks.Networks), len(volumes.Volumes))
+			return remaining, workspaceDependentsPending, nil
+		}
+		return remaining, workspaceDependentsDone, nil
+	}
+}
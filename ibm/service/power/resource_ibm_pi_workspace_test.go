@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIWorkspacebasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-workspace-%d", acctest.RandIntRange(10, 100))
+	newName := fmt.Sprintf("%s-renamed", name)
+	workspaceRes := "ibm_pi_workspace.workspace"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists(workspaceRes),
+					resource.TestCheckResourceAttr(workspaceRes, "pi_name", name),
+					resource.TestCheckResourceAttr(workspaceRes, "pi_datacenter", acc.Pi_workspace_datacenter),
+					resource.TestCheckResourceAttr(workspaceRes, "zone", acc.Pi_workspace_datacenter),
+					resource.TestCheckResourceAttr(workspaceRes, "status", "active"),
+					resource.TestCheckResourceAttrSet(workspaceRes, "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(newName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists(workspaceRes),
+					resource.TestCheckResourceAttr(workspaceRes, "pi_name", newName),
+				),
+			},
+			{
+				ResourceName:      workspaceRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceDestroy(s *terraform.State) error {
+	client, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_workspace" {
+			continue
+		}
+		id := rs.Primary.ID
+		instance, _, err := client.GetResourceInstance(&rc.GetResourceInstanceOptions{
+			ID: &id,
+		})
+		if err == nil && *instance.State != resourcecontroller.RsInstanceRemovedStatus && *instance.State != resourcecontroller.RsInstanceReclamation {
+			return fmt.Errorf("Workspace still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPIWorkspaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		id := rs.Primary.ID
+		_, _, err = client.GetResourceInstance(&rc.GetResourceInstanceOptions{
+			ID: &id,
+		})
+		return err
+	}
+}
+
+func testAccCheckIBMPIWorkspaceConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+
+	resource "ibm_pi_workspace" "workspace" {
+		pi_name              = "%s"
+		pi_datacenter        = "%s"
+		pi_resource_group_id = data.ibm_resource_group.group.id
+	}`, name, acc.Pi_workspace_datacenter)
+}
@@ -51,7 +51,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -123,6 +123,13 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_resource_instance",
 					"tags"),
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
@@ -520,8 +527,8 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -552,7 +559,8 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"Error on get of resource instance tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
+	d.Set(flex.TagsAll, tags)
 	d.Set("name", instance.Name)
 	d.Set("status", instance.State)
 	d.Set("resource_group_id", instance.ResourceGroupID)
@@ -754,8 +762,8 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error Getting resource instance: %s with resp code: %s", err, resp)
 	}
 
-	if d.HasChange("tags") {
-		oldList, newList := d.GetChange("tags")
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
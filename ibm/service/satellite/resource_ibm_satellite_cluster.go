@@ -549,7 +549,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
 	d.Set("default_worker_pool_labels", flex.IgnoreSystemLabels(workerPool.Labels))
 	d.Set("host_labels", flex.FlattenWorkerPoolHostLabels(workerPool.HostLabels))
 	d.Set("operating_system", workerPool.OperatingSystem)
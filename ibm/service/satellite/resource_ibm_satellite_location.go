@@ -344,7 +344,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error on get of ibm satellite location tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
 	d.Set("crn", *instance.Crn)
 	d.Set(flex.ResourceGroupName, *instance.ResourceGroupName)
 	if instance.Hosts != nil {
@@ -0,0 +1,605 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	schematicsJobCompleted  = "COMPLETED"
+	schematicsJobCreated    = "CREATED"
+	schematicsJobFailed     = "FAILED"
+	schematicsJobInProgress = "INPROGRESS"
+	schematicsJobPending    = "PENDING"
+	schematicsJobStopped    = "STOPPED"
+
+	schematicsWorkspaceLocked   = "locked"
+	schematicsWorkspaceUnlocked = "unlocked"
+)
+
+func ResourceIBMSchematicsWorkspaceRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsWorkspaceRunCreate,
+		ReadContext:   resourceIBMSchematicsWorkspaceRunRead,
+		UpdateContext: resourceIBMSchematicsWorkspaceRunUpdate,
+		DeleteContext: resourceIBMSchematicsWorkspaceRunDelete,
+		CustomizeDiff: resourceIBMSchematicsWorkspaceRunDriftCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the workspace to run the jobs on.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region of the workspace, by default the region in the workspace ID.",
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the template of the workspace whose logs and outputs are read, by default the first template of the workspace.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values, a change of which runs the plan and apply jobs again.",
+			},
+			"apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to run an apply job after the plan job when the plan reports changes.",
+			},
+			"log_excerpt_lines": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      40,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of lines of the job log included in the error of a failed job.",
+			},
+			"drift_detection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Runs a drift detection job on the workspace once the interval has elapsed since the last job.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval_hours": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of hours between two drift detection jobs.",
+						},
+						"fail_on_drift": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether a drift of the workspace fails the run with the drift excerpt of the job log.",
+						},
+					},
+				},
+			},
+			"plan_job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the last plan job.",
+			},
+			"plan_has_changes": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the last plan job reported changes.",
+			},
+			"apply_job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the last apply job.",
+			},
+			"drift_job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the last drift detection job.",
+			},
+			"drift_detected": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the last drift detection job found a drift of the workspace resources.",
+			},
+			"last_drift_check_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last job the drift detection interval is counted from.",
+			},
+			"workspace_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the workspace.",
+			},
+			"string_outputs": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The string outputs of the workspace template.",
+			},
+			"number_outputs": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: "The number outputs of the workspace template.",
+			},
+			"bool_outputs": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "The bool outputs of the workspace template.",
+			},
+			"json_outputs": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The list, map and object outputs of the workspace template, encoded as JSON.",
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsWorkspaceRunCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	workspaceID := d.Get("workspace_id").(string)
+	d.SetId(workspaceID)
+
+	err := runSchematicsWorkspaceJobs(context, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSchematicsWorkspaceRunRead(context, d, meta)
+}
+
+func resourceIBMSchematicsWorkspaceRunRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsWorkspaceRunClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getWorkspaceOptions := &schematicsv1.GetWorkspaceOptions{}
+	getWorkspaceOptions.SetWID(d.Id())
+
+	workspaceResponse, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("workspace_id", d.Id())
+	d.Set("location", workspaceResponse.Location)
+	d.Set("workspace_status", workspaceResponse.Status)
+	templateID := d.Get("template_id").(string)
+	if templateID == "" {
+		if len(workspaceResponse.TemplateData) == 0 || workspaceResponse.TemplateData[0].ID == nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] The workspace %s has no template", d.Id()))
+		}
+		templateID = *workspaceResponse.TemplateData[0].ID
+		d.Set("template_id", templateID)
+	}
+
+	getWorkspaceOutputsOptions := &schematicsv1.GetWorkspaceOutputsOptions{}
+	getWorkspaceOutputsOptions.SetWID(d.Id())
+
+	outputValuesList, response, err := schematicsClient.GetWorkspaceOutputsWithContext(context, getWorkspaceOutputsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetWorkspaceOutputsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetWorkspaceOutputsWithContext failed %s\n%s", err, response))
+	}
+
+	var outputValues []interface{}
+	for _, outputValuesItem := range outputValuesList {
+		if outputValuesItem.ID != nil && *outputValuesItem.ID == templateID {
+			outputValues = outputValuesItem.OutputValues
+		}
+	}
+	stringOutputs, numberOutputs, boolOutputs, jsonOutputs, err := flattenSchematicsWorkspaceOutputs(outputValues)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("string_outputs", stringOutputs)
+	d.Set("number_outputs", numberOutputs)
+	d.Set("bool_outputs", boolOutputs)
+	d.Set("json_outputs", jsonOutputs)
+
+	return nil
+}
+
+func resourceIBMSchematicsWorkspaceRunUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChanges("triggers", "apply") {
+		err := runSchematicsWorkspaceJobs(context, d, meta, timeout)
+		if err != nil {
+			// Keep the previous values, so that the next apply runs the jobs again
+			o, _ := d.GetChange("triggers")
+			d.Set("triggers", o)
+			o, _ = d.GetChange("apply")
+			d.Set("apply", o)
+			return diag.FromErr(err)
+		}
+	} else if isSchematicsDriftCheckDue(d.Get("drift_detection").([]interface{}), d.Get("last_drift_check_at").(string), time.Now()) {
+		err := runSchematicsWorkspaceDriftJob(context, d, meta, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSchematicsWorkspaceRunRead(context, d, meta)
+}
+
+func resourceIBMSchematicsWorkspaceRunDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The jobs cannot be undone, the resources of the workspace are destroyed along with the workspace
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMSchematicsWorkspaceRunDriftCustomizeDiff plans a drift detection job once the drift detection interval
+// has elapsed since the last job, there is no schedule for the jobs of a workspace on the Schematics side
+func resourceIBMSchematicsWorkspaceRunDriftCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if !isSchematicsDriftCheckDue(diff.Get("drift_detection").([]interface{}), diff.Get("last_drift_check_at").(string), time.Now()) {
+		return nil
+	}
+	for _, key := range []string{"drift_job_id", "drift_detected", "last_drift_check_at"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isSchematicsDriftCheckDue(driftDetection []interface{}, lastCheckAt string, now time.Time) bool {
+	if len(driftDetection) == 0 || driftDetection[0] == nil {
+		return false
+	}
+	interval := time.Duration(driftDetection[0].(map[string]interface{})["interval_hours"].(int)) * time.Hour
+	last, err := time.Parse(time.RFC3339, lastCheckAt)
+	if err != nil {
+		return true
+	}
+	return !now.Before(last.Add(interval))
+}
+
+func schematicsWorkspaceRunClient(d *schema.ResourceData, meta interface{}) (*schematicsv1.SchematicsV1, error) {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return nil, err
+	}
+	region := strings.Split(d.Id(), ".")[0]
+	if r, ok := d.GetOk("location"); ok {
+		region = r.(string)
+	}
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+	return schematicsClient, nil
+}
+
+// runSchematicsWorkspaceJobs runs a plan job on the workspace, followed by an apply job when the plan reports changes
+func runSchematicsWorkspaceJobs(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	schematicsClient, err := schematicsWorkspaceRunClient(d, meta)
+	if err != nil {
+		return err
+	}
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	iamRefreshToken := session.Config.IAMRefreshToken
+	workspaceID := d.Id()
+
+	templateID, err := waitForSchematicsWorkspaceUnlocked(context, schematicsClient, workspaceID, d.Get("template_id").(string), timeout)
+	if err != nil {
+		return err
+	}
+
+	planWorkspaceCommandOptions := &schematicsv1.PlanWorkspaceCommandOptions{}
+	planWorkspaceCommandOptions.SetWID(workspaceID)
+	planWorkspaceCommandOptions.SetRefreshToken(iamRefreshToken)
+
+	planResult, response, err := schematicsClient.PlanWorkspaceCommandWithContext(context, planWorkspaceCommandOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PlanWorkspaceCommandWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("PlanWorkspaceCommandWithContext failed %s\n%s", err, response)
+	}
+	planJobID := *planResult.Activityid
+	d.Set("plan_job_id", planJobID)
+	d.Set("apply_job_id", "")
+
+	planLog, err := waitForSchematicsWorkspaceJob(context, schematicsClient, workspaceID, templateID, planJobID, "plan", d.Get("log_excerpt_lines").(int), timeout)
+	if err != nil {
+		return err
+	}
+	hasChanges := schematicsPlanHasChanges(planLog)
+	d.Set("plan_has_changes", hasChanges)
+
+	if hasChanges && d.Get("apply").(bool) {
+		_, err = waitForSchematicsWorkspaceUnlocked(context, schematicsClient, workspaceID, templateID, timeout)
+		if err != nil {
+			return err
+		}
+
+		applyWorkspaceCommandOptions := &schematicsv1.ApplyWorkspaceCommandOptions{}
+		applyWorkspaceCommandOptions.SetWID(workspaceID)
+		applyWorkspaceCommandOptions.SetRefreshToken(iamRefreshToken)
+
+		applyResult, response, err := schematicsClient.ApplyWorkspaceCommandWithContext(context, applyWorkspaceCommandOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ApplyWorkspaceCommandWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("ApplyWorkspaceCommandWithContext failed %s\n%s", err, response)
+		}
+		applyJobID := *applyResult.Activityid
+		d.Set("apply_job_id", applyJobID)
+
+		_, err = waitForSchematicsWorkspaceJob(context, schematicsClient, workspaceID, templateID, applyJobID, "apply", d.Get("log_excerpt_lines").(int), timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The workspace matches its template after the jobs, the drift detection interval starts over
+	if hasChanges && !d.Get("apply").(bool) {
+		d.Set("drift_detected", true)
+	} else {
+		d.Set("drift_detected", false)
+	}
+	d.Set("last_drift_check_at", time.Now().UTC().Format(time.RFC3339))
+	return nil
+}
+
+// runSchematicsWorkspaceDriftJob runs a drift detection job on the workspace and records whether it found a drift
+func runSchematicsWorkspaceDriftJob(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	schematicsClient, err := schematicsWorkspaceRunClient(d, meta)
+	if err != nil {
+		return err
+	}
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	workspaceID := d.Id()
+	excerptLines := d.Get("log_excerpt_lines").(int)
+
+	templateID, err := waitForSchematicsWorkspaceUnlocked(context, schematicsClient, workspaceID, d.Get("template_id").(string), timeout)
+	if err != nil {
+		return err
+	}
+
+	runWorkspaceCommandsOptions := &schematicsv1.RunWorkspaceCommandsOptions{}
+	runWorkspaceCommandsOptions.SetWID(workspaceID)
+	runWorkspaceCommandsOptions.SetRefreshToken(session.Config.IAMRefreshToken)
+	runWorkspaceCommandsOptions.SetOperationName("drift-detection")
+	runWorkspaceCommandsOptions.SetCommands([]schematicsv1.TerraformCommand{
+		{
+			Command:     core.StringPtr("drift"),
+			CommandName: core.StringPtr("drift-detection"),
+		},
+	})
+
+	result, response, err := schematicsClient.RunWorkspaceCommandsWithContext(context, runWorkspaceCommandsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] RunWorkspaceCommandsWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("RunWorkspaceCommandsWithContext failed %s\n%s", err, response)
+	}
+	driftJobID := *result.Activityid
+	d.Set("drift_job_id", driftJobID)
+
+	driftLog, err := waitForSchematicsWorkspaceJob(context, schematicsClient, workspaceID, templateID, driftJobID, "drift detection", excerptLines, timeout)
+	if err != nil {
+		return err
+	}
+	driftDetected := schematicsLogHasDrift(driftLog)
+	d.Set("drift_detected", driftDetected)
+	d.Set("last_drift_check_at", time.Now().UTC().Format(time.RFC3339))
+
+	driftDetection := d.Get("drift_detection").([]interface{})[0].(map[string]interface{})
+	if driftDetected && driftDetection["fail_on_drift"].(bool) {
+		return fmt.Errorf("[ERROR] The drift detection job %s found a drift of the workspace %s:\n%s", driftJobID, workspaceID, schematicsJobLogExcerpt(driftLog, excerptLines))
+	}
+	return nil
+}
+
+// waitForSchematicsWorkspaceUnlocked waits for the running jobs of the workspace to finish, and returns the ID of the
+// template, by default the first template of the workspace
+func waitForSchematicsWorkspaceUnlocked(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID, templateID string, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{schematicsWorkspaceLocked},
+		Target:  []string{schematicsWorkspaceUnlocked},
+		Refresh: func() (interface{}, string, error) {
+			getWorkspaceOptions := &schematicsv1.GetWorkspaceOptions{}
+			getWorkspaceOptions.SetWID(workspaceID)
+
+			workspaceResponse, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response)
+			}
+			if workspaceResponse.WorkspaceStatus != nil && workspaceResponse.WorkspaceStatus.Locked != nil && *workspaceResponse.WorkspaceStatus.Locked {
+				return workspaceResponse, schematicsWorkspaceLocked, nil
+			}
+			return workspaceResponse, schematicsWorkspaceUnlocked, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	v, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error waiting for the workspace %s to be unlocked: %s", workspaceID, err)
+	}
+	if templateID != "" {
+		return templateID, nil
+	}
+	workspaceResponse := v.(*schematicsv1.WorkspaceResponse)
+	if len(workspaceResponse.TemplateData) == 0 || workspaceResponse.TemplateData[0].ID == nil {
+		return "", fmt.Errorf("[ERROR] The workspace %s has no template", workspaceID)
+	}
+	return *workspaceResponse.TemplateData[0].ID, nil
+}
+
+// waitForSchematicsWorkspaceJob waits for the job to finish and returns its log, a failed job returns an error with
+// the relevant excerpt of the log
+func waitForSchematicsWorkspaceJob(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID, templateID, jobID, name string, excerptLines int, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{schematicsJobCreated, schematicsJobPending, schematicsJobInProgress},
+		Target:  []string{schematicsJobCompleted, schematicsJobFailed, schematicsJobStopped},
+		Refresh: func() (interface{}, string, error) {
+			getWorkspaceActivityOptions := &schematicsv1.GetWorkspaceActivityOptions{}
+			getWorkspaceActivityOptions.SetWID(workspaceID)
+			getWorkspaceActivityOptions.SetActivityID(jobID)
+
+			activity, response, err := schematicsClient.GetWorkspaceActivityWithContext(context, getWorkspaceActivityOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetWorkspaceActivityWithContext failed %s\n%s", err, response)
+			}
+			status := schematicsJobCreated
+			if activity.Status != nil {
+				// The status is reported as IN PROGRESS as well as INPROGRESS
+				status = strings.ReplaceAll(strings.ToUpper(*activity.Status), " ", "")
+			}
+			log.Printf("[DEBUG] The %s job %s of the workspace %s is %s", name, jobID, workspaceID, status)
+			return activity, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	v, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error waiting for the %s job %s of the workspace %s: %s", name, jobID, workspaceID, err)
+	}
+	activity := v.(*schematicsv1.WorkspaceActivity)
+
+	getTemplateActivityLogOptions := &schematicsv1.GetTemplateActivityLogOptions{}
+	getTemplateActivityLogOptions.SetWID(workspaceID)
+	getTemplateActivityLogOptions.SetTID(templateID)
+	getTemplateActivityLogOptions.SetActivityID(jobID)
+
+	jobLog, response, logErr := schematicsClient.GetTemplateActivityLogWithContext(context, getTemplateActivityLogOptions)
+	if logErr != nil {
+		log.Printf("[DEBUG] GetTemplateActivityLogWithContext failed %s\n%s", logErr, response)
+	}
+
+	status := strings.ReplaceAll(strings.ToUpper(*activity.Status), " ", "")
+	if status == schematicsJobCompleted {
+		if logErr != nil {
+			return "", fmt.Errorf("GetTemplateActivityLogWithContext failed %s\n%s", logErr, response)
+		}
+		return *jobLog, nil
+	}
+
+	excerpt := strings.Join(activity.Message, "\n")
+	if logErr == nil && jobLog != nil {
+		excerpt = schematicsJobLogExcerpt(*jobLog, excerptLines)
+	}
+	return "", fmt.Errorf("[ERROR] The %s job %s of the workspace %s finished with status %s:\n%s", name, jobID, workspaceID, status, excerpt)
+}
+
+// schematicsJobLogExcerpt returns the lines of the log from the first error on, or the last lines of the log when it
+// has no error
+func schematicsJobLogExcerpt(jobLog string, lines int) string {
+	logLines := strings.Split(strings.TrimRight(jobLog, "\n"), "\n")
+	start := len(logLines) - lines
+	for i, line := range logLines {
+		if strings.Contains(line, "Error:") || strings.Contains(line, "[ERROR]") {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + lines
+	if end > len(logLines) {
+		end = len(logLines)
+	}
+	return strings.Join(logLines[start:end], "\n")
+}
+
+// schematicsPlanHasChanges returns whether the log of a plan job reports changes to the workspace resources
+func schematicsPlanHasChanges(planLog string) bool {
+	return !strings.Contains(planLog, "No changes.")
+}
+
+// schematicsLogHasDrift returns whether the log of a drift detection job reports resources which changed outside of
+// the workspace
+func schematicsLogHasDrift(driftLog string) bool {
+	lower := strings.ToLower(driftLog)
+	return strings.Contains(lower, "changed outside of terraform") || strings.Contains(lower, "drift detected")
+}
+
+// flattenSchematicsWorkspaceOutputs sorts the output values of a workspace template by the type of their value
+func flattenSchematicsWorkspaceOutputs(outputValues []interface{}) (stringOutputs, numberOutputs, boolOutputs, jsonOutputs map[string]interface{}, err error) {
+	stringOutputs = map[string]interface{}{}
+	numberOutputs = map[string]interface{}{}
+	boolOutputs = map[string]interface{}{}
+	jsonOutputs = map[string]interface{}{}
+
+	for _, outputValue := range outputValues {
+		outputs, ok := outputValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, output := range outputs {
+			fields, ok := output.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch value := fields["value"].(type) {
+			case string:
+				stringOutputs[name] = value
+			case float64:
+				numberOutputs[name] = value
+			case bool:
+				boolOutputs[name] = value
+			default:
+				valueJSON, err := json.Marshal(value)
+				if err != nil {
+					return nil, nil, nil, nil, fmt.Errorf("[ERROR] Error encoding the output %s: %s", name, err)
+				}
+				jsonOutputs[name] = string(valueJSON)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSchematicsJobLogExcerpt(t *testing.T) {
+	testcases := []struct {
+		name    string
+		log     string
+		lines   int
+		excerpt string
+	}{
+		{"last lines", "one\ntwo\nthree\nfour\n", 2, "three\nfour"},
+		{"short log", "one\ntwo\n", 5, "one\ntwo"},
+		{"from the first error", "init\nError: invalid\ndetail\nmore\nError: again\n", 2, "Error: invalid\ndetail"},
+		{"provider error", "plan\n[ERROR] timeout\nend\n", 5, "[ERROR] timeout\nend"},
+	}
+	for _, tc := range testcases {
+		if excerpt := schematicsJobLogExcerpt(tc.log, tc.lines); excerpt != tc.excerpt {
+			t.Errorf("%s: got %q, expected %q", tc.name, excerpt, tc.excerpt)
+		}
+	}
+}
+
+func TestSchematicsPlanHasChanges(t *testing.T) {
+	testcases := []struct {
+		name    string
+		log     string
+		changes bool
+	}{
+		{"no changes", "Refreshing state...\nNo changes. Your infrastructure matches the configuration.\n", false},
+		{"changes", "Plan: 1 to add, 0 to change, 0 to destroy.\n", true},
+		{"empty log", "", true},
+	}
+	for _, tc := range testcases {
+		if changes := schematicsPlanHasChanges(tc.log); changes != tc.changes {
+			t.Errorf("%s: got %t, expected %t", tc.name, changes, tc.changes)
+		}
+	}
+}
+
+func TestFlattenSchematicsWorkspaceOutputs(t *testing.T) {
+	outputValues := []interface{}{
+		map[string]interface{}{
+			"name":     map[string]interface{}{"value": "web"},
+			"port":     map[string]interface{}{"value": 443.0},
+			"public":   map[string]interface{}{"value": true},
+			"zones":    map[string]interface{}{"value": []interface{}{"us-south-1", "us-south-2"}},
+			"tags":     map[string]interface{}{"value": map[string]interface{}{"env": "dev"}},
+			"nothing":  map[string]interface{}{"value": nil},
+			"unparsed": "value",
+		},
+		"unparsed",
+	}
+	stringOutputs, numberOutputs, boolOutputs, jsonOutputs, err := flattenSchematicsWorkspaceOutputs(outputValues)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	testcases := []struct {
+		name     string
+		outputs  map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{"string", stringOutputs, map[string]interface{}{"name": "web"}},
+		{"number", numberOutputs, map[string]interface{}{"port": 443.0}},
+		{"bool", boolOutputs, map[string]interface{}{"public": true}},
+		{"json", jsonOutputs, map[string]interface{}{"zones": `["us-south-1","us-south-2"]`, "tags": `{"env":"dev"}`, "nothing": "null"}},
+	}
+	for _, tc := range testcases {
+		if !reflect.DeepEqual(tc.outputs, tc.expected) {
+			t.Errorf("%s outputs: got %v, expected %v", tc.name, tc.outputs, tc.expected)
+		}
+	}
+}
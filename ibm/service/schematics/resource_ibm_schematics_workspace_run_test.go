@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsWorkspaceRunBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceRunConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_run.run", "workspace_id", acc.WorkspaceID),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_run.run", "plan_job_id"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_run.run", "template_id"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_run.run", "last_drift_check_at"),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_run.run", "drift_detected", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceRunConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_run.run", "triggers.run", "2"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_run.run", "plan_job_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceRunConfig(run string) string {
+	return fmt.Sprintf(`
+	resource "ibm_schematics_workspace_run" "run" {
+		workspace_id = "%s"
+		triggers = {
+			run = "%s"
+		}
+		drift_detection {
+			interval_hours = 24
+		}
+	}
+	`, acc.WorkspaceID, run)
+}
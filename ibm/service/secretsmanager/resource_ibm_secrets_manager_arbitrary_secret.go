@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerArbitrarySecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerArbitrarySecretCreate,
+		ReadContext:   resourceIBMSecretsManagerArbitrarySecretRead,
+		UpdateContext: resourceIBMSecretsManagerArbitrarySecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerArbitrarySecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceIBMSecretsManagerSecretSchema("ibm_secrets_manager_arbitrary_secret", map[string]*schema.Schema{
+			"payload": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret data to assign to the secret. Changing the payload creates a new version of the secret.",
+			},
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSecretsManagerDateDiff,
+				Description:      "The date the secret material expires. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerArbitrarySecretValidator() *validate.ResourceValidator {
+	return resourceIBMSecretsManagerSecretValidator("ibm_secrets_manager_arbitrary_secret")
+}
+
+func resourceIBMSecretsManagerArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getSecretsManagerSession(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expirationDate, err := expandSecretsManagerExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretResource := &secretsmanagerv1.SecretResource{
+		Name:           core.StringPtr(d.Get("name").(string)),
+		Description:    core.StringPtr(d.Get("description").(string)),
+		Labels:         flex.ExpandStringList(d.Get("labels").([]interface{})),
+		Payload:        core.StringPtr(d.Get("payload").(string)),
+		ExpirationDate: expirationDate,
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+
+	if err = createSecretsManagerSecret(d, client, secretTypeArbitrary, secretResource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secret, err := getSecretsManagerSecret(client, secretTypeArbitrary, secretID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setSecretsManagerSecretAttributes(d, instanceID, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	payload := secret.Payload
+	if payload == nil {
+		if p, ok := secretsManagerSecretData(secret)["payload"].(string); ok {
+			payload = &p
+		}
+	}
+	if err = d.Set("payload", payload); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting payload: %s", err))
+	}
+	if secret.ExpirationDate != nil {
+		if err = d.Set("expiration_date", secret.ExpirationDate.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting expiration_date: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerArbitrarySecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateSecretsManagerSecretMetadata(d, client, secretTypeArbitrary, secretID, "expiration_date"); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("payload") {
+		action := &secretsmanagerv1.SecretActionOneOfRotateArbitrarySecretBody{
+			Payload: core.StringPtr(d.Get("payload").(string)),
+		}
+		if err = rotateSecretsManagerSecret(client, secretTypeArbitrary, secretID, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerArbitrarySecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretTypeArbitrary); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerArbitrarySecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-arbitrary-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerArbitrarySecretConfig(name, "secret-payload", "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.arbitrary_secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.arbitrary_secret", "payload", "secret-payload"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.arbitrary_secret", "labels.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_arbitrary_secret.arbitrary_secret", "secret_id"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_arbitrary_secret.arbitrary_secret", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerArbitrarySecretConfig(name, "secret-payload-rotated", "2031-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.arbitrary_secret", "payload", "secret-payload-rotated"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.arbitrary_secret", "versions_total", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_secrets_manager_arbitrary_secret.arbitrary_secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"expiration_date"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerArbitrarySecretConfig(name, payload, expirationDate string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_arbitrary_secret" "arbitrary_secret" {
+			instance_id = "%s"
+			name = "%s"
+			description = "Terraform arbitrary secret"
+			labels = ["terraform"]
+			payload = "%s"
+			expiration_date = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, name, payload, expirationDate)
+}
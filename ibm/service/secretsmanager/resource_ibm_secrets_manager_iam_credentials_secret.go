@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerIamCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerIamCredentialsSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerIamCredentialsSecretRead,
+		UpdateContext: resourceIBMSecretsManagerIamCredentialsSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerIamCredentialsSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceIBMSecretsManagerSecretSchema("ibm_secrets_manager_iam_credentials_secret", map[string]*schema.Schema{
+			"ttl": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressSecretsManagerTTLDiff,
+				Description:      "The time-to-live (TTL) or lease duration to assign to generated credentials. The value can be either an integer that specifies the number of seconds, or the string representation of a duration, such as 120m or 24h.",
+			},
+			"access_groups": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"service_id"},
+				Description:   "The access groups that define the capabilities of the service ID and API key that are generated for the secret.",
+			},
+			"service_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"access_groups"},
+				Description:   "The service ID under which the API key is created. If not specified, a service ID is generated for the secret.",
+			},
+			"reuse_api_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Set to true to reuse the service ID and API key for future read operations.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for the secret.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerIamCredentialsSecretValidator() *validate.ResourceValidator {
+	return resourceIBMSecretsManagerSecretValidator("ibm_secrets_manager_iam_credentials_secret")
+}
+
+func resourceIBMSecretsManagerIamCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getSecretsManagerSession(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &secretsmanagerv1.SecretResource{
+		Name:        core.StringPtr(d.Get("name").(string)),
+		Description: core.StringPtr(d.Get("description").(string)),
+		Labels:      flex.ExpandStringList(d.Get("labels").([]interface{})),
+		TTL:         d.Get("ttl").(string),
+		ReuseAPIKey: core.BoolPtr(d.Get("reuse_api_key").(bool)),
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	if accessGroups, ok := d.GetOk("access_groups"); ok {
+		secretResource.AccessGroups = flex.ExpandStringList(accessGroups.([]interface{}))
+	}
+	if serviceID, ok := d.GetOk("service_id"); ok {
+		secretResource.ServiceID = core.StringPtr(serviceID.(string))
+	}
+
+	if err = createSecretsManagerSecret(d, client, secretTypeIamCredentials, secretResource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerIamCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIamCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secret, err := getSecretsManagerSecret(client, secretTypeIamCredentials, secretID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setSecretsManagerSecretAttributes(d, instanceID, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if secret.TTL != nil {
+		ttl := fmt.Sprint(secret.TTL)
+		if seconds, ok := secret.TTL.(float64); ok {
+			ttl = fmt.Sprintf("%d", int64(seconds))
+		}
+		if err = d.Set("ttl", ttl); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting ttl: %s", err))
+		}
+	}
+	if secret.AccessGroups != nil {
+		if err = d.Set("access_groups", secret.AccessGroups); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting access_groups: %s", err))
+		}
+	}
+	if secret.ReuseAPIKey != nil {
+		if err = d.Set("reuse_api_key", secret.ReuseAPIKey); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting reuse_api_key: %s", err))
+		}
+	}
+	secretData := secretsManagerSecretData(secret)
+	serviceID, apiKey := secret.ServiceID, secret.APIKey
+	if s, ok := secretData["service_id"].(string); ok && serviceID == nil {
+		serviceID = &s
+	}
+	if a, ok := secretData["api_key"].(string); ok && apiKey == nil {
+		apiKey = &a
+	}
+	if err = d.Set("service_id", serviceID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting service_id: %s", err))
+	}
+	if err = d.Set("api_key", apiKey); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting api_key: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerIamCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateSecretsManagerSecretMetadata(d, client, secretTypeIamCredentials, secretID, "ttl"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerIamCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIamCredentialsSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretTypeIamCredentials); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerIamCredentialsSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-iam-credentials-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerIamCredentialsSecretConfig(name, "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_iam_credentials_secret.iam_credentials_secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_iam_credentials_secret.iam_credentials_secret", "reuse_api_key", "true"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_iam_credentials_secret.iam_credentials_secret", "service_id"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_iam_credentials_secret.iam_credentials_secret", "api_key"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerIamCredentialsSecretConfig(name, "2h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_iam_credentials_secret.iam_credentials_secret", "ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerIamCredentialsSecretConfig(name, ttl string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "access_group" {
+			name = "%[2]s"
+		}
+		resource "ibm_secrets_manager_iam_credentials_secret" "iam_credentials_secret" {
+			instance_id = "%[1]s"
+			name = "%[2]s"
+			ttl = "%[3]s"
+			access_groups = [ibm_iam_access_group.access_group.id]
+			reuse_api_key = true
+		}
+	`, acc.SecretsManagerInstanceID, name, ttl)
+}
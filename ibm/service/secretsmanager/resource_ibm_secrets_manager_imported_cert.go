@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importedCertSecretResource adds the certificate fields which the Secrets Manager SDK does not model to SecretResource
+type importedCertSecretResource struct {
+	secretsmanagerv1.SecretResource
+	Certificate  *string `json:"certificate,omitempty"`
+	PrivateKey   *string `json:"private_key,omitempty"`
+	Intermediate *string `json:"intermediate,omitempty"`
+}
+
+// importedCertRotateAction is the body of the rotate action of an imported_cert secret
+type importedCertRotateAction struct {
+	secretsmanagerv1.SecretActionOneOf
+	Certificate  *string `json:"certificate"`
+	PrivateKey   *string `json:"private_key,omitempty"`
+	Intermediate *string `json:"intermediate,omitempty"`
+}
+
+func ResourceIBMSecretsManagerImportedCert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerImportedCertCreate,
+		ReadContext:   resourceIBMSecretsManagerImportedCertRead,
+		UpdateContext: resourceIBMSecretsManagerImportedCertUpdate,
+		DeleteContext: resourceIBMSecretsManagerImportedCertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceIBMSecretsManagerSecretSchema("ibm_secrets_manager_imported_cert", map[string]*schema.Schema{
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressSecretsManagerPEMDiff,
+				Description:      "The PEM encoded contents of the certificate. Changing the certificate creates a new version of the secret.",
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSecretsManagerPEMDiff,
+				Description:      "The PEM encoded private key to associate with the certificate.",
+			},
+			"intermediate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSecretsManagerPEMDiff,
+				Description:      "The PEM encoded intermediate certificate to associate with the root certificate.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the certificate expires. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerImportedCertValidator() *validate.ResourceValidator {
+	return resourceIBMSecretsManagerSecretValidator("ibm_secrets_manager_imported_cert")
+}
+
+func resourceIBMSecretsManagerImportedCertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getSecretsManagerSession(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &importedCertSecretResource{
+		SecretResource: secretsmanagerv1.SecretResource{
+			Name:        core.StringPtr(d.Get("name").(string)),
+			Description: core.StringPtr(d.Get("description").(string)),
+			Labels:      flex.ExpandStringList(d.Get("labels").([]interface{})),
+		},
+		Certificate: core.StringPtr(d.Get("certificate").(string)),
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	if privateKey, ok := d.GetOk("private_key"); ok {
+		secretResource.PrivateKey = core.StringPtr(privateKey.(string))
+	}
+	if intermediate, ok := d.GetOk("intermediate"); ok {
+		secretResource.Intermediate = core.StringPtr(intermediate.(string))
+	}
+
+	if err = createSecretsManagerSecret(d, client, secretTypeImportedCert, secretResource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerImportedCertRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerImportedCertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secret, err := getSecretsManagerSecret(client, secretTypeImportedCert, secretID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setSecretsManagerSecretAttributes(d, instanceID, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	secretData := secretsManagerSecretData(secret)
+	for _, key := range []string{"certificate", "private_key", "intermediate"} {
+		if value, ok := secretData[key].(string); ok {
+			if err = d.Set(key, value); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", key, err))
+			}
+		}
+	}
+	if secret.ExpirationDate != nil {
+		if err = d.Set("expiration_date", secret.ExpirationDate.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting expiration_date: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerImportedCertUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateSecretsManagerSecretMetadata(d, client, secretTypeImportedCert, secretID); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges("certificate", "private_key", "intermediate") {
+		action := &importedCertRotateAction{
+			Certificate: core.StringPtr(d.Get("certificate").(string)),
+		}
+		if privateKey, ok := d.GetOk("private_key"); ok {
+			action.PrivateKey = core.StringPtr(privateKey.(string))
+		}
+		if intermediate, ok := d.GetOk("intermediate"); ok {
+			action.Intermediate = core.StringPtr(intermediate.(string))
+		}
+		if err = rotateSecretsManagerSecret(client, secretTypeImportedCert, secretID, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerImportedCertRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerImportedCertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretTypeImportedCert); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// suppressSecretsManagerPEMDiff ignores the surrounding whitespace the service strips from PEM encoded values
+func suppressSecretsManagerPEMDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerImportedCertBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-imported-cert-%d", acctest.RandIntRange(10, 100))
+	certificate, privateKey := testAccSecretsManagerSelfSignedCert(t, "terraform.example.com")
+	certificateUpdate, privateKeyUpdate := testAccSecretsManagerSelfSignedCert(t, "terraform.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertConfig(name, certificate, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_cert.imported_cert", "name", name),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_imported_cert.imported_cert", "secret_id"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_imported_cert.imported_cert", "expiration_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertConfig(name, certificateUpdate, privateKeyUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_cert.imported_cert", "versions_total", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerImportedCertConfig(name, certificate, privateKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_imported_cert" "imported_cert" {
+			instance_id = "%s"
+			name = "%s"
+			certificate = <<EOT
+%sEOT
+			private_key = <<EOT
+%sEOT
+		}
+	`, acc.SecretsManagerInstanceID, name, certificate, privateKey)
+}
+
+func testAccSecretsManagerSelfSignedCert(t *testing.T, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error creating certificate: %s", err)
+	}
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(certificate), string(privateKey)
+}
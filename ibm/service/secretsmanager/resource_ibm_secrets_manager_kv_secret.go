@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kvSecretResource replaces the string payload of SecretResource with the key-value payload of a kv secret
+type kvSecretResource struct {
+	secretsmanagerv1.SecretResource
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// kvRotateAction is the body of the rotate action of a kv secret
+type kvRotateAction struct {
+	secretsmanagerv1.SecretActionOneOf
+	Payload map[string]interface{} `json:"payload"`
+}
+
+func ResourceIBMSecretsManagerKvSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerKvSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerKvSecretRead,
+		UpdateContext: resourceIBMSecretsManagerKvSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerKvSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceIBMSecretsManagerSecretSchema("ibm_secrets_manager_kv_secret", map[string]*schema.Schema{
+			"data": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key-value pairs to assign to the secret. Changing the data creates a new version of the secret.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerKvSecretValidator() *validate.ResourceValidator {
+	return resourceIBMSecretsManagerSecretValidator("ibm_secrets_manager_kv_secret")
+}
+
+func resourceIBMSecretsManagerKvSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getSecretsManagerSession(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &kvSecretResource{
+		SecretResource: secretsmanagerv1.SecretResource{
+			Name:        core.StringPtr(d.Get("name").(string)),
+			Description: core.StringPtr(d.Get("description").(string)),
+			Labels:      flex.ExpandStringList(d.Get("labels").([]interface{})),
+		},
+		Payload: d.Get("data").(map[string]interface{}),
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+
+	if err = createSecretsManagerSecret(d, client, secretTypeKv, secretResource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerKvSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secret, err := getSecretsManagerSecret(client, secretTypeKv, secretID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setSecretsManagerSecretAttributes(d, instanceID, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if payload, ok := secretsManagerSecretData(secret)["payload"].(map[string]interface{}); ok {
+		data, err := flattenSecretsManagerKvPayload(payload)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("data", data); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting data: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerKvSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateSecretsManagerSecretMetadata(d, client, secretTypeKv, secretID); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("data") {
+		action := &kvRotateAction{
+			Payload: d.Get("data").(map[string]interface{}),
+		}
+		if err = rotateSecretsManagerSecret(client, secretTypeKv, secretID, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerKvSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerKvSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretTypeKv); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// flattenSecretsManagerKvPayload converts the kv payload to a map of strings, nested values are JSON encoded
+func flattenSecretsManagerKvPayload(payload map[string]interface{}) (map[string]string, error) {
+	data := make(map[string]string, len(payload))
+	for k, v := range payload {
+		if s, ok := v.(string); ok {
+			data[k] = s
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error encoding the value of %s: %s", k, err)
+		}
+		data[k] = string(b)
+	}
+	return data, nil
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerKvSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-kv-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerKvSecretConfig(name, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.kv_secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.kv_secret", "data.%", "2"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.kv_secret", "data.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerKvSecretConfig(name, "value1-rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.kv_secret", "data.key1", "value1-rotated"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_kv_secret.kv_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerKvSecretConfig(name, value string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_kv_secret" "kv_secret" {
+			instance_id = "%s"
+			name = "%s"
+			data = {
+				key1 = "%s"
+				key2 = "value2"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, name, value)
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerSecretGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretGroupCreate,
+		ReadContext:   resourceIBMSecretsManagerSecretGroupRead,
+		UpdateContext: resourceIBMSecretsManagerSecretGroupUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the Secrets Manager instance.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validate.InvokeValidator("ibm_secrets_manager_secret_group", "endpoint_type"),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable name to assign to the secret group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of the secret group.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the secret group.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was last updated. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func ResourceIBMSecretsManagerSecretGroupValidator() *validate.ResourceValidator {
+	return resourceIBMSecretsManagerSecretValidator("ibm_secrets_manager_secret_group")
+}
+
+func resourceIBMSecretsManagerSecretGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretGroup := secretsmanagerv1.SecretGroupResource{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretGroup.Description = core.StringPtr(description.(string))
+	}
+	createSecretGroupOptions := &secretsmanagerv1.CreateSecretGroupOptions{
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretGroupResource{secretGroup},
+	}
+
+	secretGroupDef, response, err := client.CreateSecretGroup(createSecretGroupOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretGroup failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateSecretGroup failed %s\n%s", err, response))
+	}
+	if len(secretGroupDef.Resources) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateSecretGroup returned no secret group"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *secretGroupDef.Resources[0].ID))
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretGroupID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretGroupOptions := &secretsmanagerv1.GetSecretGroupOptions{
+		ID: core.StringPtr(secretGroupID),
+	}
+	secretGroupDef, response, err := client.GetSecretGroup(getSecretGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretGroup failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] GetSecretGroup failed %s\n%s", err, response))
+	}
+	if len(secretGroupDef.Resources) == 0 {
+		d.SetId("")
+		return nil
+	}
+	secretGroup := secretGroupDef.Resources[0]
+
+	if err = d.Set("instance_id", instanceID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_id: %s", err))
+	}
+	if err = d.Set("secret_group_id", secretGroup.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_group_id: %s", err))
+	}
+	if err = d.Set("name", secretGroup.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("description", secretGroup.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if secretGroup.CreationDate != nil {
+		if err = d.Set("creation_date", secretGroup.CreationDate.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting creation_date: %s", err))
+		}
+	}
+	if secretGroup.LastUpdateDate != nil {
+		if err = d.Set("last_update_date", secretGroup.LastUpdateDate.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting last_update_date: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretGroupID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		updateSecretGroupMetadataOptions := &secretsmanagerv1.UpdateSecretGroupMetadataOptions{
+			ID: core.StringPtr(secretGroupID),
+			Metadata: &secretsmanagerv1.CollectionMetadata{
+				CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+				CollectionTotal: core.Int64Ptr(1),
+			},
+			Resources: []secretsmanagerv1.SecretGroupMetadataUpdatable{
+				{
+					Name:        core.StringPtr(d.Get("name").(string)),
+					Description: core.StringPtr(d.Get("description").(string)),
+				},
+			},
+		}
+		_, response, err := client.UpdateSecretGroupMetadata(updateSecretGroupMetadataOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretGroupMetadata failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] UpdateSecretGroupMetadata failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretGroupID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteSecretGroupOptions := &secretsmanagerv1.DeleteSecretGroupOptions{
+		ID: core.StringPtr(secretGroupID),
+	}
+	response, err := client.DeleteSecretGroup(deleteSecretGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteSecretGroup failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteSecretGroup failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
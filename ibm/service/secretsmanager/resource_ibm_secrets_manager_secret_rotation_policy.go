@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretPolicyRotation = "rotation"
+
+func ResourceIBMSecretsManagerSecretRotationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretRotationPolicyPut,
+		ReadContext:   resourceIBMSecretsManagerSecretRotationPolicyRead,
+		UpdateContext: resourceIBMSecretsManagerSecretRotationPolicyPut,
+		DeleteContext: resourceIBMSecretsManagerSecretRotationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the Secrets Manager instance.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validate.InvokeValidator("ibm_secrets_manager_secret_rotation_policy", "endpoint_type"),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      secretTypeUsernamePassword,
+				ValidateFunc: validate.InvokeValidator("ibm_secrets_manager_secret_rotation_policy", "secret_type"),
+				Description:  "The secret type. Supported options include: username_password.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The v4 UUID that uniquely identifies the secret.",
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The length of the secret rotation time interval.",
+			},
+			"unit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_secrets_manager_secret_rotation_policy", "unit"),
+				Description:  "The units for the secret rotation time interval. Supported options include: day, month.",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the policy.",
+			},
+		},
+	}
+}
+
+func ResourceIBMSecretsManagerSecretRotationPolicyValidator() *validate.ResourceValidator {
+	validator := resourceIBMSecretsManagerSecretValidator("ibm_secrets_manager_secret_rotation_policy")
+	validator.Schema = append(validator.Schema,
+		validate.ValidateSchema{
+			Identifier:                 "secret_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              secretTypeUsernamePassword,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "unit",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "day, month",
+		})
+	return validator
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyPut(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretType := d.Get("secret_type").(string)
+	secretID := d.Get("secret_id").(string)
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	putPolicyOptions := &secretsmanagerv1.PutPolicyOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+		Policy:     core.StringPtr(secretPolicyRotation),
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretPolicyRotation{
+			{
+				Type: core.StringPtr(secretsmanagerv1.SecretPolicyRotationTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+				Rotation: &secretsmanagerv1.SecretPolicyRotationRotation{
+					Interval: core.Int64Ptr(int64(d.Get("interval").(int))),
+					Unit:     core.StringPtr(d.Get("unit").(string)),
+				},
+			},
+		},
+	}
+	_, response, err := client.PutPolicy(putPolicyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutPolicy failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] PutPolicy failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, secretType, secretID))
+
+	return resourceIBMSecretsManagerSecretRotationPolicyRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceID/secretType/secretID", d.Id()))
+	}
+	instanceID, secretType, secretID := parts[0], parts[1], parts[2]
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getPolicyOptions := &secretsmanagerv1.GetPolicyOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+		Policy:     core.StringPtr(secretPolicyRotation),
+	}
+	result, response, err := client.GetPolicy(getPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPolicy failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] GetPolicy failed %s\n%s", err, response))
+	}
+	policies, ok := result.(*secretsmanagerv1.GetSecretPoliciesOneOf)
+	if !ok || len(policies.Resources) == 0 || policies.Resources[0].Rotation == nil {
+		d.SetId("")
+		return nil
+	}
+	policy := policies.Resources[0]
+
+	if err = d.Set("instance_id", instanceID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_id: %s", err))
+	}
+	if err = d.Set("secret_type", secretType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_type: %s", err))
+	}
+	if err = d.Set("secret_id", secretID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_id: %s", err))
+	}
+	if err = d.Set("policy_id", policy.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting policy_id: %s", err))
+	}
+	if err = d.Set("interval", flex.IntValue(policy.Rotation.Interval)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting interval: %s", err))
+	}
+	if err = d.Set("unit", policy.Rotation.Unit); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting unit: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The API has no way to remove a rotation policy, it is removed together with the secret
+	d.SetId("")
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "The rotation policy was removed from the state only",
+			Detail:   "Secrets Manager does not support deleting a rotation policy, it remains in effect until the secret is deleted.",
+		},
+	}
+}
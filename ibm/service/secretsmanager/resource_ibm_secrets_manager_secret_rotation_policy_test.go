@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerSecretRotationPolicyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-rotation-policy-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerSecretRotationPolicyConfig(name, 30, "day"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.rotation_policy", "interval", "30"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.rotation_policy", "unit", "day"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_secret_rotation_policy.rotation_policy", "policy_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerSecretRotationPolicyConfig(name, 2, "month"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.rotation_policy", "interval", "2"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.rotation_policy", "unit", "month"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_username_password_secret.username_password_secret", "next_rotation_date"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_secret_rotation_policy.rotation_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerSecretRotationPolicyConfig(name string, interval int, unit string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_username_password_secret" "username_password_secret" {
+			instance_id = "%s"
+			name = "%s"
+			username = "tf-user"
+			password = "tf-Passw0rd-1"
+		}
+		resource "ibm_secrets_manager_secret_rotation_policy" "rotation_policy" {
+			instance_id = ibm_secrets_manager_username_password_secret.username_password_secret.instance_id
+			secret_id = ibm_secrets_manager_username_password_secret.username_password_secret.secret_id
+			interval = %d
+			unit = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, name, interval, unit)
+}
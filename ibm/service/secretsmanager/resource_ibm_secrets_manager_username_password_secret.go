@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerUsernamePasswordSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerUsernamePasswordSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerUsernamePasswordSecretRead,
+		UpdateContext: resourceIBMSecretsManagerUsernamePasswordSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerUsernamePasswordSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceIBMSecretsManagerSecretSchema("ibm_secrets_manager_username_password_secret", map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username to assign to the secret.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password to assign to the secret. Changing the password creates a new version of the secret.",
+			},
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSecretsManagerDateDiff,
+				Description:      "The date the secret material expires. The date format follows RFC 3339.",
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation, if a rotation policy is set.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerUsernamePasswordSecretValidator() *validate.ResourceValidator {
+	return resourceIBMSecretsManagerSecretValidator("ibm_secrets_manager_username_password_secret")
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getSecretsManagerSession(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expirationDate, err := expandSecretsManagerExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretResource := &secretsmanagerv1.SecretResource{
+		Name:           core.StringPtr(d.Get("name").(string)),
+		Description:    core.StringPtr(d.Get("description").(string)),
+		Labels:         flex.ExpandStringList(d.Get("labels").([]interface{})),
+		Username:       core.StringPtr(d.Get("username").(string)),
+		Password:       core.StringPtr(d.Get("password").(string)),
+		ExpirationDate: expirationDate,
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+
+	if err = createSecretsManagerSecret(d, client, secretTypeUsernamePassword, secretResource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secret, err := getSecretsManagerSecret(client, secretTypeUsernamePassword, secretID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setSecretsManagerSecretAttributes(d, instanceID, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	secretData := secretsManagerSecretData(secret)
+	username, password := secret.Username, secret.Password
+	if u, ok := secretData["username"].(string); ok && username == nil {
+		username = &u
+	}
+	if p, ok := secretData["password"].(string); ok && password == nil {
+		password = &p
+	}
+	if err = d.Set("username", username); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting username: %s", err))
+	}
+	if err = d.Set("password", password); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting password: %s", err))
+	}
+	if secret.ExpirationDate != nil {
+		if err = d.Set("expiration_date", secret.ExpirationDate.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting expiration_date: %s", err))
+		}
+	}
+	if secret.NextRotationDate != nil {
+		if err = d.Set("next_rotation_date", secret.NextRotationDate.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting next_rotation_date: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateSecretsManagerSecretMetadata(d, client, secretTypeUsernamePassword, secretID, "expiration_date"); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("password") {
+		action := &secretsmanagerv1.SecretActionOneOfRotateUsernamePasswordSecretBody{
+			Password: core.StringPtr(d.Get("password").(string)),
+		}
+		if err = rotateSecretsManagerSecret(client, secretTypeUsernamePassword, secretID, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretTypeUsernamePassword); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerUsernamePasswordSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-username-password-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, "tf-Passw0rd-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.username_password_secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.username_password_secret", "username", "tf-user"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.username_password_secret", "password", "tf-Passw0rd-1"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_username_password_secret.username_password_secret", "secret_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, "tf-Passw0rd-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.username_password_secret", "password", "tf-Passw0rd-2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_username_password_secret.username_password_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, password string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_username_password_secret" "username_password_secret" {
+			instance_id = "%s"
+			name = "%s"
+			username = "tf-user"
+			password = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, name, password)
+}
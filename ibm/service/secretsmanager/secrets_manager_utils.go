@@ -0,0 +1,425 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	secretTypeArbitrary        = "arbitrary"
+	secretTypeIamCredentials   = "iam_credentials"
+	secretTypeImportedCert     = "imported_cert"
+	secretTypeKv               = "kv"
+	secretTypeUsernamePassword = "username_password"
+
+	secretActionRotate = "rotate"
+)
+
+// getSecretsManagerSession returns a copy of the Secrets Manager client pointed at the given instance,
+// the shared client is left untouched so that instances in different regions can be used together
+func getSecretsManagerSession(meta interface{}, instanceID, endpointType string) (*secretsmanagerv1.SecretsManagerV1, error) {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(conns.ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if len(crnData) < 6 || crnData[4] != "secrets-manager" {
+		return nil, fmt.Errorf("[ERROR] Invalid or unsupported service Instance %s", instanceID)
+	}
+	region := crnData[5]
+
+	smEndpointURL, err := meta.(conns.ClientSession).ResolveEndpoint("secrets_manager", region)
+	if err != nil {
+		return nil, err
+	}
+	if smEndpointURL == "" {
+		if endpointType == "private" {
+			smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+		} else {
+			smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+		}
+	}
+
+	client := secretsManagerClient.Clone()
+	err = client.SetServiceURL(smEndpointURL)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// secretsManagerSecretID returns the instance and secret ID encoded in the resource ID
+func secretsManagerSecretID(id string) (string, string, error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return "", "", err
+	}
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceID/ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// resourceIBMSecretsManagerSecretSchema returns the attributes shared by all the secret resources merged with the
+// attributes specific to the secret type
+func resourceIBMSecretsManagerSecretSchema(resourceName string, secretSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The GUID of the Secrets Manager instance.",
+		},
+		"endpoint_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "public",
+			ValidateFunc: validate.InvokeValidator(resourceName, "endpoint_type"),
+			Description:  "Endpoint Type. 'public' or 'private'",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A human-readable alias to assign to the secret.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An extended description of the secret.",
+		},
+		"secret_group_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Computed:    true,
+			Description: "The ID of the secret group to assign to the secret. If not specified, the secret is assigned to the default secret group.",
+		},
+		"labels": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Labels that you can use to search for the secret.",
+		},
+		"secret_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The v4 UUID that uniquely identifies the secret.",
+		},
+		"crn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Cloud Resource Name (CRN) that uniquely identifies the secret.",
+		},
+		"state": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The secret state based on NIST SP 800-57. States are integers and correspond to the Pre-activation = 0, Active = 1,  Suspended = 2, Deactivated = 3, and Destroyed = 5 values.",
+		},
+		"state_description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A text representation of the secret state.",
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the secret was created. The date format follows RFC 3339.",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for the entity that created the secret.",
+		},
+		"last_update_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Updates when the actual secret is modified. The date format follows RFC 3339.",
+		},
+		"versions_total": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of versions that are associated with the secret.",
+		},
+	}
+	for k, v := range secretSchema {
+		s[k] = v
+	}
+	return s
+}
+
+// resourceIBMSecretsManagerSecretValidator returns the validator for the attributes shared by all the secret resources
+func resourceIBMSecretsManagerSecretValidator(resourceName string) *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		})
+
+	return &validate.ResourceValidator{ResourceName: resourceName, Schema: validateSchema}
+}
+
+// createSecretsManagerSecret creates a secret of the given type and sets the resource ID
+func createSecretsManagerSecret(d *schema.ResourceData, client *secretsmanagerv1.SecretsManagerV1, secretType string, secretResource secretsmanagerv1.SecretResourceIntf) error {
+	createSecretOptions := &secretsmanagerv1.CreateSecretOptions{
+		SecretType: core.StringPtr(secretType),
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretResourceIntf{secretResource},
+	}
+
+	createSecret, response, err := client.CreateSecret(createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecret failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] CreateSecret failed %s\n%s", err, response)
+	}
+	if len(createSecret.Resources) == 0 {
+		return fmt.Errorf("[ERROR] CreateSecret returned no secret")
+	}
+	secret := createSecret.Resources[0].(*secretsmanagerv1.SecretResource)
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("instance_id").(string), *secret.ID))
+	return nil
+}
+
+// getSecretsManagerSecret retrieves the secret, a nil secret is returned if it no longer exists
+func getSecretsManagerSecret(client *secretsmanagerv1.SecretsManagerV1, secretType, secretID string) (*secretsmanagerv1.SecretResource, error) {
+	getSecretOptions := &secretsmanagerv1.GetSecretOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+	}
+	getSecret, response, err := client.GetSecret(getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil, nil
+		}
+		log.Printf("[DEBUG] GetSecret failed %s\n%s", err, response)
+		return nil, fmt.Errorf("[ERROR] GetSecret failed %s\n%s", err, response)
+	}
+	if len(getSecret.Resources) == 0 {
+		return nil, nil
+	}
+	secret := getSecret.Resources[0].(*secretsmanagerv1.SecretResource)
+	if secret.State != nil && *secret.State == 5 {
+		// Destroyed secrets are gone for good
+		return nil, nil
+	}
+	return secret, nil
+}
+
+// setSecretsManagerSecretAttributes sets the attributes shared by all the secret resources
+func setSecretsManagerSecretAttributes(d *schema.ResourceData, instanceID string, secret *secretsmanagerv1.SecretResource) error {
+	if err := d.Set("instance_id", instanceID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting instance_id: %s", err)
+	}
+	if err := d.Set("secret_id", secret.ID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting secret_id: %s", err)
+	}
+	if err := d.Set("name", secret.Name); err != nil {
+		return fmt.Errorf("[ERROR] Error setting name: %s", err)
+	}
+	if err := d.Set("description", secret.Description); err != nil {
+		return fmt.Errorf("[ERROR] Error setting description: %s", err)
+	}
+	if err := d.Set("secret_group_id", secret.SecretGroupID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting secret_group_id: %s", err)
+	}
+	if err := d.Set("labels", secret.Labels); err != nil {
+		return fmt.Errorf("[ERROR] Error setting labels: %s", err)
+	}
+	if err := d.Set("crn", secret.CRN); err != nil {
+		return fmt.Errorf("[ERROR] Error setting crn: %s", err)
+	}
+	if err := d.Set("state", flex.IntValue(secret.State)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting state: %s", err)
+	}
+	if err := d.Set("state_description", secret.StateDescription); err != nil {
+		return fmt.Errorf("[ERROR] Error setting state_description: %s", err)
+	}
+	if secret.CreationDate != nil {
+		if err := d.Set("creation_date", secret.CreationDate.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting creation_date: %s", err)
+		}
+	}
+	if err := d.Set("created_by", secret.CreatedBy); err != nil {
+		return fmt.Errorf("[ERROR] Error setting created_by: %s", err)
+	}
+	if secret.LastUpdateDate != nil {
+		if err := d.Set("last_update_date", secret.LastUpdateDate.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting last_update_date: %s", err)
+		}
+	}
+	if err := d.Set("versions_total", len(secret.Versions)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting versions_total: %s", err)
+	}
+	return nil
+}
+
+// updateSecretsManagerSecretMetadata updates the name, description and labels of the secret together with the
+// given secret type specific metadata attributes, which can be expiration_date and ttl
+func updateSecretsManagerSecretMetadata(d *schema.ResourceData, client *secretsmanagerv1.SecretsManagerV1, secretType, secretID string, metadataKeys ...string) error {
+	if !d.HasChanges(append([]string{"name", "description", "labels"}, metadataKeys...)...) {
+		return nil
+	}
+
+	secretMetadata := secretsmanagerv1.SecretMetadata{
+		Name:        core.StringPtr(d.Get("name").(string)),
+		Description: core.StringPtr(d.Get("description").(string)),
+		Labels:      flex.ExpandStringList(d.Get("labels").([]interface{})),
+	}
+	for _, key := range metadataKeys {
+		switch key {
+		case "expiration_date":
+			expirationDate, err := expandSecretsManagerExpirationDate(d)
+			if err != nil {
+				return err
+			}
+			secretMetadata.ExpirationDate = expirationDate
+		case "ttl":
+			secretMetadata.TTL = d.Get("ttl").(string)
+		}
+	}
+
+	updateSecretMetadataOptions := &secretsmanagerv1.UpdateSecretMetadataOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretMetadata{secretMetadata},
+	}
+	_, response, err := client.UpdateSecretMetadata(updateSecretMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecretMetadata failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] UpdateSecretMetadata failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// rotateSecretsManagerSecret creates a new version of the secret with the given secret data
+func rotateSecretsManagerSecret(client *secretsmanagerv1.SecretsManagerV1, secretType, secretID string, action secretsmanagerv1.SecretActionOneOfIntf) error {
+	updateSecretOptions := &secretsmanagerv1.UpdateSecretOptions{
+		SecretType:        core.StringPtr(secretType),
+		ID:                core.StringPtr(secretID),
+		Action:            core.StringPtr(secretActionRotate),
+		SecretActionOneOf: action,
+	}
+	_, response, err := client.UpdateSecret(updateSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecret failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] UpdateSecret failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// deleteSecretsManagerSecret deletes the secret, secrets which are already gone are ignored
+func deleteSecretsManagerSecret(d *schema.ResourceData, meta interface{}, secretType string) error {
+	instanceID, secretID, err := secretsManagerSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+	client, err := getSecretsManagerSession(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	deleteSecretOptions := &secretsmanagerv1.DeleteSecretOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+	}
+	response, err := client.DeleteSecret(deleteSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteSecret failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] DeleteSecret failed %s\n%s", err, response)
+	}
+	d.SetId("")
+	return nil
+}
+
+// expandSecretsManagerExpirationDate parses the optional expiration_date attribute
+func expandSecretsManagerExpirationDate(d *schema.ResourceData) (*strfmt.DateTime, error) {
+	expiration, ok := d.GetOk("expiration_date")
+	if !ok {
+		return nil, nil
+	}
+	expirationDate, err := strfmt.ParseDateTime(expiration.(string))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing expiration_date: %s", err)
+	}
+	return &expirationDate, nil
+}
+
+// secretsManagerSecretData returns the secret_data of the secret as a map
+func secretsManagerSecretData(secret *secretsmanagerv1.SecretResource) map[string]interface{} {
+	if secretData, ok := secret.SecretData.(map[string]interface{}); ok {
+		return secretData
+	}
+	return map[string]interface{}{}
+}
+
+// suppressSecretsManagerDateDiff suppresses the diff between two representations of the same RFC 3339 date
+func suppressSecretsManagerDateDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, err := strfmt.ParseDateTime(old)
+	if err != nil {
+		return false
+	}
+	newDate, err := strfmt.ParseDateTime(new)
+	if err != nil {
+		return false
+	}
+	return time.Time(oldDate).Equal(time.Time(newDate))
+}
+
+// secretsManagerTTLSeconds converts a ttl given either as a number of seconds or as a duration such as 24h to seconds
+func secretsManagerTTLSeconds(ttl string) (int64, bool) {
+	if seconds, err := strconv.ParseInt(ttl, 10, 64); err == nil {
+		return seconds, true
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, false
+	}
+	return int64(duration.Seconds()), true
+}
+
+// suppressSecretsManagerTTLDiff suppresses the diff between two representations of the same ttl
+func suppressSecretsManagerTTLDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldTTL, ok := secretsManagerTTLSeconds(old)
+	if !ok {
+		return false
+	}
+	newTTL, ok := secretsManagerTTLSeconds(new)
+	return ok && oldTTL == newTTL
+}
@@ -302,7 +302,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 		log.Printf(
 			"Error on get of transit gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(tgGatewayTags, tags)
+	d.Set(tgGatewayTags, flex.RemoveDefaultTags(meta, tags, d.Get(tgGatewayTags)))
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsShareProfiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsShareProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of file share profiles",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The globally unique name for this share profile",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The product family this share profile belongs to",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this share profile",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type",
+						},
+						"capacity": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The permitted capacity range in gigabytes for a share with this profile",
+							Elem:        dataSourceIBMIsShareProfileRange(),
+						},
+						"iops": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The permitted IOPS range for a share with this profile",
+							Elem:        dataSourceIBMIsShareProfileRange(),
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of share profiles",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsShareProfileRange() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the range, fixed, range, enum or dependent",
+			},
+			"default": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The default value",
+			},
+			"max": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum value",
+			},
+			"min": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum value",
+			},
+			"step": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The increment step value",
+			},
+			"value": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The value for a fixed range",
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The permitted values for an enum range",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsShareProfilesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profiles, err := listShareProfiles(context, sess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profilesList := make([]map[string]interface{}, 0, len(profiles))
+	for _, profile := range profiles {
+		profileMap := map[string]interface{}{
+			"capacity": flattenShareProfileRange(profile.Capacity),
+			"iops":     flattenShareProfileRange(profile.Iops),
+		}
+		if profile.Name != nil {
+			profileMap["name"] = *profile.Name
+		}
+		if profile.Family != nil {
+			profileMap["family"] = *profile.Family
+		}
+		if profile.Href != nil {
+			profileMap["href"] = *profile.Href
+		}
+		if profile.ResourceType != nil {
+			profileMap["resource_type"] = *profile.ResourceType
+		}
+		profilesList = append(profilesList, profileMap)
+	}
+
+	d.SetId(dataSourceIBMIsShareProfilesID(d))
+	if err = d.Set("profiles", profilesList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profiles %s", err))
+	}
+	d.Set("total_count", len(profiles))
+	return nil
+}
+
+// dataSourceIBMIsShareProfilesID returns a reasonable ID for the share profiles list
+func dataSourceIBMIsShareProfilesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func flattenShareProfileRange(profileRange *vpcShareProfileRange) []map[string]interface{} {
+	if profileRange == nil {
+		return nil
+	}
+	rangeMap := map[string]interface{}{}
+	if profileRange.Type != nil {
+		rangeMap["type"] = *profileRange.Type
+	}
+	if profileRange.Default != nil {
+		rangeMap["default"] = int(*profileRange.Default)
+	}
+	if profileRange.Max != nil {
+		rangeMap["max"] = int(*profileRange.Max)
+	}
+	if profileRange.Min != nil {
+		rangeMap["min"] = int(*profileRange.Min)
+	}
+	if profileRange.Step != nil {
+		rangeMap["step"] = int(*profileRange.Step)
+	}
+	if profileRange.Value != nil {
+		rangeMap["value"] = int(*profileRange.Value)
+	}
+	values := make([]int, 0, len(profileRange.Values))
+	for _, value := range profileRange.Values {
+		values = append(values, int(value))
+	}
+	rangeMap["values"] = values
+	return []map[string]interface{}{rangeMap}
+}
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISShareProfilesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "ibm_is_share_profiles" "profiles" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_share_profiles.profiles", "profiles.0.name"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_share_profiles.profiles", "profiles.0.family"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_share_profiles.profiles", "profiles.0.capacity.0.type"),
+				),
+			},
+		},
+	})
+}
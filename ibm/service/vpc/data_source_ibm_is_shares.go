@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsShares() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsSharesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to file shares with the resource group ID",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to file shares with the exact name",
+			},
+			"shares": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of file shares",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this file share",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique user-defined name for this file share",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN for this file share",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this file share",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the file share is created",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone the file share resides in",
+						},
+						"profile": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the profile of the file share",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the file share rounded up to the next gigabyte",
+						},
+						"iops": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum input/output operations per second (IOPS) for the file share",
+						},
+						"encryption": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of encryption used for this file share",
+						},
+						"encryption_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the key used to encrypt this file share",
+						},
+						"access_control_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The access control mode for the file share",
+						},
+						"lifecycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the file share",
+						},
+						"resource_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group of the file share",
+						},
+						"replication_role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The replication role of the file share",
+						},
+						"replication_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The replication status of the file share",
+						},
+						"replication_cron_spec": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cron specification of the replication of a replica share",
+						},
+						"source_share": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the source file share of a replica share",
+						},
+						"replica_share": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the replica share of the file share",
+						},
+						"mount_targets": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The mount targets of the file share",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for this share mount target",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The user-defined name for this share mount target",
+									},
+								},
+							},
+						},
+						"tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "The user tags of the file share",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of file shares",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsSharesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shares, err := listShares(context, sess, d.Get("resource_group").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sharesList := make([]map[string]interface{}, 0, len(shares))
+	for _, share := range shares {
+		sharesList = append(sharesList, dataSourceShareCollectionFlattenShare(share))
+	}
+
+	d.SetId(dataSourceIBMIsSharesID(d))
+	if err = d.Set("shares", sharesList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting shares %s", err))
+	}
+	d.Set("total_count", len(shares))
+	return nil
+}
+
+// dataSourceIBMIsSharesID returns a reasonable ID for the file shares list
+func dataSourceIBMIsSharesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceShareCollectionFlattenShare(share vpcShare) map[string]interface{} {
+	shareMap := map[string]interface{}{
+		"mount_targets": flattenShareMountTargetReferences(share.MountTargets),
+		"tags":          flex.NewStringSet(flex.ResourceIBMVPCHash, share.UserTags),
+	}
+	stringAttributes := map[string]*string{
+		"id":                    share.ID,
+		"name":                  share.Name,
+		"crn":                   share.CRN,
+		"href":                  share.Href,
+		"created_at":            share.CreatedAt,
+		"encryption":            share.Encryption,
+		"access_control_mode":   share.AccessControlMode,
+		"lifecycle_state":       share.LifecycleState,
+		"replication_role":      share.ReplicationRole,
+		"replication_status":    share.ReplicationStatus,
+		"replication_cron_spec": share.ReplicationCronSpec,
+	}
+	for key, value := range stringAttributes {
+		if value != nil {
+			shareMap[key] = *value
+		}
+	}
+	if share.Size != nil {
+		shareMap["size"] = int(*share.Size)
+	}
+	if share.Iops != nil {
+		shareMap["iops"] = int(*share.Iops)
+	}
+	if share.Zone != nil && share.Zone.Name != nil {
+		shareMap["zone"] = *share.Zone.Name
+	}
+	if share.Profile != nil && share.Profile.Name != nil {
+		shareMap["profile"] = *share.Profile.Name
+	}
+	if share.EncryptionKey != nil && share.EncryptionKey.CRN != nil {
+		shareMap["encryption_key"] = *share.EncryptionKey.CRN
+	}
+	if share.ResourceGroup != nil && share.ResourceGroup.ID != nil {
+		shareMap["resource_group"] = *share.ResourceGroup.ID
+	}
+	if share.SourceShare != nil && share.SourceShare.ID != nil {
+		shareMap["source_share"] = *share.SourceShare.ID
+	}
+	if share.ReplicaShare != nil && share.ReplicaShare.ID != nil {
+		shareMap["replica_share"] = *share.ReplicaShare.ID
+	}
+	return shareMap
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISSharesDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareConfig(name, 200) + `
+				data "ibm_is_shares" "shares" {
+					name = ibm_is_share.share.name
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_shares.shares", "shares.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_shares.shares", "shares.0.id", "ibm_is_share.share", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_shares.shares", "shares.0.size", "200"),
+					resource.TestCheckResourceAttr("data.ibm_is_shares.shares", "shares.0.profile", "dp2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,534 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// GenerateConfigOptions selects the VPC resources GenerateConfig writes the configuration of
+type GenerateConfigOptions struct {
+	// Region is written to the provider block, it is left out when empty
+	Region string
+	// ResourceGroupID limits the resources to a resource group, all the resource groups are walked when empty
+	ResourceGroupID string
+}
+
+// GenerateConfig walks the VPCs, subnets, security groups and their rules, instances, load balancers and routing
+// tables of the account and writes their configuration to w, together with the import blocks which bring them under
+// management of Terraform. The import IDs are the ones the importers of the resources expect. References between
+// the written resources are written as expressions, references to anything else keep the literal ID.
+func GenerateConfig(ctx context.Context, sess *vpcv1.VpcV1, options GenerateConfigOptions, w io.Writer) error {
+	g := &configGenerator{
+		ctx:     ctx,
+		sess:    sess,
+		options: options,
+		file:    hclwrite.NewEmptyFile(),
+		labels:  map[string]bool{},
+		refs:    map[string]hcl.Traversal{},
+	}
+	if options.Region != "" {
+		provider := g.appendBlock("provider", []string{"ibm"})
+		provider.Body().SetAttributeValue("region", cty.StringVal(options.Region))
+	}
+	for _, step := range []func() error{g.vpcs, g.routingTables, g.subnets, g.securityGroups, g.instances, g.loadBalancers} {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	_, err := g.file.WriteTo(w)
+	return err
+}
+
+type configGenerator struct {
+	ctx     context.Context
+	sess    *vpcv1.VpcV1
+	options GenerateConfigOptions
+	file    *hclwrite.File
+
+	// labels holds the addresses already written, refs the expression each written resource ID is referenced with
+	labels map[string]bool
+	refs   map[string]hcl.Traversal
+
+	vpcList []vpcv1.VPC
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// label returns a unique label for a resource of resourceType named name
+func (g *configGenerator) label(resourceType, name string) string {
+	base := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" {
+		base = "resource"
+	}
+	if base[0] >= '0' && base[0] <= '9' || base[0] == '-' {
+		base = "r_" + base
+	}
+	label := base
+	for i := 2; g.labels[resourceType+"."+label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	g.labels[resourceType+"."+label] = true
+	return label
+}
+
+// appendBlock appends a top-level block, separated from the previous block by an empty line
+func (g *configGenerator) appendBlock(blockType string, labels []string) *hclwrite.Block {
+	body := g.file.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	return body.AppendNewBlock(blockType, labels)
+}
+
+// resource appends the block of a resource and the import block with importID, and returns the body of the resource
+func (g *configGenerator) resource(resourceType, name, importID string) (string, *hclwrite.Body) {
+	label := g.label(resourceType, name)
+
+	imp := g.appendBlock("import", nil)
+	imp.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	imp.Body().SetAttributeValue("id", cty.StringVal(importID))
+
+	block := g.appendBlock("resource", []string{resourceType, label})
+	return label, block.Body()
+}
+
+func attrRef(resourceType, label, attribute string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+		hcl.TraverseAttr{Name: attribute},
+	}
+}
+
+// ref returns the tokens of a reference to the resource with id, the literal ID if the resource is not written
+func (g *configGenerator) ref(id string) hclwrite.Tokens {
+	if traversal, ok := g.refs[id]; ok {
+		return hclwrite.TokensForTraversal(traversal)
+	}
+	return hclwrite.TokensForValue(cty.StringVal(id))
+}
+
+func (g *configGenerator) setRef(body *hclwrite.Body, name, id string) {
+	body.SetAttributeRaw(name, g.ref(id))
+}
+
+func (g *configGenerator) setRefs(body *hclwrite.Body, name string, ids []string) {
+	elems := make([]hclwrite.Tokens, 0, len(ids))
+	for _, id := range ids {
+		elems = append(elems, g.ref(id))
+	}
+	body.SetAttributeRaw(name, hclwrite.TokensForTuple(elems))
+}
+
+func (g *configGenerator) setResourceGroup(body *hclwrite.Body, resourceGroup *vpcv1.ResourceGroupReference) {
+	if resourceGroup != nil && resourceGroup.ID != nil {
+		body.SetAttributeValue("resource_group", cty.StringVal(*resourceGroup.ID))
+	}
+}
+
+func (g *configGenerator) inResourceGroup(resourceGroup *vpcv1.ResourceGroupReference) bool {
+	if g.options.ResourceGroupID == "" {
+		return true
+	}
+	return resourceGroup != nil && resourceGroup.ID != nil && *resourceGroup.ID == g.options.ResourceGroupID
+}
+
+func (g *configGenerator) resourceGroupID() *string {
+	if g.options.ResourceGroupID == "" {
+		return nil
+	}
+	return &g.options.ResourceGroupID
+}
+
+func (g *configGenerator) vpcs() error {
+	start := ""
+	for {
+		listVpcsOptions := &vpcv1.ListVpcsOptions{
+			ResourceGroupID: g.resourceGroupID(),
+		}
+		if start != "" {
+			listVpcsOptions.Start = &start
+		}
+		vpcs, response, err := g.sess.ListVpcsWithContext(g.ctx, listVpcsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching vpcs %s\n%s", err, response)
+		}
+		g.vpcList = append(g.vpcList, vpcs.Vpcs...)
+		start = flex.GetNext(vpcs.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	for _, vpc := range g.vpcList {
+		label, body := g.resource("ibm_is_vpc", *vpc.Name, *vpc.ID)
+		g.refs[*vpc.ID] = attrRef("ibm_is_vpc", label, "id")
+		// The default security group and routing table are created with the VPC, they are referenced through it
+		if vpc.DefaultSecurityGroup != nil {
+			g.refs[*vpc.DefaultSecurityGroup.ID] = attrRef("ibm_is_vpc", label, "default_security_group")
+		}
+		if vpc.DefaultRoutingTable != nil {
+			g.refs[*vpc.DefaultRoutingTable.ID] = attrRef("ibm_is_vpc", label, "default_routing_table")
+		}
+		body.SetAttributeValue("name", cty.StringVal(*vpc.Name))
+		g.setResourceGroup(body, vpc.ResourceGroup)
+		if vpc.ClassicAccess != nil && *vpc.ClassicAccess {
+			body.SetAttributeValue("classic_access", cty.True)
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) routingTables() error {
+	for _, vpc := range g.vpcList {
+		var tables []vpcv1.RoutingTable
+		start := ""
+		for {
+			listOptions := &vpcv1.ListVPCRoutingTablesOptions{
+				VPCID: vpc.ID,
+			}
+			if start != "" {
+				listOptions.Start = &start
+			}
+			result, response, err := g.sess.ListVPCRoutingTablesWithContext(g.ctx, listOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error Fetching routing tables of vpc %s %s\n%s", *vpc.ID, err, response)
+			}
+			tables = append(tables, result.RoutingTables...)
+			start = flex.GetNext(result.Next)
+			if start == "" {
+				break
+			}
+		}
+
+		for _, table := range tables {
+			if table.IsDefault == nil || !*table.IsDefault {
+				label, body := g.resource("ibm_is_vpc_routing_table", *table.Name, fmt.Sprintf("%s/%s", *vpc.ID, *table.ID))
+				g.refs[*table.ID] = attrRef("ibm_is_vpc_routing_table", label, rtID)
+				g.setRef(body, rtVpcID, *vpc.ID)
+				body.SetAttributeValue(rtName, cty.StringVal(*table.Name))
+				if table.RouteDirectLinkIngress != nil && *table.RouteDirectLinkIngress {
+					body.SetAttributeValue(rtRouteDirectLinkIngress, cty.True)
+				}
+				if table.RouteTransitGatewayIngress != nil && *table.RouteTransitGatewayIngress {
+					body.SetAttributeValue(rtRouteTransitGatewayIngress, cty.True)
+				}
+				if table.RouteVPCZoneIngress != nil && *table.RouteVPCZoneIngress {
+					body.SetAttributeValue(rtRouteVPCZoneIngress, cty.True)
+				}
+			}
+			if err := g.routes(*vpc.ID, *table.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) routes(vpcID, tableID string) error {
+	var routes []vpcv1.Route
+	start := ""
+	for {
+		listOptions := &vpcv1.ListVPCRoutingTableRoutesOptions{
+			VPCID:          &vpcID,
+			RoutingTableID: &tableID,
+		}
+		if start != "" {
+			listOptions.Start = &start
+		}
+		result, response, err := g.sess.ListVPCRoutingTableRoutesWithContext(g.ctx, listOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching routes of routing table %s %s\n%s", tableID, err, response)
+		}
+		routes = append(routes, result.Routes...)
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	for _, route := range routes {
+		// Learned and service routes are managed by the resources which created them
+		if route.Origin != nil && *route.Origin != "user" {
+			continue
+		}
+		_, body := g.resource("ibm_is_vpc_routing_table_route", *route.Name, fmt.Sprintf("%s/%s/%s", vpcID, tableID, *route.ID))
+		g.setRef(body, rtVpcID, vpcID)
+		g.setRef(body, rtID, tableID)
+		body.SetAttributeValue(rName, cty.StringVal(*route.Name))
+		body.SetAttributeValue(rZone, cty.StringVal(*route.Zone.Name))
+		body.SetAttributeValue(rDestination, cty.StringVal(*route.Destination))
+		if route.Action != nil && *route.Action != "deliver" {
+			body.SetAttributeValue(rAction, cty.StringVal(*route.Action))
+		}
+		if nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && nextHop != nil {
+			if nextHop.Address != nil {
+				body.SetAttributeValue(rNextHop, cty.StringVal(*nextHop.Address))
+			} else if nextHop.ID != nil {
+				body.SetAttributeValue(rNextHop, cty.StringVal(*nextHop.ID))
+			}
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) defaultRoutingTable(id string) bool {
+	for _, vpc := range g.vpcList {
+		if vpc.DefaultRoutingTable != nil && *vpc.DefaultRoutingTable.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *configGenerator) subnets() error {
+	start := ""
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{
+			ResourceGroupID: g.resourceGroupID(),
+		}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnets, response, err := g.sess.ListSubnetsWithContext(g.ctx, listSubnetsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+		}
+		for _, subnet := range subnets.Subnets {
+			label, body := g.resource("ibm_is_subnet", *subnet.Name, *subnet.ID)
+			g.refs[*subnet.ID] = attrRef("ibm_is_subnet", label, "id")
+			body.SetAttributeValue(isSubnetName, cty.StringVal(*subnet.Name))
+			g.setRef(body, isSubnetVPC, *subnet.VPC.ID)
+			body.SetAttributeValue(isSubnetZone, cty.StringVal(*subnet.Zone.Name))
+			body.SetAttributeValue(isSubnetIpv4CidrBlock, cty.StringVal(*subnet.Ipv4CIDRBlock))
+			g.setResourceGroup(body, subnet.ResourceGroup)
+			if subnet.RoutingTable != nil && !g.defaultRoutingTable(*subnet.RoutingTable.ID) {
+				g.setRef(body, isSubnetRoutingTableID, *subnet.RoutingTable.ID)
+			}
+			if subnet.PublicGateway != nil {
+				body.SetAttributeValue(isSubnetPublicGateway, cty.StringVal(*subnet.PublicGateway.ID))
+			}
+		}
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) securityGroups() error {
+	var groups []vpcv1.SecurityGroup
+	start := ""
+	for {
+		listSecurityGroupsOptions := &vpcv1.ListSecurityGroupsOptions{
+			ResourceGroupID: g.resourceGroupID(),
+		}
+		if start != "" {
+			listSecurityGroupsOptions.Start = &start
+		}
+		result, response, err := g.sess.ListSecurityGroupsWithContext(g.ctx, listSecurityGroupsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching Security Groups %s\n%s", err, response)
+		}
+		groups = append(groups, result.SecurityGroups...)
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	// The groups are written first, so that the rules of a group can reference any group as remote
+	for _, group := range groups {
+		if _, ok := g.refs[*group.ID]; ok {
+			continue
+		}
+		label, body := g.resource("ibm_is_security_group", *group.Name, *group.ID)
+		g.refs[*group.ID] = attrRef("ibm_is_security_group", label, "id")
+		body.SetAttributeValue(isSecurityGroupName, cty.StringVal(*group.Name))
+		g.setRef(body, isSecurityGroupVPC, *group.VPC.ID)
+		g.setResourceGroup(body, group.ResourceGroup)
+	}
+	for _, group := range groups {
+		for _, rule := range group.Rules {
+			g.securityGroupRule(*group.Name, *group.ID, rule)
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) securityGroupRule(groupName, groupID string, sgrule vpcv1.SecurityGroupRuleIntf) {
+	var id, direction, ipVersion, protocol string
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	var protocolBlock map[string]*int64
+	switch rule := sgrule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		id, direction, ipVersion, protocol, remote = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol, rule.Remote
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id, direction, ipVersion, protocol, remote = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol, rule.Remote
+		protocolBlock = map[string]*int64{"type": rule.Type, "code": rule.Code}
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id, direction, ipVersion, protocol, remote = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol, rule.Remote
+		protocolBlock = map[string]*int64{isSecurityGroupRulePortMin: rule.PortMin, isSecurityGroupRulePortMax: rule.PortMax}
+	default:
+		return
+	}
+
+	_, body := g.resource("ibm_is_security_group_rule", fmt.Sprintf("%s_%s", groupName, direction), makeTerraformRuleID(groupID, id))
+	g.setRef(body, isSecurityGroupID, groupID)
+	body.SetAttributeValue(isSecurityGroupRuleDirection, cty.StringVal(direction))
+	body.SetAttributeValue(isSecurityGroupRuleIPVersion, cty.StringVal(ipVersion))
+	if remote, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			g.setRef(body, isSecurityGroupRuleRemote, *remote.ID)
+		} else if remote.Address != nil {
+			body.SetAttributeValue(isSecurityGroupRuleRemote, cty.StringVal(*remote.Address))
+		} else if remote.CIDRBlock != nil {
+			body.SetAttributeValue(isSecurityGroupRuleRemote, cty.StringVal(*remote.CIDRBlock))
+		}
+	}
+	if protocolBlock != nil {
+		block := body.AppendNewBlock(protocol, nil)
+		for _, attribute := range []string{"type", "code", isSecurityGroupRulePortMin, isSecurityGroupRulePortMax} {
+			if value := protocolBlock[attribute]; value != nil {
+				block.Body().SetAttributeValue(attribute, cty.NumberIntVal(*value))
+			}
+		}
+	}
+}
+
+func (g *configGenerator) instances() error {
+	start := ""
+	for {
+		listInstancesOptions := &vpcv1.ListInstancesOptions{
+			ResourceGroupID: g.resourceGroupID(),
+		}
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := g.sess.ListInstancesWithContext(g.ctx, listInstancesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			if err := g.instance(instance); err != nil {
+				return err
+			}
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) instance(instance vpcv1.Instance) error {
+	initialization, response, err := g.sess.GetInstanceInitializationWithContext(g.ctx, &vpcv1.GetInstanceInitializationOptions{
+		ID: instance.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting instance (%s) initialization : %s\n%s", *instance.ID, err, response)
+	}
+	var securityGroups []string
+	if instance.PrimaryNetworkInterface != nil {
+		nic, response, err := g.sess.GetInstanceNetworkInterfaceWithContext(g.ctx, &vpcv1.GetInstanceNetworkInterfaceOptions{
+			InstanceID: instance.ID,
+			ID:         instance.PrimaryNetworkInterface.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Getting primary network interface of instance (%s) : %s\n%s", *instance.ID, err, response)
+		}
+		for _, sg := range nic.SecurityGroups {
+			securityGroups = append(securityGroups, *sg.ID)
+		}
+	}
+
+	_, body := g.resource("ibm_is_instance", *instance.Name, *instance.ID)
+	body.SetAttributeValue(isInstanceName, cty.StringVal(*instance.Name))
+	g.setRef(body, isInstanceVPC, *instance.VPC.ID)
+	body.SetAttributeValue(isInstanceZone, cty.StringVal(*instance.Zone.Name))
+	body.SetAttributeValue(isInstanceProfile, cty.StringVal(*instance.Profile.Name))
+	if instance.Image != nil {
+		body.SetAttributeValue(isInstanceImage, cty.StringVal(*instance.Image.ID))
+	}
+	keys := make([]cty.Value, 0, len(initialization.Keys))
+	for _, key := range initialization.Keys {
+		keys = append(keys, cty.StringVal(*key.ID))
+	}
+	if len(keys) > 0 {
+		body.SetAttributeValue(isInstanceKeys, cty.ListVal(keys))
+	} else {
+		body.SetAttributeValue(isInstanceKeys, cty.ListValEmpty(cty.String))
+	}
+	g.setResourceGroup(body, instance.ResourceGroup)
+	if instance.PrimaryNetworkInterface != nil {
+		nic := body.AppendNewBlock(isInstancePrimaryNetworkInterface, nil)
+		g.setRef(nic.Body(), isInstanceNicSubnet, *instance.PrimaryNetworkInterface.Subnet.ID)
+		if len(securityGroups) > 0 {
+			g.setRefs(nic.Body(), isInstanceNicSecurityGroups, securityGroups)
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) loadBalancers() error {
+	start := ""
+	for {
+		listLoadBalancersOptions := &vpcv1.ListLoadBalancersOptions{}
+		if start != "" {
+			listLoadBalancersOptions.Start = &start
+		}
+		lbs, response, err := g.sess.ListLoadBalancersWithContext(g.ctx, listLoadBalancersOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s\n%s", err, response)
+		}
+		for _, lb := range lbs.LoadBalancers {
+			// Load balancers cannot be listed by resource group
+			if !g.inResourceGroup(lb.ResourceGroup) {
+				continue
+			}
+			_, body := g.resource("ibm_is_lb", *lb.Name, *lb.ID)
+			body.SetAttributeValue(isLBName, cty.StringVal(*lb.Name))
+			subnets := make([]string, 0, len(lb.Subnets))
+			for _, subnet := range lb.Subnets {
+				subnets = append(subnets, *subnet.ID)
+			}
+			g.setRefs(body, isLBSubnets, subnets)
+			if lb.IsPublic != nil && !*lb.IsPublic {
+				body.SetAttributeValue(isLBType, cty.StringVal("private"))
+			}
+			if lb.Profile != nil && lb.Profile.Family != nil && strings.EqualFold(*lb.Profile.Family, "network") {
+				body.SetAttributeValue(isLBProfile, cty.StringVal(*lb.Profile.Name))
+			}
+			if lb.SecurityGroupsSupported != nil && *lb.SecurityGroupsSupported && len(lb.SecurityGroups) > 0 {
+				securityGroups := make([]string, 0, len(lb.SecurityGroups))
+				for _, sg := range lb.SecurityGroups {
+					securityGroups = append(securityGroups, *sg.ID)
+				}
+				g.setRefs(body, isLBSecurityGroups, securityGroups)
+			}
+			g.setResourceGroup(body, lb.ResourceGroup)
+		}
+		start = flex.GetNext(lbs.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestGenerateConfigOffline(t *testing.T) {
+	endpoints := acc.NewFakeEndpoints(t, "testdata/generate_config.json")
+	sess, err := endpoints.ClientSession(t).(conns.ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var out bytes.Buffer
+	options := vpc.GenerateConfigOptions{
+		Region:          acc.FakeEndpointsRegion,
+		ResourceGroupID: "rg-1",
+	}
+	if err := vpc.GenerateConfig(context.Background(), sess, options, &out); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, diags := hclsyntax.ParseConfig(out.Bytes(), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("the generated configuration does not parse: %s\n%s", diags, out.String())
+	}
+	expected, err := ioutil.ReadFile("testdata/generate_config.tf")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if out.String() != string(expected) {
+		t.Fatalf("bad configuration, expected testdata/generate_config.tf, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,429 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The ordered rules mode of ibm_is_network_acl applies a change of the rules list in place: rules are matched by name,
+// the rules which keep their relative order stay where they are, the others are moved with a patch of before, and only
+// the rules which are new, removed or change protocol are created or deleted.
+
+const isNetworkACLOrderedRules = "ordered_rules"
+
+// networkACLRuleSpec is a rule of a network ACL, from the configuration or from the API. Unset fields of the rule are
+// nil.
+type networkACLRuleSpec struct {
+	id            string
+	name          string
+	action        string
+	direction     string
+	source        string
+	destination   string
+	protocol      string
+	icmpType      *int64
+	icmpCode      *int64
+	portMin       *int64
+	portMax       *int64
+	sourcePortMin *int64
+	sourcePortMax *int64
+}
+
+// content identifies what the rule does, two rules with the same content only differ by name
+func (rule *networkACLRuleSpec) content() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s/%s/%s/%s/%s", rule.action, rule.direction, rule.source, rule.destination,
+		rule.protocol, int64PtrKey(rule.icmpType), int64PtrKey(rule.icmpCode), int64PtrKey(rule.portMin),
+		int64PtrKey(rule.portMax), int64PtrKey(rule.sourcePortMin), int64PtrKey(rule.sourcePortMax))
+}
+
+// patchable tells if the current rule can be changed into the desired rule with UpdateNetworkACLRule, fields of a rule
+// can not be unset by a patch so a change of protocol or to any ICMP type is a delete and create
+func (rule *networkACLRuleSpec) patchable(desired *networkACLRuleSpec) bool {
+	if rule.protocol != desired.protocol {
+		return false
+	}
+	return (rule.icmpType == nil) == (desired.icmpType == nil) && (rule.icmpCode == nil) == (desired.icmpCode == nil)
+}
+
+// shadows tells if the rule matches all the traffic the later rule matches, the later rule then never applies. Rules
+// with values unknown at plan time never shadow.
+func (rule *networkACLRuleSpec) shadows(later *networkACLRuleSpec) bool {
+	if rule.direction != later.direction || !cidrContains(rule.source, later.source) || !cidrContains(rule.destination, later.destination) {
+		return false
+	}
+	switch rule.protocol {
+	case "all":
+		return true
+	case later.protocol:
+	default:
+		return false
+	}
+	switch rule.protocol {
+	case isNetworkACLRuleICMP:
+		if rule.icmpType == nil {
+			return true
+		}
+		if later.icmpType == nil || *rule.icmpType != *later.icmpType {
+			return false
+		}
+		return rule.icmpCode == nil || (later.icmpCode != nil && *rule.icmpCode == *later.icmpCode)
+	default:
+		return rangeContains(rule.portMin, rule.portMax, later.portMin, later.portMax) &&
+			rangeContains(rule.sourcePortMin, rule.sourcePortMax, later.sourcePortMin, later.sourcePortMax)
+	}
+}
+
+func rangeContains(min, max, otherMin, otherMax *int64) bool {
+	if min == nil || max == nil || otherMin == nil || otherMax == nil {
+		return false
+	}
+	return *min <= *otherMin && *otherMax <= *max
+}
+
+// cidrContains tells if the CIDR block or IP address outer contains inner
+func cidrContains(outer, inner string) bool {
+	outerNet := parseNetworkACLAddress(outer)
+	innerNet := parseNetworkACLAddress(inner)
+	if outerNet == nil || innerNet == nil {
+		return false
+	}
+	outerOnes, outerBits := outerNet.Mask.Size()
+	innerOnes, innerBits := innerNet.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outerNet.Contains(innerNet.IP)
+}
+
+func parseNetworkACLAddress(address string) *net.IPNet {
+	if address == "" {
+		return nil
+	}
+	if !strings.Contains(address, "/") {
+		address += "/32"
+	}
+	_, ipNet, err := net.ParseCIDR(address)
+	if err != nil {
+		return nil
+	}
+	return ipNet
+}
+
+// expandNetworkACLRuleSpecs reads the rules the way createInlineRules creates them
+func expandNetworkACLRuleSpecs(rules []interface{}) []*networkACLRuleSpec {
+	specs := make([]*networkACLRuleSpec, 0, len(rules))
+	for _, ruleIntf := range rules {
+		if ruleIntf == nil {
+			continue
+		}
+		rulex := ruleIntf.(map[string]interface{})
+		spec := &networkACLRuleSpec{
+			name:        rulex[isNetworkACLRuleName].(string),
+			action:      rulex[isNetworkACLRuleAction].(string),
+			direction:   rulex[isNetworkACLRuleDirection].(string),
+			source:      rulex[isNetworkACLRuleSource].(string),
+			destination: rulex[isNetworkACLRuleDestination].(string),
+			protocol:    "all",
+		}
+		if id, ok := rulex[isNetworkACLRuleID].(string); ok {
+			spec.id = id
+		}
+		icmp := rulex[isNetworkACLRuleICMP].([]interface{})
+		tcp := rulex[isNetworkACLRuleTCP].([]interface{})
+		udp := rulex[isNetworkACLRuleUDP].([]interface{})
+		if len(icmp) > 0 {
+			spec.protocol = isNetworkACLRuleICMP
+			if !isNil(icmp[0]) {
+				icmpval := icmp[0].(map[string]interface{})
+				icmpType := int64(icmpval[isNetworkACLRuleICMPType].(int))
+				icmpCode := int64(icmpval[isNetworkACLRuleICMPCode].(int))
+				spec.icmpType, spec.icmpCode = &icmpType, &icmpCode
+			}
+		} else if len(tcp) > 0 || len(udp) > 0 {
+			ports := tcp
+			spec.protocol = isNetworkACLRuleTCP
+			if len(udp) > 0 {
+				ports = udp
+				spec.protocol = isNetworkACLRuleUDP
+			}
+			portMin, portMax, sourcePortMin, sourcePortMax := int64(1), int64(65535), int64(1), int64(65535)
+			if !isNil(ports[0]) {
+				portval := ports[0].(map[string]interface{})
+				portMin = int64(portval[isNetworkACLRulePortMin].(int))
+				portMax = int64(portval[isNetworkACLRulePortMax].(int))
+				sourcePortMin = int64(portval[isNetworkACLRuleSourcePortMin].(int))
+				sourcePortMax = int64(portval[isNetworkACLRuleSourcePortMax].(int))
+			}
+			spec.portMin, spec.portMax, spec.sourcePortMin, spec.sourcePortMax = &portMin, &portMax, &sourcePortMin, &sourcePortMax
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func networkACLRuleSpecFromRule(rulex vpcv1.NetworkACLRuleItemIntf) *networkACLRuleSpec {
+	switch rule := rulex.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		return &networkACLRuleSpec{
+			id: *rule.ID, name: *rule.Name, action: *rule.Action, direction: *rule.Direction, source: *rule.Source,
+			destination: *rule.Destination, protocol: *rule.Protocol, icmpType: rule.Type, icmpCode: rule.Code,
+		}
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		return &networkACLRuleSpec{
+			id: *rule.ID, name: *rule.Name, action: *rule.Action, direction: *rule.Direction, source: *rule.Source,
+			destination: *rule.Destination, protocol: *rule.Protocol, portMin: rule.DestinationPortMin,
+			portMax: rule.DestinationPortMax, sourcePortMin: rule.SourcePortMin, sourcePortMax: rule.SourcePortMax,
+		}
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		return &networkACLRuleSpec{
+			id: *rule.ID, name: *rule.Name, action: *rule.Action, direction: *rule.Direction, source: *rule.Source,
+			destination: *rule.Destination, protocol: *rule.Protocol,
+		}
+	}
+	return nil
+}
+
+// listNetworkACLRuleSpecs lists the rules of the network ACL in priority order
+func listNetworkACLRuleSpecs(sess *vpcv1.VpcV1, nwaclid string) ([]*networkACLRuleSpec, error) {
+	start := ""
+	allrecs := []*networkACLRuleSpec{}
+	for {
+		listNetworkAclRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &nwaclid,
+		}
+		if start != "" {
+			listNetworkAclRulesOptions.Start = &start
+		}
+		rawrules, response, err := sess.ListNetworkACLRules(listNetworkAclRulesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
+		}
+		for _, rule := range rawrules.Rules {
+			if spec := networkACLRuleSpecFromRule(rule); spec != nil {
+				allrecs = append(allrecs, spec)
+			}
+		}
+		start = flex.GetNext(rawrules.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+// validateNetworkACLOrderedRules rejects at plan time the rules of the ordered rules mode which can not be matched by
+// name and the rules which never apply because an earlier rule matches all their traffic
+func validateNetworkACLOrderedRules(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get(isNetworkACLOrderedRules).(bool) {
+		return nil
+	}
+	rules := expandNetworkACLRuleSpecs(diff.Get(isNetworkACLRules).([]interface{}))
+	names := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		if rule.name == "" {
+			continue
+		}
+		if j, ok := names[rule.name]; ok {
+			return fmt.Errorf("[ERROR] rules.%d and rules.%d have the same name %s, the names of the rules must be unique with %s", j, i, rule.name, isNetworkACLOrderedRules)
+		}
+		names[rule.name] = i
+	}
+	for i, rule := range rules {
+		for j := 0; j < i; j++ {
+			if rules[j].shadows(rule) {
+				return fmt.Errorf("[ERROR] rules.%d (%s) is unreachable, rules.%d (%s) comes first and matches all its traffic", i, rule.name, j, rules[j].name)
+			}
+		}
+	}
+	return nil
+}
+
+// networkACLRulesPlan is the sequence of changes which turns the current rules of a network ACL into the desired rules
+type networkACLRulesPlan struct {
+	// deletes are the current rules which are not kept
+	deletes []*networkACLRuleSpec
+	// current are the kept rules, by index of desired
+	current map[int]*networkACLRuleSpec
+	// fixed are the indexes of the desired rules which keep their position, the other kept rules are moved
+	fixed map[int]bool
+}
+
+// planNetworkACLRules matches the desired rules to the current rules by name and keeps in place the longest run of
+// matched rules which are already in the desired order
+func planNetworkACLRules(current, desired []*networkACLRuleSpec) *networkACLRulesPlan {
+	plan := &networkACLRulesPlan{
+		current: map[int]*networkACLRuleSpec{},
+		fixed:   map[int]bool{},
+	}
+	desiredByName := make(map[string]int, len(desired))
+	for i, rule := range desired {
+		desiredByName[rule.name] = i
+	}
+	// order holds the indexes in desired of the kept rules, in current order
+	order := []int{}
+	for _, rule := range current {
+		i, ok := desiredByName[rule.name]
+		if !ok || plan.current[i] != nil || !rule.patchable(desired[i]) {
+			plan.deletes = append(plan.deletes, rule)
+			continue
+		}
+		plan.current[i] = rule
+		order = append(order, i)
+	}
+	for _, i := range longestIncreasingSubsequence(order) {
+		plan.fixed[i] = true
+	}
+	return plan
+}
+
+// longestIncreasingSubsequence returns one of the longest increasing subsequences of values
+func longestIncreasingSubsequence(values []int) []int {
+	if len(values) == 0 {
+		return nil
+	}
+	lengths := make([]int, len(values))
+	previous := make([]int, len(values))
+	best := 0
+	for i := range values {
+		lengths[i], previous[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if values[j] < values[i] && lengths[j]+1 > lengths[i] {
+				lengths[i], previous[i] = lengths[j]+1, j
+			}
+		}
+		if lengths[i] > lengths[best] {
+			best = i
+		}
+	}
+	subsequence := make([]int, lengths[best])
+	for i, k := best, lengths[best]-1; i >= 0; i, k = previous[i], k-1 {
+		subsequence[k] = values[i]
+	}
+	return subsequence
+}
+
+// networkACLRulePrototype builds the prototype of a rule, as createInlineRules does
+func networkACLRulePrototype(rule *networkACLRuleSpec, before string) *vpcv1.NetworkACLRulePrototype {
+	ruleTemplate := &vpcv1.NetworkACLRulePrototype{
+		Action:             &rule.action,
+		Destination:        &rule.destination,
+		Direction:          &rule.direction,
+		Source:             &rule.source,
+		Name:               &rule.name,
+		Protocol:           &rule.protocol,
+		Type:               rule.icmpType,
+		Code:               rule.icmpCode,
+		DestinationPortMin: rule.portMin,
+		DestinationPortMax: rule.portMax,
+		SourcePortMin:      rule.sourcePortMin,
+		SourcePortMax:      rule.sourcePortMax,
+	}
+	if before != "" {
+		ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototype{
+			ID: &before,
+		}
+	}
+	return ruleTemplate
+}
+
+// applyNetworkACLOrderedRules makes the rules of the network ACL the desired rules, in order. The rules are walked in
+// the desired order: a rule which is not fixed is created or moved immediately before the next fixed rule, or after
+// all the rules when no fixed rule follows, which leaves every rule in its desired position.
+func applyNetworkACLOrderedRules(sess *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
+	current, err := listNetworkACLRuleSpecs(sess, nwaclid)
+	if err != nil {
+		return err
+	}
+	desired := expandNetworkACLRuleSpecs(rules)
+	plan := planNetworkACLRules(current, desired)
+	log.Printf("[DEBUG] Network ACL %s rules: %d kept in place, %d moved, %d created, %d deleted", nwaclid,
+		len(plan.fixed), len(plan.current)-len(plan.fixed), len(desired)-len(plan.current), len(plan.deletes))
+
+	// Deletes go first so that the names of the deleted rules can be reused
+	for _, rule := range plan.deletes {
+		ruleID := rule.id
+		deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+			NetworkACLID: &nwaclid,
+			ID:           &ruleID,
+		}
+		response, err := sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
+		}
+	}
+
+	nextFixed := make([]string, len(desired))
+	next := ""
+	for i := len(desired) - 1; i >= 0; i-- {
+		nextFixed[i] = next
+		if plan.fixed[i] {
+			next = plan.current[i].id
+		}
+	}
+
+	for i, rule := range desired {
+		currentRule, kept := plan.current[i]
+		if !kept {
+			createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
+				NetworkACLID:            &nwaclid,
+				NetworkACLRulePrototype: networkACLRulePrototype(rule, nextFixed[i]),
+			}
+			_, response, err := sess.CreateNetworkACLRule(createNetworkAclRuleOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+			}
+			continue
+		}
+
+		move := !plan.fixed[i]
+		if !move && currentRule.content() == rule.content() {
+			continue
+		}
+		networkACLRulePatchModel := &vpcv1.NetworkACLRulePatch{}
+		if currentRule.content() != rule.content() {
+			networkACLRulePatchModel = &vpcv1.NetworkACLRulePatch{
+				Action:             &rule.action,
+				Destination:        &rule.destination,
+				Direction:          &rule.direction,
+				Source:             &rule.source,
+				Type:               rule.icmpType,
+				Code:               rule.icmpCode,
+				DestinationPortMin: rule.portMin,
+				DestinationPortMax: rule.portMax,
+				SourcePortMin:      rule.sourcePortMin,
+				SourcePortMax:      rule.sourcePortMax,
+			}
+		}
+		if move && nextFixed[i] != "" {
+			networkACLRulePatchModel.Before = &vpcv1.NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID{
+				ID: &nextFixed[i],
+			}
+		}
+		networkACLRulePatch, err := networkACLRulePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLRulePatch: %s", err)
+		}
+		if move && nextFixed[i] == "" {
+			// A null before moves the rule after all the rules
+			networkACLRulePatch["before"] = nil
+		}
+		ruleID := currentRule.id
+		updateNetworkACLRuleOptions := &vpcv1.UpdateNetworkACLRuleOptions{
+			NetworkACLID:        &nwaclid,
+			ID:                  &ruleID,
+			NetworkACLRulePatch: networkACLRulePatch,
+		}
+		_, response, err := sess.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Updating network ACL rule (%s): %s\n%s", ruleID, err, response)
+		}
+	}
+	return nil
+}
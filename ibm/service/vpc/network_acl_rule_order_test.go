@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestNetworkACLRuleSpecShadows(t *testing.T) {
+	denyAll := &networkACLRuleSpec{name: "deny-all", action: "deny", direction: "inbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: "all"}
+	web := &networkACLRuleSpec{name: "allow-web", action: "allow", direction: "inbound", source: "10.1.0.0/16", destination: "10.2.0.5", protocol: "tcp",
+		portMin: core.Int64Ptr(443), portMax: core.Int64Ptr(443), sourcePortMin: core.Int64Ptr(1), sourcePortMax: core.Int64Ptr(65535)}
+	webRange := &networkACLRuleSpec{name: "allow-web-range", action: "allow", direction: "inbound", source: "10.0.0.0/8", destination: "10.2.0.0/16", protocol: "tcp",
+		portMin: core.Int64Ptr(400), portMax: core.Int64Ptr(500), sourcePortMin: core.Int64Ptr(1), sourcePortMax: core.Int64Ptr(65535)}
+	webUnknownPorts := &networkACLRuleSpec{name: "allow-web-unknown", action: "allow", direction: "inbound", source: "10.0.0.0/8", destination: "10.2.0.0/16", protocol: "tcp"}
+	outbound := &networkACLRuleSpec{name: "allow-outbound", action: "allow", direction: "outbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: "all"}
+	anyPing := &networkACLRuleSpec{name: "allow-icmp", action: "allow", direction: "inbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: "icmp"}
+	echo := &networkACLRuleSpec{name: "allow-ping", action: "allow", direction: "inbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: "icmp",
+		icmpType: core.Int64Ptr(8), icmpCode: core.Int64Ptr(0)}
+	echoAnyCode := &networkACLRuleSpec{name: "allow-echo", action: "allow", direction: "inbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: "icmp",
+		icmpType: core.Int64Ptr(8)}
+	unreachable := &networkACLRuleSpec{name: "allow-unreachable", action: "allow", direction: "inbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: "icmp",
+		icmpType: core.Int64Ptr(3)}
+
+	testcases := []struct {
+		name    string
+		rule    *networkACLRuleSpec
+		later   *networkACLRuleSpec
+		shadows bool
+	}{
+		{"all protocols", denyAll, web, true},
+		{"port and address ranges", webRange, web, true},
+		{"narrower rule", web, webRange, false},
+		{"other direction", outbound, web, false},
+		{"ports unknown at plan time", webUnknownPorts, web, false},
+		{"any ICMP type", anyPing, echo, true},
+		{"any ICMP code", echoAnyCode, echo, true},
+		{"other ICMP type", unreachable, echo, false},
+		{"other protocol", anyPing, web, false},
+	}
+	for _, tc := range testcases {
+		if shadows := tc.rule.shadows(tc.later); shadows != tc.shadows {
+			t.Errorf("%s: %s shadows %s is %t, expected %t", tc.name, tc.rule.name, tc.later.name, shadows, tc.shadows)
+		}
+	}
+}
+
+func TestPlanNetworkACLRules(t *testing.T) {
+	rule := func(id, name, protocol string) *networkACLRuleSpec {
+		return &networkACLRuleSpec{id: id, name: name, action: "allow", direction: "inbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: protocol}
+	}
+	current := []*networkACLRuleSpec{
+		rule("r1", "allow-ssh", "tcp"),
+		rule("r2", "allow-outbound", "all"),
+		rule("r3", "allow-web", "tcp"),
+		rule("r4", "deny-all-inbound", "all"),
+	}
+
+	testcases := []struct {
+		name    string
+		desired []*networkACLRuleSpec
+		deletes []string
+		kept    []string
+		fixed   []int
+	}{
+		{
+			name:    "unchanged",
+			desired: []*networkACLRuleSpec{rule("", "allow-ssh", "tcp"), rule("", "allow-outbound", "all"), rule("", "allow-web", "tcp"), rule("", "deny-all-inbound", "all")},
+			kept:    []string{"r1", "r2", "r3", "r4"},
+			fixed:   []int{0, 1, 2, 3},
+		},
+		{
+			name:    "moved, created and deleted",
+			desired: []*networkACLRuleSpec{rule("", "allow-outbound", "all"), rule("", "allow-ssh", "tcp"), rule("", "allow-ping", "icmp"), rule("", "allow-web", "tcp")},
+			deletes: []string{"r4"},
+			kept:    []string{"r2", "r1", "", "r3"},
+			fixed:   []int{1, 3},
+		},
+		{
+			name:    "protocol changed",
+			desired: []*networkACLRuleSpec{rule("", "allow-ssh", "tcp"), rule("", "allow-outbound", "all"), rule("", "allow-web", "udp"), rule("", "deny-all-inbound", "all")},
+			deletes: []string{"r3"},
+			kept:    []string{"r1", "r2", "", "r4"},
+			fixed:   []int{0, 1, 3},
+		},
+		{
+			name:    "reversed",
+			desired: []*networkACLRuleSpec{rule("", "deny-all-inbound", "all"), rule("", "allow-web", "tcp"), rule("", "allow-outbound", "all"), rule("", "allow-ssh", "tcp")},
+			kept:    []string{"r4", "r3", "r2", "r1"},
+			fixed:   []int{3},
+		},
+	}
+	for _, tc := range testcases {
+		plan := planNetworkACLRules(current, tc.desired)
+		deletes := []string{}
+		for _, rule := range plan.deletes {
+			deletes = append(deletes, rule.id)
+		}
+		if tc.deletes == nil {
+			tc.deletes = []string{}
+		}
+		if !reflect.DeepEqual(deletes, tc.deletes) {
+			t.Errorf("%s: bad deletes %v, expected %v", tc.name, deletes, tc.deletes)
+		}
+		kept := make([]string, len(tc.desired))
+		for i, rule := range plan.current {
+			kept[i] = rule.id
+		}
+		if !reflect.DeepEqual(kept, tc.kept) {
+			t.Errorf("%s: bad kept rules %v, expected %v", tc.name, kept, tc.kept)
+		}
+		fixed := []int{}
+		for i := range plan.fixed {
+			fixed = append(fixed, i)
+		}
+		sort.Ints(fixed)
+		if !reflect.DeepEqual(fixed, tc.fixed) {
+			t.Errorf("%s: bad fixed rules %v, expected %v", tc.name, fixed, tc.fixed)
+		}
+	}
+}
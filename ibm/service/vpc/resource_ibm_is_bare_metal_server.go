@@ -100,7 +100,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsAllCustomizeDiff(diff, v)
 				},
 			),
 		),
@@ -560,6 +560,13 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the Bare metal server",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 		},
 	}
 }
@@ -1034,8 +1041,8 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
-		oldList, newList := d.GetChange(isBareMetalServerTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *bms.CRN)
 		if err != nil {
 			log.Printf(
@@ -1365,7 +1372,8 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 		log.Printf(
 			"[ERROR] Error on get of resource bare metal server (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isBareMetalServerTags, tags)
+	d.Set(isBareMetalServerTags, flex.RemoveDefaultTags(meta, tags, d.Get(isBareMetalServerTags)))
+	d.Set(flex.TagsAll, tags)
 
 	return nil
 }
@@ -1388,7 +1396,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		return err
 	}
 
-	if d.HasChange(isBareMetalServerTags) {
+	if d.HasChange(isBareMetalServerTags) || d.HasChange(flex.TagsAll) {
 		bmscrn := d.Get(isBareMetalServerCRN).(string)
 		if bmscrn == "" {
 			options := &vpcv1.GetBareMetalServerOptions{
@@ -1404,7 +1412,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			}
 			bmscrn = *bms.CRN
 		}
-		oldList, newList := d.GetChange(isBareMetalServerTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, bmscrn)
 		if err != nil {
 			log.Printf(
@@ -53,7 +53,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsAllCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -218,6 +218,13 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Floating IP tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -350,8 +357,8 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
-		oldList, newList := d.GetChange(isFloatingIPTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *floatingip.CRN)
 		if err != nil {
 			log.Printf(
@@ -406,7 +413,8 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc Floating IP (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isFloatingIPTags, tags)
+	d.Set(isFloatingIPTags, flex.RemoveDefaultTags(meta, tags, d.Get(isFloatingIPTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -437,7 +445,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isFloatingIPTags) {
+	if d.HasChange(isFloatingIPTags) || d.HasChange(flex.TagsAll) {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
@@ -445,7 +453,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isFloatingIPTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
 		if err != nil {
 			log.Printf(
@@ -51,7 +51,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -137,6 +137,13 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the VPC Flow logs",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -238,8 +245,8 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
-		oldList, newList := d.GetChange(isFlowLogTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
 			log.Printf(
@@ -311,7 +318,8 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf(
 			"Error on get of resource vpc flow log (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isFlowLogTags, tags)
+	d.Set(isFlowLogTags, flex.RemoveDefaultTags(meta, tags, d.Get(isFlowLogTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -347,8 +355,8 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
 	}
 
-	if d.HasChange(isFlowLogTags) {
-		oldList, newList := d.GetChange(isFlowLogTags)
+	if d.HasChange(isFlowLogTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
 			log.Printf(
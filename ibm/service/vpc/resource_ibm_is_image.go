@@ -59,7 +59,7 @@ func ResourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -102,6 +102,13 @@ func ResourceIBMISImage() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the image",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isImageOperatingSystem: {
 				Type:         schema.TypeString,
@@ -295,8 +302,8 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
-		oldList, newList := d.GetChange(isImageTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
@@ -384,8 +391,8 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
-		oldList, newList := d.GetChange(isImageTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
@@ -450,7 +457,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isImageTags) {
+	if d.HasChange(isImageTags) || d.HasChange(flex.TagsAll) {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
@@ -458,7 +465,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Image IP: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isImageTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
@@ -548,7 +555,8 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc Image (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isImageTags, tags)
+	d.Set(isImageTags, flex.RemoveDefaultTags(meta, tags, d.Get(isImageTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -0,0 +1,451 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isImageExportJobQueued    = "queued"
+	isImageExportJobRunning   = "running"
+	isImageExportJobSucceeded = "succeeded"
+	isImageExportJobFailed    = "failed"
+	isImageExportJobDeleting  = "deleting"
+	isImageExportJobDeleted   = "done"
+)
+
+// vpcImageExportJob is an export job of an image, the image export jobs API is not part of the version of the VPC SDK
+// the provider uses, the requests are made with vpcAPIRequest
+type vpcImageExportJob struct {
+	CompletedAt      *string                   `json:"completed_at,omitempty"`
+	CreatedAt        *string                   `json:"created_at,omitempty"`
+	EncryptedDataKey *string                   `json:"encrypted_data_key,omitempty"`
+	Format           *string                   `json:"format,omitempty"`
+	Href             *string                   `json:"href,omitempty"`
+	ID               *string                   `json:"id,omitempty"`
+	Name             *string                   `json:"name,omitempty"`
+	ResourceType     *string                   `json:"resource_type,omitempty"`
+	StartedAt        *string                   `json:"started_at,omitempty"`
+	Status           *string                   `json:"status,omitempty"`
+	StatusReasons    []vpcImageExportJobReason `json:"status_reasons,omitempty"`
+	StorageBucket    *vpcImageExportJobBucket  `json:"storage_bucket,omitempty"`
+	StorageHref      *string                   `json:"storage_href,omitempty"`
+	StorageObject    *vpcImageExportJobObject  `json:"storage_object,omitempty"`
+}
+
+type vpcImageExportJobReason struct {
+	Code     *string `json:"code,omitempty"`
+	Message  *string `json:"message,omitempty"`
+	MoreInfo *string `json:"more_info,omitempty"`
+}
+
+type vpcImageExportJobBucket struct {
+	CRN  *string `json:"crn,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type vpcImageExportJobObject struct {
+	Name *string `json:"name,omitempty"`
+}
+
+func ResourceIBMIsImageExportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsImageExportJobCreate,
+		ReadContext:   resourceIBMIsImageExportJobRead,
+		UpdateContext: resourceIBMIsImageExportJobUpdate,
+		DeleteContext: resourceIBMIsImageExportJobDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the image to export",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_image_export_job", "name"),
+				Description:  "The user-defined name for this image export job",
+			},
+			"storage_bucket": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The Cloud Object Storage bucket to export the image to, the bucket must allow the VPC infrastructure service to write to it",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The globally unique name of the bucket",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The CRN of the bucket",
+						},
+					},
+				},
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "qcow2",
+				ValidateFunc: validate.InvokeValidator("ibm_is_image_export_job", "format"),
+				Description:  "The format to export the image in, qcow2 or vhd",
+			},
+			"image_export_job": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this image export job",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of this image export job",
+			},
+			"status_reasons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons for the current status of this image export job",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A snake case string succinctly identifying the status reason",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An explanation of the status reason",
+						},
+						"more_info": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Link to documentation about this status reason",
+						},
+					},
+				},
+			},
+			"storage_href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Cloud Object Storage location of the exported image object",
+			},
+			"storage_object": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the exported image object in the bucket",
+			},
+			"encrypted_data_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A base64-encoded, encrypted representation of the key that was used to encrypt the data for the exported image, present for an image with a user managed key",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the image export job was created",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the image export job started running",
+			},
+			"completed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the image export job was completed",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this image export job",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of resource referenced",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsImageExportJobValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "format",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "qcow2, vhd",
+		})
+
+	ibmISImageExportJobResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_image_export_job", Schema: validateSchema}
+	return &ibmISImageExportJobResourceValidator
+}
+
+func resourceIBMIsImageExportJobCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Get("image").(string)
+	prototype := &vpcImageExportJob{
+		Format:        flex.PtrToString(d.Get("format").(string)),
+		StorageBucket: &vpcImageExportJobBucket{},
+	}
+	if name, ok := d.GetOk("name"); ok {
+		prototype.Name = flex.PtrToString(name.(string))
+	}
+	if bucketName, ok := d.GetOk("storage_bucket.0.name"); ok {
+		prototype.StorageBucket.Name = flex.PtrToString(bucketName.(string))
+	} else if bucketCRN, ok := d.GetOk("storage_bucket.0.crn"); ok {
+		prototype.StorageBucket.CRN = flex.PtrToString(bucketCRN.(string))
+	} else {
+		return diag.FromErr(fmt.Errorf("[ERROR] Either the name or the crn of the storage_bucket must be set"))
+	}
+
+	job := &vpcImageExportJob{}
+	response, err := vpcAPIRequest(context, sess, core.POST, "/images/{image_id}/export_jobs", map[string]string{"image_id": imageID}, nil, nil, prototype, job)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating export job of image (%s): %s\n%s", imageID, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", imageID, *job.ID))
+	log.Printf("[INFO] Image export job : %s", d.Id())
+
+	_, err = isWaitForImageExportJobSucceeded(context, sess, imageID, *job.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsImageExportJobRead(context, d, meta)
+}
+
+func getImageExportJob(context context.Context, sess *vpcv1.VpcV1, imageID, id string) (*vpcImageExportJob, *core.DetailedResponse, error) {
+	job := &vpcImageExportJob{}
+	response, err := vpcAPIRequest(context, sess, core.GET, "/images/{image_id}/export_jobs/{id}", map[string]string{"image_id": imageID, "id": id}, nil, nil, nil, job)
+	if err != nil {
+		return nil, response, err
+	}
+	return job, response, nil
+}
+
+func resourceIBMIsImageExportJobRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of imageID/imageExportJobID", d.Id()))
+	}
+	imageID, jobID := parts[0], parts[1]
+
+	job, response, err := getImageExportJob(context, sess, imageID, jobID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting export job (%s) of image (%s): %s\n%s", jobID, imageID, err, response))
+	}
+
+	d.Set("image", imageID)
+	d.Set("image_export_job", job.ID)
+	d.Set("name", job.Name)
+	d.Set("format", job.Format)
+	if job.StorageBucket != nil {
+		bucket := map[string]interface{}{}
+		if job.StorageBucket.Name != nil {
+			bucket["name"] = *job.StorageBucket.Name
+		}
+		if job.StorageBucket.CRN != nil {
+			bucket["crn"] = *job.StorageBucket.CRN
+		}
+		if err = d.Set("storage_bucket", []map[string]interface{}{bucket}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting storage_bucket: %s", err))
+		}
+	}
+	d.Set("status", job.Status)
+	statusReasons := make([]map[string]interface{}, 0, len(job.StatusReasons))
+	for _, reason := range job.StatusReasons {
+		statusReasons = append(statusReasons, map[string]interface{}{
+			"code":      reason.Code,
+			"message":   reason.Message,
+			"more_info": reason.MoreInfo,
+		})
+	}
+	if err = d.Set("status_reasons", statusReasons); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status_reasons: %s", err))
+	}
+	d.Set("storage_href", job.StorageHref)
+	if job.StorageObject != nil {
+		d.Set("storage_object", job.StorageObject.Name)
+	}
+	d.Set("encrypted_data_key", job.EncryptedDataKey)
+	d.Set("created_at", job.CreatedAt)
+	d.Set("started_at", job.StartedAt)
+	d.Set("completed_at", job.CompletedAt)
+	d.Set("href", job.Href)
+	d.Set("resource_type", job.ResourceType)
+	return nil
+}
+
+func resourceIBMIsImageExportJobUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("name") {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		patch := map[string]interface{}{
+			"name": d.Get("name").(string),
+		}
+		response, err := vpcAPIRequest(context, sess, core.PATCH, "/images/{image_id}/export_jobs/{id}", map[string]string{"image_id": parts[0], "id": parts[1]}, nil, nil, patch, &vpcImageExportJob{})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating export job (%s) of image (%s): %s\n%s", parts[1], parts[0], err, response))
+		}
+	}
+	return resourceIBMIsImageExportJobRead(context, d, meta)
+}
+
+// resourceIBMIsImageExportJobDelete deletes the image export job, a job which has not completed is cancelled. The
+// exported image object is kept in the bucket.
+func resourceIBMIsImageExportJobDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	imageID, jobID := parts[0], parts[1]
+
+	response, err := vpcAPIRequest(context, sess, core.DELETE, "/images/{image_id}/export_jobs/{id}", map[string]string{"image_id": imageID, "id": jobID}, nil, nil, nil, &vpcImageExportJob{})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting export job (%s) of image (%s): %s\n%s", jobID, imageID, err, response))
+	}
+	_, err = isWaitForImageExportJobDeleted(context, sess, imageID, jobID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForImageExportJobSucceeded(context context.Context, sess *vpcv1.VpcV1, imageID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for image export job (%s) to succeed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", isImageExportJobQueued, isImageExportJobRunning},
+		Target:  []string{isImageExportJobSucceeded},
+		Refresh: func() (interface{}, string, error) {
+			job, response, err := getImageExportJob(context, sess, imageID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting image export job: %s\n%s", err, response)
+			}
+			if job.Status == nil {
+				return job, isImageExportJobQueued, nil
+			}
+			if *job.Status == isImageExportJobFailed {
+				return job, *job.Status, fmt.Errorf("[ERROR] Image export job (%s) failed: %s", id, flattenImageExportJobReasons(job.StatusReasons))
+			}
+			return job, *job.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func flattenImageExportJobReasons(reasons []vpcImageExportJobReason) string {
+	messages := ""
+	for _, reason := range reasons {
+		if reason.Message == nil {
+			continue
+		}
+		if messages != "" {
+			messages += ", "
+		}
+		messages += *reason.Message
+	}
+	return messages
+}
+
+func isWaitForImageExportJobDeleted(context context.Context, sess *vpcv1.VpcV1, imageID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for image export job (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", isImageExportJobDeleting},
+		Target:  []string{isImageExportJobDeleted, ""},
+		Refresh: func() (interface{}, string, error) {
+			job, response, err := getImageExportJob(context, sess, imageID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return job, isImageExportJobDeleted, nil
+				}
+				return job, "", fmt.Errorf("[ERROR] Error getting image export job: %s\n%s", err, response)
+			}
+			return job, isImageExportJobDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISImageExportJob_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-export-%d", acctest.RandIntRange(10, 100))
+	bucket := fmt.Sprintf("tf-export-bucket-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImageExportJobConfig(bucket, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.export", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.export", "status", "succeeded"),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.export", "format", "qcow2"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_export_job.export", "storage_href"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_export_job.export", "storage_object"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISImageExportJobConfig(bucket, name+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.export", "name", name+"-updated"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_image_export_job.export",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISImageExportJobConfig(bucket, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "cos" {
+		name     = "%s-cos"
+		service  = "cloud-object-storage"
+		plan     = "standard"
+		location = "global"
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.cos.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+
+	resource "ibm_iam_authorization_policy" "policy" {
+		source_service_name         = "is"
+		source_resource_type        = "image"
+		target_service_name         = "cloud-object-storage"
+		target_resource_instance_id = ibm_resource_instance.cos.guid
+		roles                       = ["Writer"]
+	}
+
+	resource "ibm_is_image_export_job" "export" {
+		depends_on = [ibm_iam_authorization_policy.policy]
+		image      = "%s"
+		name       = "%s"
+		storage_bucket {
+			name = ibm_cos_bucket.bucket.bucket_name
+		}
+	}`, bucket, bucket, acc.IsImage, name)
+}
+
+func TestIBMISImageExportJobOffline(t *testing.T) {
+	acc.RunOfflineCRUDTest(t, acc.OfflineCRUDTest{
+		Resource: vpc.ResourceIBMIsImageExportJob(),
+		Fixtures: "testdata/ibm_is_image_export_job.json",
+		Attributes: map[string]interface{}{
+			"image":          "r006-72b27b5c-f4b0-48bb-b954-5becc7c1dcb8",
+			"name":           "tf-offline-export",
+			"storage_bucket": []interface{}{map[string]interface{}{"name": "tf-offline-bucket"}},
+		},
+		ID: "r006-72b27b5c-f4b0-48bb-b954-5becc7c1dcb8/r006-095e9baf-01d4-4e29-986e-20d26606b82a",
+		Expected: map[string]string{
+			"image_export_job":      "r006-095e9baf-01d4-4e29-986e-20d26606b82a",
+			"status":                "succeeded",
+			"format":                "qcow2",
+			"storage_object":        "tf-offline-export.qcow2",
+			"storage_href":          "cos://us-south/tf-offline-bucket/tf-offline-export.qcow2",
+			"storage_bucket.0.name": "tf-offline-bucket",
+			"completed_at":          "2022-07-01T10:05:00Z",
+			"resource_type":         "image_export_job",
+		},
+		Polls: true,
+	})
+}
@@ -171,7 +171,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsAllCustomizeDiff(diff, v)
 				}),
 		),
 
@@ -299,6 +299,13 @@ func ResourceIBMISInstance() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "list of tags for the instance",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isEnableCleanDelete: {
 				Type:             schema.TypeBool,
@@ -1342,8 +1349,8 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -1702,8 +1709,8 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -2071,8 +2078,8 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -2432,8 +2439,8 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -2903,7 +2910,8 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource Instance (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isInstanceTags, tags)
+	d.Set(isInstanceTags, flex.RemoveDefaultTags(meta, tags, d.Get(isInstanceTags)))
+	d.Set(flex.TagsAll, tags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -3586,8 +3594,8 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 	}
-	if d.HasChange(isInstanceTags) {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if d.HasChange(isInstanceTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
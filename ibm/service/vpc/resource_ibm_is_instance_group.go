@@ -39,7 +39,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -146,6 +146,13 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for instance group",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 		},
 	}
 }
@@ -255,8 +262,8 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
 			log.Printf(
@@ -278,14 +285,14 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
 		instanceGroupID := d.Id()
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 		if err != nil || instanceGroup == nil {
 			return fmt.Errorf("[ERROR] Error getting instance group: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange("tags")
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
 			log.Printf(
@@ -405,7 +412,8 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error on get of instance group (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	d.Set("tags", flex.RemoveDefaultTags(meta, tags, d.Get("tags")))
+	d.Set(flex.TagsAll, tags)
 	return nil
 }
 
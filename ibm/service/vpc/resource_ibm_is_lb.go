@@ -62,7 +62,7 @@ func ResourceIBMISLB() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsAllCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -195,6 +195,13 @@ func ResourceIBMISLB() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_lb", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isLBResourceGroup: {
 				Type:     schema.TypeString,
@@ -409,8 +416,8 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isLBTags); ok || v != "" {
-		oldList, newList := d.GetChange(isLBTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
 		if err != nil {
 			log.Printf(
@@ -544,7 +551,8 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isLBTags, tags)
+	d.Set(isLBTags, flex.RemoveDefaultTags(meta, tags, d.Get(isLBTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -600,7 +608,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isLBTags) {
+	if d.HasChange(isLBTags) || d.HasChange(flex.TagsAll) {
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
@@ -608,7 +616,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isLBTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
 		if err != nil {
 			log.Printf(
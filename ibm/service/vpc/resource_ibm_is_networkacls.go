@@ -63,8 +63,9 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
+			validateNetworkACLOrderedRules,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +97,13 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isNetworkACLCRN: {
 				Type:        schema.TypeString,
@@ -124,6 +132,11 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Computed:    true,
 				Description: "The resource group name in which resource is provisioned",
 			},
+			isNetworkACLOrderedRules: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply a change of the rules in place, matching the rules by name, instead of deleting and creating all the rules",
+			},
 			isNetworkACLRules: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -445,8 +458,8 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
-		oldList, newList := d.GetChange(isNetworkACLTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *nwacl.CRN)
 		if err != nil {
 			log.Printf(
@@ -492,7 +505,8 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource network acl (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isNetworkACLTags, tags)
+	d.Set(isNetworkACLTags, flex.RemoveDefaultTags(meta, tags, d.Get(isNetworkACLTags)))
+	d.Set(flex.TagsAll, tags)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
 	rules := make([]interface{}, 0)
 	if len(nwacl.Rules) > 0 {
@@ -626,8 +640,8 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
 		}
 	}
-	if d.HasChange(isNetworkACLTags) {
-		oldList, newList := d.GetChange(isNetworkACLTags)
+	if d.HasChange(isNetworkACLTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string))
 		if err != nil {
 			log.Printf(
@@ -639,6 +653,9 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		if err != nil {
 			return err
 		}
+		if d.Get(isNetworkACLOrderedRules).(bool) {
+			return applyNetworkACLOrderedRules(sess, id, rules)
+		}
 		//Delete all existing rules
 		err = clearRules(sess, id)
 		if err != nil {
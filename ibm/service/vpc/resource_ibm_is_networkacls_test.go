@@ -4,12 +4,15 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -185,3 +188,208 @@ func testAccCheckIBMISNetworkACLConfig1() string {
 	  }
 	`)
 }
+
+func TestNetworkACLOrderedRules(t *testing.T) {
+	var sshRuleID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLOrderedRulesConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_network_acl.ordered", "rules.#", "3"),
+					resource.TestCheckResourceAttr("ibm_is_network_acl.ordered", "rules.0.name", "inbound-ssh"),
+					testAccCheckIBMISNetworkACLRuleID("ibm_is_network_acl.ordered", 0, &sshRuleID, false),
+				),
+			},
+			{
+				// The new rule is inserted in the middle and the outbound rule moved first, the kept rules are not
+				// recreated
+				Config: testAccCheckIBMISNetworkACLOrderedRulesConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_network_acl.ordered", "rules.#", "4"),
+					resource.TestCheckResourceAttr("ibm_is_network_acl.ordered", "rules.0.name", "outbound"),
+					resource.TestCheckResourceAttr("ibm_is_network_acl.ordered", "rules.1.name", "inbound-ssh"),
+					resource.TestCheckResourceAttr("ibm_is_network_acl.ordered", "rules.2.name", "inbound-ping"),
+					resource.TestCheckResourceAttr("ibm_is_network_acl.ordered", "rules.3.name", "inbound-deny"),
+					testAccCheckIBMISNetworkACLRuleID("ibm_is_network_acl.ordered", 1, &sshRuleID, true),
+				),
+			},
+		},
+	})
+}
+
+func TestNetworkACLOrderedRulesShadowed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "ibm_is_network_acl" "ordered" {
+					name          = "tf-nwacl-shadowed"
+					vpc           = "r006-00000000-0000-0000-0000-000000000000"
+					ordered_rules = true
+					rules {
+						name        = "inbound-deny"
+						action      = "deny"
+						source      = "0.0.0.0/0"
+						destination = "0.0.0.0/0"
+						direction   = "inbound"
+					}
+					rules {
+						name        = "inbound-ssh"
+						action      = "allow"
+						source      = "10.0.0.0/8"
+						destination = "0.0.0.0/0"
+						direction   = "inbound"
+						tcp {
+							port_min = 22
+							port_max = 22
+						}
+					}
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rules.1 \(inbound-ssh\) is unreachable`),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMISNetworkACLRuleID records the ID of a rule, or checks that the rule still has the recorded ID
+func testAccCheckIBMISNetworkACLRuleID(n string, index int, ruleID *string, same bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		id := rs.Primary.Attributes[fmt.Sprintf("rules.%d.id", index)]
+		if !same {
+			*ruleID = id
+			return nil
+		}
+		if id != *ruleID {
+			return fmt.Errorf("rules.%d was recreated: ID %s, expected %s", index, id, *ruleID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISNetworkACLOrderedRulesConfig(reordered bool) string {
+	outbound := `
+		rules {
+			name        = "outbound"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "outbound"
+		}`
+	ssh := `
+		rules {
+			name        = "inbound-ssh"
+			action      = "allow"
+			source      = "10.0.0.0/8"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}`
+	ping := `
+		rules {
+			name        = "inbound-ping"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			icmp {
+				type = 8
+				code = 0
+			}
+		}`
+	deny := `
+		rules {
+			name        = "inbound-deny"
+			action      = "deny"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+		}`
+	rules := ssh + outbound + deny
+	if reordered {
+		rules = outbound + ssh + ping + deny
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "tf-nwacl-ordered-vpc"
+	}
+
+	resource "ibm_is_network_acl" "ordered" {
+		name          = "tf-nwacl-ordered"
+		vpc           = ibm_is_vpc.testacc_vpc.id
+		ordered_rules = true
+		%s
+	}
+	`, rules)
+}
+
+func TestIBMISNetworkACLOrderedRulesOffline(t *testing.T) {
+	endpoints := acc.NewFakeEndpoints(t, "testdata/ibm_is_network_acl_ordered_rules.json")
+	meta := endpoints.ClientSession(t)
+
+	r := vpc.ResourceIBMISNetworkACL()
+	state := &terraform.InstanceState{
+		ID: "r006-a4841334-b584-4293-938e-3bc63b4a5b6a",
+		Attributes: map[string]string{
+			"id":            "r006-a4841334-b584-4293-938e-3bc63b4a5b6a",
+			"name":          "tf-offline-acl",
+			"vpc":           "r006-vpc",
+			"ordered_rules": "true",
+		},
+	}
+	rule := func(name, action, direction, source string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"action":      action,
+			"direction":   direction,
+			"source":      source,
+			"destination": "0.0.0.0/0",
+		}
+	}
+	outbound := rule("allow-outbound", "allow", "outbound", "0.0.0.0/0")
+	ssh := rule("allow-ssh", "allow", "inbound", "10.0.0.0/8")
+	ssh["tcp"] = []interface{}{map[string]interface{}{"port_min": 22, "port_max": 22}}
+	ping := rule("allow-ping", "allow", "inbound", "0.0.0.0/0")
+	ping["icmp"] = []interface{}{map[string]interface{}{"type": 8, "code": 0}}
+	web := rule("allow-web", "allow", "inbound", "192.168.0.0/16")
+	web["tcp"] = []interface{}{map[string]interface{}{"port_min": 443, "port_max": 443}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "tf-offline-acl",
+		"vpc":           "r006-vpc",
+		"ordered_rules": true,
+		"rules":         []interface{}{outbound, ssh, ping, web},
+	})
+	diff, err := r.Diff(context.Background(), state, config, meta)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// allow-ssh and allow-web keep their position, allow-outbound is moved before allow-ssh, allow-ping is created
+	// before allow-web, allow-web gets its new source and deny-all-inbound is deleted. A request the fixtures do not
+	// expect fails the update.
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	for i, name := range []string{"allow-outbound", "allow-ssh", "allow-ping", "allow-web"} {
+		if newState.Attributes[fmt.Sprintf("rules.%d.name", i)] != name {
+			t.Fatalf("bad rules.%d.name: %q, expected %q", i, newState.Attributes[fmt.Sprintf("rules.%d.name", i)], name)
+		}
+	}
+	if newState.Attributes["rules.3.source"] != "192.168.0.0/16" {
+		t.Fatalf("bad rules.3.source: %q", newState.Attributes["rules.3.source"])
+	}
+}
@@ -50,7 +50,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,13 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 			isPlacementGroupAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -193,8 +200,8 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
-	if _, ok := d.GetOk(isPlacementGroupTags); ok {
-		oldList, newList := d.GetChange(isPlacementGroupTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
@@ -273,7 +280,8 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 			"Error getting placement group (%s) access tags: %s", d.Id(), err)
 	}
 
-	d.Set(isPlacementGroupTags, tags)
+	d.Set(isPlacementGroupTags, flex.RemoveDefaultTags(meta, tags, d.Get(isPlacementGroupTags)))
+	d.Set(flex.TagsAll, tags)
 	d.Set(isPlacementGroupAccessTags, accesstags)
 	return nil
 }
@@ -309,8 +317,8 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 	}
-	if d.HasChange(isPlacementGroupTags) {
-		oldList, newList := d.GetChange(isPlacementGroupTags)
+	if d.HasChange(isPlacementGroupTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
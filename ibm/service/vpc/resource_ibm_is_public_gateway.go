@@ -51,7 +51,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -107,6 +107,13 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Service tags for the public gateway instance",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -227,8 +234,8 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isPublicGatewayTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
@@ -305,7 +312,8 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error on get of vpc public gateway (%s) tags: %s", id, err)
 	}
-	d.Set(isPublicGatewayTags, tags)
+	d.Set(isPublicGatewayTags, flex.RemoveDefaultTags(meta, tags, d.Get(isPublicGatewayTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -336,7 +344,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isPublicGatewayName).(string)
 		hasChanged = true
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if d.HasChange(isPublicGatewayTags) || d.HasChange(flex.TagsAll) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
@@ -344,7 +352,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isPublicGatewayTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
@@ -40,7 +40,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -73,6 +73,13 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isSecurityGroupCRN: {
 				Type:        schema.TypeString,
@@ -180,8 +187,8 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
-		oldList, newList := d.GetChange(isSecurityGroupTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *sg.CRN)
 		if err != nil {
 			log.Printf(
@@ -214,7 +221,8 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error getting Security Group tags : %s\n%s", d.Id(), err)
 	}
-	d.Set(isSecurityGroupTags, tags)
+	d.Set(isSecurityGroupTags, flex.RemoveDefaultTags(meta, tags, d.Get(isSecurityGroupTags)))
+	d.Set(flex.TagsAll, tags)
 	d.Set(isSecurityGroupCRN, *group.CRN)
 	d.Set(isSecurityGroupName, *group.Name)
 	d.Set(isSecurityGroupVPC, *group.VPC.ID)
@@ -331,8 +339,8 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	name := ""
 	hasChanged := false
 
-	if d.HasChange(isSecurityGroupTags) {
-		oldList, newList := d.GetChange(isSecurityGroupTags)
+	if d.HasChange(isSecurityGroupTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string))
 		if err != nil {
 			log.Printf(
@@ -0,0 +1,644 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSecurityGroupRulesRules = "rules"
+	isSecurityGroupRemoteAny  = "0.0.0.0/0"
+
+	// quota of rules per security group
+	isSecurityGroupRulesQuota = 250
+)
+
+// ResourceIBMISSecurityGroupRules manages the full rule list of a security group. Rules of the group which are not in
+// the configuration, added out-of-band or by ibm_is_security_group_rule, are read into the state and deleted by the
+// next apply.
+func ResourceIBMISSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSecurityGroupRulesCreate,
+		ReadContext:   resourceIBMISSecurityGroupRulesRead,
+		UpdateContext: resourceIBMISSecurityGroupRulesUpdate,
+		DeleteContext: resourceIBMISSecurityGroupRulesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMISSecurityGroupRulesValidate,
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Security group id",
+			},
+			isSecurityGroupRulesRules: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The rules of the security group, the rules of the group which are not listed are deleted",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isSecurityGroupRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Rule id",
+						},
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+						},
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							Description:  "IP version: ipv4",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+						},
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Security group id: an IP address, a CIDR block, or a single security group identifier, any address if not set",
+						},
+						isSecurityGroupRuleProtocolICMP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=icmp",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isSecurityGroupRuleType: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+									},
+									isSecurityGroupRuleCode: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+									},
+								},
+							},
+						},
+						isSecurityGroupRuleProtocolTCP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=tcp",
+							Elem:        securityGroupRulesPortsSchema(),
+						},
+						isSecurityGroupRuleProtocolUDP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=udp",
+							Elem:        securityGroupRulesPortsSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func securityGroupRulesPortsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupRulePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+			},
+			isSecurityGroupRulePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+			},
+		},
+	}
+}
+
+// resourceIBMISSecurityGroupRulesValidate checks at plan time what ConflictsWith can not express for the elements of
+// the rules list
+func resourceIBMISSecurityGroupRulesValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, rule := range diff.Get(isSecurityGroupRulesRules).([]interface{}) {
+		if rule == nil {
+			continue
+		}
+		ruleMap := rule.(map[string]interface{})
+		protocols := 0
+		for _, protocol := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+			if len(ruleMap[protocol].([]interface{})) > 0 {
+				protocols++
+			}
+		}
+		if protocols > 1 {
+			return fmt.Errorf("[ERROR] rules.%d: only one of %s, %s and %s can be set", i, isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP)
+		}
+		for _, protocol := range []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+			ports := ruleMap[protocol].([]interface{})
+			if len(ports) == 0 || ports[0] == nil {
+				continue
+			}
+			portRange := ports[0].(map[string]interface{})
+			if portRange[isSecurityGroupRulePortMin].(int) > portRange[isSecurityGroupRulePortMax].(int) {
+				return fmt.Errorf("[ERROR] rules.%d: %s.0.%s must not be greater than %s", i, protocol, isSecurityGroupRulePortMin, isSecurityGroupRulePortMax)
+			}
+		}
+	}
+	return nil
+}
+
+// securityGroupRuleSpec is a rule of a security group, from the configuration or from the API. Unset fields of the rule
+// are nil.
+type securityGroupRuleSpec struct {
+	id        string
+	direction string
+	ipVersion string
+	remote    string
+	protocol  string
+	icmpType  *int64
+	icmpCode  *int64
+	portMin   *int64
+	portMax   *int64
+}
+
+// key identifies the rule by its content, rules with the same key are the same rule
+func (rule *securityGroupRuleSpec) key() string {
+	remote := rule.remote
+	if remote == "" {
+		remote = isSecurityGroupRemoteAny
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s/%s", rule.direction, rule.ipVersion, remote, rule.protocol,
+		int64PtrKey(rule.icmpType), int64PtrKey(rule.icmpCode), int64PtrKey(rule.portMin), int64PtrKey(rule.portMax))
+}
+
+// patchable tells if the current rule can be changed into the desired rule with UpdateSecurityGroupRule, the protocol of
+// a rule can not be changed and the type and code of an ICMP rule can not be unset
+func (rule *securityGroupRuleSpec) patchable(desired *securityGroupRuleSpec) bool {
+	if rule.protocol != desired.protocol {
+		return false
+	}
+	return (rule.icmpType == nil) == (desired.icmpType == nil) && (rule.icmpCode == nil) == (desired.icmpCode == nil)
+}
+
+func int64PtrKey(value *int64) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *value)
+}
+
+func expandSecurityGroupRuleSpecs(rules []interface{}) []*securityGroupRuleSpec {
+	specs := make([]*securityGroupRuleSpec, 0, len(rules))
+	for _, ruleIntf := range rules {
+		if ruleIntf == nil {
+			continue
+		}
+		rule := ruleIntf.(map[string]interface{})
+		spec := &securityGroupRuleSpec{
+			id:        rule[isSecurityGroupRuleID].(string),
+			direction: rule[isSecurityGroupRuleDirection].(string),
+			ipVersion: rule[isSecurityGroupRuleIPVersion].(string),
+			remote:    rule[isSecurityGroupRuleRemote].(string),
+			protocol:  "all",
+		}
+		if spec.ipVersion == "" {
+			spec.ipVersion = isSecurityGroupRuleIPVersionDefault
+		}
+		// As for ibm_is_security_group_rule, an empty icmp block allows any type and code and the code is 0 when only
+		// the type is set
+		if icmp := rule[isSecurityGroupRuleProtocolICMP].([]interface{}); len(icmp) > 0 {
+			spec.protocol = isSecurityGroupRuleProtocolICMP
+			if icmp[0] != nil {
+				icmpMap := icmp[0].(map[string]interface{})
+				icmpType := int64(icmpMap[isSecurityGroupRuleType].(int))
+				icmpCode := int64(icmpMap[isSecurityGroupRuleCode].(int))
+				spec.icmpType = &icmpType
+				spec.icmpCode = &icmpCode
+			}
+		}
+		for _, protocol := range []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+			ports := rule[protocol].([]interface{})
+			if len(ports) == 0 {
+				continue
+			}
+			spec.protocol = protocol
+			portMin, portMax := int64(1), int64(65535)
+			if ports[0] != nil {
+				portMap := ports[0].(map[string]interface{})
+				portMin = int64(portMap[isSecurityGroupRulePortMin].(int))
+				portMax = int64(portMap[isSecurityGroupRulePortMax].(int))
+			}
+			spec.portMin = &portMin
+			spec.portMax = &portMax
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func flattenSecurityGroupRuleSpec(spec *securityGroupRuleSpec) map[string]interface{} {
+	rule := map[string]interface{}{
+		isSecurityGroupRuleID:        spec.id,
+		isSecurityGroupRuleDirection: spec.direction,
+		isSecurityGroupRuleIPVersion: spec.ipVersion,
+		isSecurityGroupRuleRemote:    spec.remote,
+	}
+	switch spec.protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		icmp := map[string]interface{}{}
+		if spec.icmpType != nil {
+			icmp[isSecurityGroupRuleType] = int(*spec.icmpType)
+		}
+		if spec.icmpCode != nil {
+			icmp[isSecurityGroupRuleCode] = int(*spec.icmpCode)
+		}
+		rule[isSecurityGroupRuleProtocolICMP] = []interface{}{icmp}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		ports := map[string]interface{}{}
+		if spec.portMin != nil {
+			ports[isSecurityGroupRulePortMin] = int(*spec.portMin)
+		}
+		if spec.portMax != nil {
+			ports[isSecurityGroupRulePortMax] = int(*spec.portMax)
+		}
+		rule[spec.protocol] = []interface{}{ports}
+	}
+	return rule
+}
+
+func securityGroupRuleRemoteString(remoteIntf vpcv1.SecurityGroupRuleRemoteIntf) string {
+	remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote)
+	if !ok || remote == nil || reflect.ValueOf(remote).IsNil() {
+		return ""
+	}
+	if remote.ID != nil {
+		return *remote.ID
+	} else if remote.Address != nil {
+		return *remote.Address
+	} else if remote.CIDRBlock != nil {
+		return *remote.CIDRBlock
+	}
+	return ""
+}
+
+func securityGroupRuleSpecFromRule(ruleIntf vpcv1.SecurityGroupRuleIntf) *securityGroupRuleSpec {
+	spec := &securityGroupRuleSpec{}
+	switch rule := ruleIntf.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		spec.id, spec.direction, spec.ipVersion, spec.protocol = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol
+		spec.remote = securityGroupRuleRemoteString(rule.Remote)
+		spec.icmpType, spec.icmpCode = rule.Type, rule.Code
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		spec.id, spec.direction, spec.ipVersion, spec.protocol = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol
+		spec.remote = securityGroupRuleRemoteString(rule.Remote)
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		spec.id, spec.direction, spec.ipVersion, spec.protocol = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol
+		spec.remote = securityGroupRuleRemoteString(rule.Remote)
+		spec.portMin, spec.portMax = rule.PortMin, rule.PortMax
+	default:
+		return nil
+	}
+	return spec
+}
+
+func listSecurityGroupRuleSpecs(sess *vpcv1.VpcV1, secgrpID string) ([]*securityGroupRuleSpec, *core.DetailedResponse, error) {
+	listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+		SecurityGroupID: &secgrpID,
+	}
+	rules, response, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	specs := make([]*securityGroupRuleSpec, 0, len(rules.Rules))
+	for _, rule := range rules.Rules {
+		if spec := securityGroupRuleSpecFromRule(rule); spec != nil {
+			specs = append(specs, spec)
+		}
+	}
+	return specs, response, nil
+}
+
+// securityGroupRulesPlan is the minimal set of changes which turns the current rules of a security group into the
+// desired rules
+type securityGroupRulesPlan struct {
+	// deletes are the current rules which are neither kept nor updated
+	deletes []*securityGroupRuleSpec
+	// updates pair a current rule, by index of desired, with the desired rule it is patched into
+	updates map[int]*securityGroupRuleSpec
+	// creates are the indexes of the desired rules which are created
+	creates []int
+	// ids are the IDs of the kept and updated rules, by index of desired
+	ids map[int]string
+}
+
+// planSecurityGroupRules matches the desired rules to the current rules. A current rule with the same content as a
+// desired rule is kept, the current rules left over are patched into the desired rules left over where the API allows
+// it, and the rest are deleted and created.
+func planSecurityGroupRules(current, desired []*securityGroupRuleSpec) *securityGroupRulesPlan {
+	plan := &securityGroupRulesPlan{
+		updates: map[int]*securityGroupRuleSpec{},
+		ids:     map[int]string{},
+	}
+	used := make([]bool, len(current))
+	unmatched := []int{}
+	for i, rule := range desired {
+		matched := false
+		for j, currentRule := range current {
+			if !used[j] && currentRule.key() == rule.key() {
+				used[j] = true
+				plan.ids[i] = currentRule.id
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unmatched = append(unmatched, i)
+		}
+	}
+	for _, i := range unmatched {
+		matched := false
+		for j, currentRule := range current {
+			if !used[j] && currentRule.patchable(desired[i]) {
+				used[j] = true
+				plan.updates[i] = currentRule
+				plan.ids[i] = currentRule.id
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			plan.creates = append(plan.creates, i)
+		}
+	}
+	for j, currentRule := range current {
+		if !used[j] {
+			plan.deletes = append(plan.deletes, currentRule)
+		}
+	}
+	return plan
+}
+
+func securityGroupRuleRemotePrototype(remote string) (*vpcv1.SecurityGroupRuleRemotePrototype, error) {
+	if remote == "" {
+		remote = isSecurityGroupRemoteAny
+	}
+	address, cidr, id, err := inferRemoteSecurityGroup(remote)
+	if err != nil {
+		return nil, err
+	}
+	prototype := &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if address != "" {
+		prototype.Address = &address
+	} else if cidr != "" {
+		prototype.CIDRBlock = &cidr
+	} else {
+		prototype.ID = &id
+	}
+	return prototype, nil
+}
+
+// applySecurityGroupRules makes the rules of the security group the desired rules in one pass under the lock of the
+// rules of the group, which ibm_is_security_group_rule takes as well. It returns the IDs of the desired rules.
+func applySecurityGroupRules(ctx context.Context, sess *vpcv1.VpcV1, secgrpID string, desired []*securityGroupRuleSpec) ([]string, error) {
+	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
+	conns.IbmMutexKV.LockContext(ctx, isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.UnlockContext(ctx, isSecurityGroupRuleKey)
+
+	current, response, err := listSecurityGroupRuleSpecs(sess, secgrpID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing Security Group Rules : %s\n%s", err, response)
+	}
+	plan := planSecurityGroupRules(current, desired)
+	log.Printf("[DEBUG] Security group %s rules: %d kept, %d updated, %d created, %d deleted", secgrpID,
+		len(plan.ids)-len(plan.updates), len(plan.updates), len(plan.creates), len(plan.deletes))
+
+	// The stale rules are deleted last, so that a failure in between leaves the group with the rules it had besides
+	// the rules which were already applied. The group must have room for the created rules until then.
+	if total := len(current) + len(plan.creates); len(plan.creates) > 0 && total > isSecurityGroupRulesQuota {
+		return nil, fmt.Errorf("[ERROR] Security group %s would have %d rules before its %d stale rules are deleted, more than the quota of %d rules, apply the removal of the stale rules before adding rules",
+			secgrpID, total, len(plan.deletes), isSecurityGroupRulesQuota)
+	}
+
+	for i, currentRule := range plan.updates {
+		rule := desired[i]
+		remote, err := securityGroupRuleRemotePrototype(rule.remote)
+		if err != nil {
+			return nil, err
+		}
+		securityGroupRulePatchModel := &vpcv1.SecurityGroupRulePatch{
+			Direction: &rule.direction,
+			IPVersion: &rule.ipVersion,
+			Remote: &vpcv1.SecurityGroupRuleRemotePatch{
+				Address:   remote.Address,
+				CIDRBlock: remote.CIDRBlock,
+				ID:        remote.ID,
+			},
+			Type:    rule.icmpType,
+			Code:    rule.icmpCode,
+			PortMin: rule.portMin,
+			PortMax: rule.portMax,
+		}
+		securityGroupRulePatch, err := securityGroupRulePatchModel.AsPatch()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupRulePatch: %s", err)
+		}
+		ruleID := currentRule.id
+		updateSecurityGroupRuleOptions := &vpcv1.UpdateSecurityGroupRuleOptions{
+			SecurityGroupID:        &secgrpID,
+			ID:                     &ruleID,
+			SecurityGroupRulePatch: securityGroupRulePatch,
+		}
+		_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Updating Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		}
+	}
+
+	for _, i := range plan.creates {
+		rule := desired[i]
+		remote, err := securityGroupRuleRemotePrototype(rule.remote)
+		if err != nil {
+			return nil, err
+		}
+		options := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID: &secgrpID,
+			SecurityGroupRulePrototype: &vpcv1.SecurityGroupRulePrototype{
+				Direction: &rule.direction,
+				IPVersion: &rule.ipVersion,
+				Protocol:  &rule.protocol,
+				Remote:    remote,
+				Type:      rule.icmpType,
+				Code:      rule.icmpCode,
+				PortMin:   rule.portMin,
+				PortMax:   rule.portMax,
+			},
+		}
+		created, response, err := sess.CreateSecurityGroupRule(options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
+		}
+		if spec := securityGroupRuleSpecFromRule(created); spec != nil {
+			plan.ids[i] = spec.id
+		}
+	}
+
+	for _, rule := range plan.deletes {
+		ruleID := rule.id
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &secgrpID,
+			ID:              &ruleID,
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return nil, fmt.Errorf("[ERROR] Error Deleting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		}
+	}
+
+	ids := make([]string, len(desired))
+	for i := range desired {
+		ids[i] = plan.ids[i]
+	}
+	return ids, nil
+}
+
+func resourceIBMISSecurityGroupRulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secgrpID := d.Get(isSecurityGroupID).(string)
+	if err := resourceIBMISSecurityGroupRulesApply(context, d, sess, secgrpID); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(secgrpID)
+	return resourceIBMISSecurityGroupRulesRead(context, d, meta)
+}
+
+// resourceIBMISSecurityGroupRulesApply applies the configured rules and stores their IDs, in configuration order, for
+// the read which follows
+func resourceIBMISSecurityGroupRulesApply(ctx context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, secgrpID string) error {
+	desired := expandSecurityGroupRuleSpecs(d.Get(isSecurityGroupRulesRules).([]interface{}))
+	ids, err := applySecurityGroupRules(ctx, sess, secgrpID, desired)
+	if err != nil {
+		return err
+	}
+	rules := make([]map[string]interface{}, 0, len(desired))
+	for i, rule := range desired {
+		rule.id = ids[i]
+		rules = append(rules, flattenSecurityGroupRuleSpec(rule))
+	}
+	if err = d.Set(isSecurityGroupRulesRules, rules); err != nil {
+		return fmt.Errorf("[ERROR] Error setting rules: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secgrpID := d.Id()
+	current, response, err := listSecurityGroupRuleSpecs(sess, secgrpID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing Security Group Rules : %s\n%s", err, response))
+	}
+
+	// The rules are kept in the order of the state, the rules which are not in the state are unmanaged and are
+	// appended, which shows them as drift to delete in the next plan. A remote of any address is read as unset unless
+	// the state has it explicitly.
+	stateRules := expandSecurityGroupRuleSpecs(d.Get(isSecurityGroupRulesRules).([]interface{}))
+	stateByID := make(map[string]*securityGroupRuleSpec, len(stateRules))
+	for _, rule := range stateRules {
+		stateByID[rule.id] = rule
+	}
+	currentByID := make(map[string]*securityGroupRuleSpec, len(current))
+	for _, rule := range current {
+		if stateRule, ok := stateByID[rule.id]; rule.remote == isSecurityGroupRemoteAny && (!ok || stateRule.remote == "") {
+			rule.remote = ""
+		}
+		currentByID[rule.id] = rule
+	}
+	rules := make([]map[string]interface{}, 0, len(current))
+	for _, stateRule := range stateRules {
+		if rule, ok := currentByID[stateRule.id]; ok {
+			rules = append(rules, flattenSecurityGroupRuleSpec(rule))
+			delete(currentByID, stateRule.id)
+		}
+	}
+	for _, rule := range current {
+		if _, ok := currentByID[rule.id]; !ok {
+			continue
+		}
+		if len(stateRules) > 0 {
+			log.Printf("[WARN] Security group %s has the rule %s which is not managed by ibm_is_security_group_rules, it will be deleted by the next apply", secgrpID, rule.id)
+		}
+		rules = append(rules, flattenSecurityGroupRuleSpec(rule))
+	}
+
+	d.Set(isSecurityGroupID, secgrpID)
+	if err = d.Set(isSecurityGroupRulesRules, rules); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting rules: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isSecurityGroupRulesRules) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := resourceIBMISSecurityGroupRulesApply(context, d, sess, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMISSecurityGroupRulesRead(context, d, meta)
+}
+
+// resourceIBMISSecurityGroupRulesDelete deletes all the rules of the group, the group itself is kept
+func resourceIBMISSecurityGroupRulesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secgrpID := d.Id()
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &secgrpID,
+	}
+	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", secgrpID, err, response))
+	}
+	if _, err := applySecurityGroupRules(context, sess, secgrpID, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
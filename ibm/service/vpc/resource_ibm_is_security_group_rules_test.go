@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSecurityGroupRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsgrules-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrules-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, 22),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_security_group_rules.rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr("ibm_is_security_group_rules.rules", "rules.0.tcp.0.port_min", "22"),
+					resource.TestCheckResourceAttrSet("ibm_is_security_group_rules.rules", "rules.0.rule_id"),
+					resource.TestCheckResourceAttr("ibm_is_security_group_rules.rules", "rules.2.remote", "10.0.0.0/8"),
+					testAccCheckIBMISSecurityGroupRuleCount("ibm_is_security_group.group", 3),
+					// A rule added out-of-band shows as drift in the next step
+					testAccAddIBMISSecurityGroupRule("ibm_is_security_group.group"),
+				),
+			},
+			{
+				Config:             testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, 22),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, 2222),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_security_group_rules.rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr("ibm_is_security_group_rules.rules", "rules.0.tcp.0.port_min", "2222"),
+					testAccCheckIBMISSecurityGroupRuleCount("ibm_is_security_group.group", 3),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_security_group_rules.rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISSecurityGroupRules_conflictingProtocols(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "ibm_is_security_group_rules" "rules" {
+					group = "r006-00000000-0000-0000-0000-000000000000"
+					rules {
+						direction = "inbound"
+						tcp {
+							port_min = 22
+							port_max = 22
+						}
+						udp {
+							port_min = 53
+							port_max = 53
+						}
+					}
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only one of icmp, tcp and udp can be set"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name string, sshPort int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rules" "rules" {
+		group = ibm_is_security_group.group.id
+		rules {
+			direction = "inbound"
+			tcp {
+				port_min = %d
+				port_max = %d
+			}
+		}
+		rules {
+			direction = "inbound"
+			icmp {
+				type = 8
+			}
+		}
+		rules {
+			direction = "outbound"
+			remote    = "10.0.0.0/8"
+		}
+	}`, vpcname, name, sshPort, sshPort)
+}
+
+// testAccAddIBMISSecurityGroupRule adds a rule to the security group behind the back of terraform
+func testAccAddIBMISSecurityGroupRule(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		direction, protocol, port := "inbound", "tcp", int64(443)
+		options := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID: &rs.Primary.ID,
+			SecurityGroupRulePrototype: &vpcv1.SecurityGroupRulePrototype{
+				Direction: &direction,
+				Protocol:  &protocol,
+				PortMin:   &port,
+				PortMax:   &port,
+			},
+		}
+		_, _, err = sess.CreateSecurityGroupRule(options)
+		return err
+	}
+}
+
+func testAccCheckIBMISSecurityGroupRuleCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		rules, _, err := sess.ListSecurityGroupRules(&vpcv1.ListSecurityGroupRulesOptions{SecurityGroupID: &rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		if len(rules.Rules) != count {
+			return fmt.Errorf("security group %s has %d rules, expected %d", rs.Primary.ID, len(rules.Rules), count)
+		}
+		return nil
+	}
+}
+
+func TestIBMISSecurityGroupRulesOffline(t *testing.T) {
+	// The ICMP rule is deleted, the UDP rule is patched to port 54 and the outbound rule is created, a request the
+	// fixtures do not expect fails the test
+	acc.RunOfflineCRUDTest(t, acc.OfflineCRUDTest{
+		Resource: vpc.ResourceIBMISSecurityGroupRules(),
+		Fixtures: "testdata/ibm_is_security_group_rules.json",
+		Attributes: map[string]interface{}{
+			"group": "r006-6a9d8b4e-27c2-4d58-ba5a-5d6f3e2a5c10",
+			"rules": []interface{}{
+				map[string]interface{}{
+					"direction":  "inbound",
+					"ip_version": "ipv4",
+					"tcp":        []interface{}{map[string]interface{}{"port_min": 22, "port_max": 22}},
+				},
+				map[string]interface{}{
+					"direction":  "inbound",
+					"ip_version": "ipv4",
+					"udp":        []interface{}{map[string]interface{}{"port_min": 54, "port_max": 54}},
+				},
+				map[string]interface{}{
+					"direction":  "outbound",
+					"ip_version": "ipv4",
+					"remote":     "10.0.0.0/8",
+				},
+			},
+		},
+		ID: "r006-6a9d8b4e-27c2-4d58-ba5a-5d6f3e2a5c10",
+		Expected: map[string]string{
+			"rules.#":                "4",
+			"rules.0.rule_id":        "r006-rule-ssh",
+			"rules.0.remote":         "",
+			"rules.1.rule_id":        "r006-rule-dns",
+			"rules.1.udp.0.port_min": "54",
+			"rules.2.rule_id":        "r006-rule-outbound",
+			"rules.2.remote":         "10.0.0.0/8",
+			"rules.3.rule_id":        "r006-rule-unmanaged",
+			"rules.3.remote":         "192.168.0.0/16",
+			"rules.3.tcp.0.port_min": "443",
+		},
+	})
+}
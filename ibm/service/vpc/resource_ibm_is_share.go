@@ -0,0 +1,554 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isShareName                = "name"
+	isShareZone                = "zone"
+	isShareProfile             = "profile"
+	isShareSize                = "size"
+	isShareIops                = "iops"
+	isShareEncryptionKey       = "encryption_key"
+	isShareEncryption          = "encryption"
+	isShareResourceGroup       = "resource_group"
+	isShareAccessControlMode   = "access_control_mode"
+	isShareSourceShare         = "source_share"
+	isShareReplicationCronSpec = "replication_cron_spec"
+	isShareReplicationRole     = "replication_role"
+	isShareReplicationStatus   = "replication_status"
+	isShareReplicaShare        = "replica_share"
+	isShareMountTargets        = "mount_targets"
+	isShareTags                = "tags"
+	isShareCRN                 = "crn"
+	isShareHref                = "href"
+	isShareCreatedAt           = "created_at"
+	isShareLifecycleState      = "lifecycle_state"
+
+	isShareStable   = "stable"
+	isShareFailed   = "failed"
+	isSharePending  = "pending"
+	isShareUpdating = "updating"
+	isShareWaiting  = "waiting"
+	isShareDeleting = "deleting"
+	isShareDeleted  = "done"
+)
+
+func ResourceIBMIsShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsShareCreate,
+		ReadContext:   resourceIBMIsShareRead,
+		UpdateContext: resourceIBMIsShareUpdate,
+		DeleteContext: resourceIBMIsShareDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourceIBMIsShareValidate,
+			// The size of a share can only be increased
+			customdiff.ForceNewIfChange(isShareSize, func(_ context.Context, old, new, meta interface{}) bool {
+				return new.(int) < old.(int)
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			isShareName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareName),
+				Description:  "The unique user-defined name for this file share",
+			},
+			isShareZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone the file share resides in",
+			},
+			isShareProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the profile of the file share",
+			},
+			isShareSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareSize),
+				Description:  "The size of the file share rounded up to the next gigabyte, the size of a replica share is the size of its source share",
+			},
+			isShareIops: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareIops),
+				Description:  "The maximum input/output operations per second (IOPS) for the file share",
+			},
+			isShareEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The CRN of the key to use for encrypting this file share, the file share is encrypted with a provider managed key when it is not set",
+			},
+			isShareEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption used for this file share",
+			},
+			isShareResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource group of the file share",
+			},
+			isShareAccessControlMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareAccessControlMode),
+				Description:  "The access control mode for the file share, security_group for mount targets with a virtual network interface and vpc for mount targets with a VPC",
+			},
+			isShareSourceShare: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the source file share, setting it creates the file share as a replica of the source file share",
+			},
+			isShareReplicationCronSpec: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The cron specification of the replication of a replica share from its source file share",
+			},
+			isShareReplicationRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication role of the file share, none, replica or source",
+			},
+			isShareReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the file share",
+			},
+			isShareReplicaShare: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the replica share of the file share",
+			},
+			isShareMountTargets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mount targets of the file share",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this share mount target",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this share mount target",
+						},
+					},
+				},
+			},
+			isShareTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_share", "tags")},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The user tags of the file share",
+			},
+			isShareCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this file share",
+			},
+			isShareHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this file share",
+			},
+			isShareCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the file share is created",
+			},
+			isShareLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the file share",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsShareValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "tags",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareSize,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "10",
+			MaxValue:                   "32000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareIops,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "100",
+			MaxValue:                   "96000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareAccessControlMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "security_group, vpc",
+		})
+
+	ibmISShareResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_share", Schema: validateSchema}
+	return &ibmISShareResourceValidator
+}
+
+// resourceIBMIsShareValidate checks the arguments of a share against the ones of a replica share, the configuration is
+// checked as size and replication_cron_spec are computed
+func resourceIBMIsShareValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	sourceShare := config.GetAttr(isShareSourceShare)
+	if !sourceShare.IsKnown() {
+		return nil
+	}
+	if !sourceShare.IsNull() {
+		if diff.Id() == "" && config.GetAttr(isShareReplicationCronSpec).IsNull() {
+			return fmt.Errorf("[ERROR] %s is required to create a replica share", isShareReplicationCronSpec)
+		}
+		return nil
+	}
+	if diff.Id() == "" && config.GetAttr(isShareSize).IsNull() {
+		return fmt.Errorf("[ERROR] %s is required unless %s is set", isShareSize, isShareSourceShare)
+	}
+	if !config.GetAttr(isShareReplicationCronSpec).IsNull() {
+		return fmt.Errorf("[ERROR] %s can only be set for a replica share, with %s", isShareReplicationCronSpec, isShareSourceShare)
+	}
+	return nil
+}
+
+func resourceIBMIsShareCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prototype := &vpcShare{
+		Name: flex.PtrToString(d.Get(isShareName).(string)),
+		Zone: &vpcShareReference{
+			Name: flex.PtrToString(d.Get(isShareZone).(string)),
+		},
+		Profile: &vpcShareReference{
+			Name: flex.PtrToString(d.Get(isShareProfile).(string)),
+		},
+	}
+	if sourceShare, ok := d.GetOk(isShareSourceShare); ok {
+		prototype.SourceShare = &vpcShareReference{
+			ID: flex.PtrToString(sourceShare.(string)),
+		}
+		prototype.ReplicationCronSpec = flex.PtrToString(d.Get(isShareReplicationCronSpec).(string))
+	} else {
+		size := int64(d.Get(isShareSize).(int))
+		prototype.Size = &size
+	}
+	if i, ok := d.GetOk(isShareIops); ok {
+		iops := int64(i.(int))
+		prototype.Iops = &iops
+	}
+	if key, ok := d.GetOk(isShareEncryptionKey); ok {
+		prototype.EncryptionKey = &vpcShareReference{
+			CRN: flex.PtrToString(key.(string)),
+		}
+	}
+	if rg, ok := d.GetOk(isShareResourceGroup); ok {
+		prototype.ResourceGroup = &vpcShareReference{
+			ID: flex.PtrToString(rg.(string)),
+		}
+	}
+	if mode, ok := d.GetOk(isShareAccessControlMode); ok {
+		prototype.AccessControlMode = flex.PtrToString(mode.(string))
+	}
+	if v, ok := d.GetOk(isShareTags); ok {
+		userTags := flex.ExpandStringList(v.(*schema.Set).List())
+		if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+			userTags = append(userTags, strings.Split(schematicTags, ",")...)
+		}
+		prototype.UserTags = userTags
+	}
+
+	share, response, err := createShare(context, sess, prototype)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating share: %s\n%s", err, response))
+	}
+	d.SetId(*share.ID)
+	log.Printf("[INFO] Share : %s", *share.ID)
+
+	_, err = isWaitForShareAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsShareRead(context, d, meta)
+}
+
+func resourceIBMIsShareRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	share, response, err := getShare(context, sess, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting share (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set(isShareName, share.Name)
+	if share.Zone != nil {
+		d.Set(isShareZone, share.Zone.Name)
+	}
+	if share.Profile != nil {
+		d.Set(isShareProfile, share.Profile.Name)
+	}
+	d.Set(isShareSize, flex.IntValue(share.Size))
+	d.Set(isShareIops, flex.IntValue(share.Iops))
+	if share.EncryptionKey != nil {
+		d.Set(isShareEncryptionKey, share.EncryptionKey.CRN)
+	}
+	d.Set(isShareEncryption, share.Encryption)
+	if share.ResourceGroup != nil {
+		d.Set(isShareResourceGroup, share.ResourceGroup.ID)
+	}
+	d.Set(isShareAccessControlMode, share.AccessControlMode)
+	if share.SourceShare != nil {
+		d.Set(isShareSourceShare, share.SourceShare.ID)
+	}
+	d.Set(isShareReplicationCronSpec, share.ReplicationCronSpec)
+	d.Set(isShareReplicationRole, share.ReplicationRole)
+	d.Set(isShareReplicationStatus, share.ReplicationStatus)
+	if share.ReplicaShare != nil {
+		d.Set(isShareReplicaShare, share.ReplicaShare.ID)
+	} else {
+		d.Set(isShareReplicaShare, nil)
+	}
+	if err = d.Set(isShareMountTargets, flattenShareMountTargetReferences(share.MountTargets)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting mount_targets: %s", err))
+	}
+	if err = d.Set(isShareTags, share.UserTags); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting user tags: %s", err))
+	}
+	d.Set(isShareCRN, share.CRN)
+	d.Set(isShareHref, share.Href)
+	d.Set(isShareCreatedAt, share.CreatedAt)
+	d.Set(isShareLifecycleState, share.LifecycleState)
+	return nil
+}
+
+func flattenShareMountTargetReferences(mountTargets []vpcShareReference) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(mountTargets))
+	for _, mountTarget := range mountTargets {
+		mountTargetMap := map[string]interface{}{}
+		if mountTarget.ID != nil {
+			mountTargetMap["id"] = *mountTarget.ID
+		}
+		if mountTarget.Name != nil {
+			mountTargetMap["name"] = *mountTarget.Name
+		}
+		result = append(result, mountTargetMap)
+	}
+	return result
+}
+
+func resourceIBMIsShareUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := map[string]interface{}{}
+	if d.HasChange(isShareName) {
+		patch["name"] = d.Get(isShareName).(string)
+	}
+	if d.HasChange(isShareProfile) {
+		patch["profile"] = map[string]interface{}{"name": d.Get(isShareProfile).(string)}
+	}
+	if d.HasChange(isShareSize) {
+		patch["size"] = d.Get(isShareSize).(int)
+	}
+	if d.HasChange(isShareIops) {
+		patch["iops"] = d.Get(isShareIops).(int)
+	}
+	if d.HasChange(isShareAccessControlMode) {
+		patch["access_control_mode"] = d.Get(isShareAccessControlMode).(string)
+	}
+	if d.HasChange(isShareReplicationCronSpec) {
+		patch["replication_cron_spec"] = d.Get(isShareReplicationCronSpec).(string)
+	}
+	if d.HasChange(isShareTags) {
+		patch["user_tags"] = flex.ExpandStringList(d.Get(isShareTags).(*schema.Set).List())
+	}
+	if len(patch) == 0 {
+		return resourceIBMIsShareRead(context, d, meta)
+	}
+
+	// The share is updated against the version it was last read at
+	_, response, err := getShare(context, sess, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting share (%s): %s\n%s", d.Id(), err, response))
+	}
+	_, response, err = updateShare(context, sess, d.Id(), response.Headers.Get("Etag"), patch)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating share (%s): %s\n%s", d.Id(), err, response))
+	}
+	_, err = isWaitForShareAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsShareRead(context, d, meta)
+}
+
+func resourceIBMIsShareDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, response, err := getShare(context, sess, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting share (%s): %s\n%s", d.Id(), err, response))
+	}
+	response, err = deleteShare(context, sess, d.Id(), response.Headers.Get("Etag"))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting share (%s): %s\n%s", d.Id(), err, response))
+	}
+	_, err = isWaitForShareDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForShareAvailable(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isSharePending, isShareUpdating, isShareWaiting},
+		Target:     []string{isShareStable},
+		Refresh:    isShareRefreshFunc(context, sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isShareRefreshFunc(context context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		share, response, err := getShare(context, sess, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting share: %s\n%s", err, response)
+		}
+		state := share.lifecycleState()
+		if state == isShareFailed {
+			return share, state, fmt.Errorf("[ERROR] Share (%s) went into the %s lifecycle state", id, state)
+		}
+		return share, state, nil
+	}
+}
+
+func isWaitForShareDeleted(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isShareDeleting, isShareStable, isShareUpdating},
+		Target:     []string{isShareDeleted, ""},
+		Refresh:    isShareDeleteRefreshFunc(context, sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isShareDeleteRefreshFunc(context context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		share, response, err := getShare(context, sess, id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return share, isShareDeleted, nil
+			}
+			return share, "", fmt.Errorf("[ERROR] Error getting share: %s\n%s", err, response)
+		}
+		state := share.lifecycleState()
+		if state == isShareFailed {
+			return share, state, fmt.Errorf("[ERROR] Share (%s) went into the %s lifecycle state", id, state)
+		}
+		return share, isShareDeleting, nil
+	}
+}
@@ -0,0 +1,480 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isShareMountTargetStable   = "stable"
+	isShareMountTargetFailed   = "failed"
+	isShareMountTargetPending  = "pending"
+	isShareMountTargetUpdating = "updating"
+	isShareMountTargetDeleting = "deleting"
+	isShareMountTargetDeleted  = "done"
+)
+
+func ResourceIBMIsShareMountTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsShareMountTargetCreate,
+		ReadContext:   resourceIBMIsShareMountTargetRead,
+		UpdateContext: resourceIBMIsShareMountTargetUpdate,
+		DeleteContext: resourceIBMIsShareMountTargetDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"share": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the file share",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share_mount_target", "name"),
+				Description:  "The user-defined name for this share mount target",
+			},
+			"vpc": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"vpc", "virtual_network_interface"},
+				Description:  "The ID of the VPC in which clients can mount the file share, for a file share with the vpc access control mode",
+			},
+			"virtual_network_interface": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"vpc", "virtual_network_interface"},
+				Description:  "The virtual network interface clients mount the file share through, for a file share with the security_group access control mode",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the virtual network interface",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The name of the virtual network interface",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The ID of the subnet of the virtual network interface",
+						},
+						"primary_ip": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "The primary IP address of the virtual network interface",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										ForceNew:    true,
+										Description: "The IP address to reserve, an available address of the subnet is reserved when it is not set",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										ForceNew:    true,
+										Description: "The name of the reserved IP",
+									},
+									"reserved_ip": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier of the reserved IP",
+									},
+								},
+							},
+						},
+						"resource_group": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The ID of the resource group of the virtual network interface",
+						},
+						"security_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The IDs of the security groups of the virtual network interface, the default security group of the VPC is used when it is not set",
+						},
+					},
+				},
+			},
+			"transit_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share_mount_target", "transit_encryption"),
+				Description:  "The transit encryption mode of the mount target, none or user_managed",
+			},
+			"mount_target": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the share mount target",
+			},
+			"mount_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mount path for the file share",
+			},
+			"access_control_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access control mode of the mount target",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the mount target",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the share mount target was created",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this share mount target",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of resource referenced",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsShareMountTargetValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "transit_encryption",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "none, user_managed",
+		})
+
+	ibmISShareMountTargetResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_share_mount_target", Schema: validateSchema}
+	return &ibmISShareMountTargetResourceValidator
+}
+
+func resourceIBMIsShareMountTargetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shareID := d.Get("share").(string)
+	prototype := &vpcShareMountTarget{
+		Name: flex.PtrToString(d.Get("name").(string)),
+	}
+	if vpcID, ok := d.GetOk("vpc"); ok {
+		prototype.VPC = &vpcShareReference{
+			ID: flex.PtrToString(vpcID.(string)),
+		}
+	}
+	if vni, ok := d.GetOk("virtual_network_interface.0"); ok {
+		prototype.VirtualNetworkInterface = expandShareMountTargetNetworkInterface(vni.(map[string]interface{}))
+	}
+	if transitEncryption, ok := d.GetOk("transit_encryption"); ok {
+		prototype.TransitEncryption = flex.PtrToString(transitEncryption.(string))
+	}
+
+	mountTarget, response, err := createShareMountTarget(context, sess, shareID, prototype)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating mount target of share (%s): %s\n%s", shareID, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", shareID, *mountTarget.ID))
+	log.Printf("[INFO] Share mount target : %s", d.Id())
+
+	_, err = isWaitForShareMountTargetAvailable(context, sess, shareID, *mountTarget.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsShareMountTargetRead(context, d, meta)
+}
+
+func expandShareMountTargetNetworkInterface(vni map[string]interface{}) *vpcShareMountTargetNetworkInterface {
+	prototype := &vpcShareMountTargetNetworkInterface{
+		Subnet: &vpcShareReference{
+			ID: flex.PtrToString(vni["subnet"].(string)),
+		},
+	}
+	if name := vni["name"].(string); name != "" {
+		prototype.Name = &name
+	}
+	if rg := vni["resource_group"].(string); rg != "" {
+		prototype.ResourceGroup = &vpcShareReference{
+			ID: &rg,
+		}
+	}
+	if primaryIPs := vni["primary_ip"].([]interface{}); len(primaryIPs) > 0 && primaryIPs[0] != nil {
+		primaryIP := primaryIPs[0].(map[string]interface{})
+		prototype.PrimaryIP = &vpcShareReference{}
+		if address := primaryIP["address"].(string); address != "" {
+			prototype.PrimaryIP.Address = &address
+		}
+		if name := primaryIP["name"].(string); name != "" {
+			prototype.PrimaryIP.Name = &name
+		}
+	}
+	if securityGroups, ok := vni["security_groups"].(*schema.Set); ok {
+		for _, securityGroup := range securityGroups.List() {
+			prototype.SecurityGroups = append(prototype.SecurityGroups, vpcShareReference{
+				ID: flex.PtrToString(securityGroup.(string)),
+			})
+		}
+	}
+	return prototype
+}
+
+func resourceIBMIsShareMountTargetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of shareID/mountTargetID", d.Id()))
+	}
+	shareID, mountTargetID := parts[0], parts[1]
+
+	mountTarget, response, err := getShareMountTarget(context, sess, shareID, mountTargetID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting mount target (%s) of share (%s): %s\n%s", mountTargetID, shareID, err, response))
+	}
+
+	d.Set("share", shareID)
+	d.Set("mount_target", mountTarget.ID)
+	d.Set("name", mountTarget.Name)
+	if mountTarget.VPC != nil && mountTarget.VirtualNetworkInterface == nil {
+		d.Set("vpc", mountTarget.VPC.ID)
+	}
+	if mountTarget.VirtualNetworkInterface != nil {
+		vni, err := flattenShareMountTargetNetworkInterface(context, sess, mountTarget)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("virtual_network_interface", vni); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting virtual_network_interface: %s", err))
+		}
+	}
+	d.Set("transit_encryption", mountTarget.TransitEncryption)
+	d.Set("mount_path", mountTarget.MountPath)
+	d.Set("access_control_mode", mountTarget.AccessControlMode)
+	d.Set("lifecycle_state", mountTarget.LifecycleState)
+	d.Set("created_at", mountTarget.CreatedAt)
+	d.Set("href", mountTarget.Href)
+	d.Set("resource_type", mountTarget.ResourceType)
+	return nil
+}
+
+// flattenShareMountTargetNetworkInterface flattens the virtual network interface of a mount target, the mount target
+// only references the interface so its security groups and resource group are read from the interface itself
+func flattenShareMountTargetNetworkInterface(context context.Context, sess *vpcv1.VpcV1, mountTarget *vpcShareMountTarget) ([]map[string]interface{}, error) {
+	vni := map[string]interface{}{}
+	if mountTarget.VirtualNetworkInterface.ID != nil {
+		vni["id"] = *mountTarget.VirtualNetworkInterface.ID
+	}
+	if mountTarget.VirtualNetworkInterface.Name != nil {
+		vni["name"] = *mountTarget.VirtualNetworkInterface.Name
+	}
+	if mountTarget.Subnet != nil && mountTarget.Subnet.ID != nil {
+		vni["subnet"] = *mountTarget.Subnet.ID
+	}
+	if mountTarget.PrimaryIP != nil {
+		primaryIP := map[string]interface{}{}
+		if mountTarget.PrimaryIP.Address != nil {
+			primaryIP["address"] = *mountTarget.PrimaryIP.Address
+		}
+		if mountTarget.PrimaryIP.Name != nil {
+			primaryIP["name"] = *mountTarget.PrimaryIP.Name
+		}
+		if mountTarget.PrimaryIP.ID != nil {
+			primaryIP["reserved_ip"] = *mountTarget.PrimaryIP.ID
+		}
+		vni["primary_ip"] = []map[string]interface{}{primaryIP}
+	}
+
+	if mountTarget.VirtualNetworkInterface.ID != nil {
+		networkInterface := &vpcShareMountTargetNetworkInterface{}
+		response, err := vpcAPIRequest(context, sess, core.GET, "/virtual_network_interfaces/{id}", map[string]string{"id": *mountTarget.VirtualNetworkInterface.ID}, nil, nil, nil, networkInterface)
+		if err != nil {
+			if response == nil || response.StatusCode != 404 {
+				return nil, fmt.Errorf("[ERROR] Error getting virtual network interface (%s): %s\n%s", *mountTarget.VirtualNetworkInterface.ID, err, response)
+			}
+		} else {
+			if networkInterface.ResourceGroup != nil && networkInterface.ResourceGroup.ID != nil {
+				vni["resource_group"] = *networkInterface.ResourceGroup.ID
+			}
+			securityGroups := make([]string, 0, len(networkInterface.SecurityGroups))
+			for _, securityGroup := range networkInterface.SecurityGroups {
+				if securityGroup.ID != nil {
+					securityGroups = append(securityGroups, *securityGroup.ID)
+				}
+			}
+			vni["security_groups"] = securityGroups
+		}
+	}
+	return []map[string]interface{}{vni}, nil
+}
+
+func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("name") {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		patch := map[string]interface{}{
+			"name": d.Get("name").(string),
+		}
+		_, response, err := updateShareMountTarget(context, sess, parts[0], parts[1], patch)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating mount target (%s) of share (%s): %s\n%s", parts[1], parts[0], err, response))
+		}
+	}
+	return resourceIBMIsShareMountTargetRead(context, d, meta)
+}
+
+func resourceIBMIsShareMountTargetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	shareID, mountTargetID := parts[0], parts[1]
+
+	response, err := deleteShareMountTarget(context, sess, shareID, mountTargetID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting mount target (%s) of share (%s): %s\n%s", mountTargetID, shareID, err, response))
+	}
+	_, err = isWaitForShareMountTargetDeleted(context, sess, shareID, mountTargetID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForShareMountTargetAvailable(context context.Context, sess *vpcv1.VpcV1, shareID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share mount target (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", isShareMountTargetPending, isShareMountTargetUpdating},
+		Target:  []string{isShareMountTargetStable},
+		Refresh: func() (interface{}, string, error) {
+			mountTarget, response, err := getShareMountTarget(context, sess, shareID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting share mount target: %s\n%s", err, response)
+			}
+			state := mountTarget.lifecycleState()
+			if state == isShareMountTargetFailed {
+				return mountTarget, state, fmt.Errorf("[ERROR] Share mount target (%s) went into the %s lifecycle state", id, state)
+			}
+			return mountTarget, state, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForShareMountTargetDeleted(context context.Context, sess *vpcv1.VpcV1, shareID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share mount target (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", isShareMountTargetDeleting},
+		Target:  []string{isShareMountTargetDeleted, ""},
+		Refresh: func() (interface{}, string, error) {
+			mountTarget, response, err := getShareMountTarget(context, sess, shareID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return mountTarget, isShareMountTargetDeleted, nil
+				}
+				return mountTarget, "", fmt.Errorf("[ERROR] Error getting share mount target: %s\n%s", err, response)
+			}
+			state := mountTarget.lifecycleState()
+			if state == isShareMountTargetFailed {
+				return mountTarget, state, fmt.Errorf("[ERROR] Share mount target (%s) went into the %s lifecycle state", id, state)
+			}
+			return mountTarget, isShareMountTargetDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISShareMountTarget_vpc(t *testing.T) {
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	shareName := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareMountTargetVPCConfig(vpcName, shareName, "tf-mount-target"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.mount_target", "name", "tf-mount-target"),
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.mount_target", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.mount_target", "access_control_mode", "vpc"),
+					resource.TestCheckResourceAttrSet("ibm_is_share_mount_target.mount_target", "mount_path"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareMountTargetVPCConfig(vpcName, shareName, "tf-mount-target-upd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.mount_target", "name", "tf-mount-target-upd"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_share_mount_target.mount_target",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISShareMountTarget_virtualNetworkInterface(t *testing.T) {
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	shareName := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareMountTargetVNIConfig(vpcName, subnetName, shareName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.mount_target", "access_control_mode", "security_group"),
+					resource.TestCheckResourceAttrSet("ibm_is_share_mount_target.mount_target", "virtual_network_interface.0.id"),
+					resource.TestCheckResourceAttrSet("ibm_is_share_mount_target.mount_target", "virtual_network_interface.0.primary_ip.0.address"),
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.mount_target", "virtual_network_interface.0.security_groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISShareMountTargetVPCConfig(vpcName, shareName, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_share" "share" {
+		name                = "%s"
+		zone                = "%s"
+		profile             = "dp2"
+		size                = 200
+		access_control_mode = "vpc"
+	}
+
+	resource "ibm_is_share_mount_target" "mount_target" {
+		share = ibm_is_share.share.id
+		name  = "%s"
+		vpc   = ibm_is_vpc.vpc.id
+	}`, vpcName, shareName, acc.ISZoneName, name)
+}
+
+func testAccCheckIBMISShareMountTargetVNIConfig(vpcName, subnetName, shareName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_share" "share" {
+		name    = "%s"
+		zone    = "%s"
+		profile = "dp2"
+		size    = 200
+	}
+
+	resource "ibm_is_share_mount_target" "mount_target" {
+		share = ibm_is_share.share.id
+		name  = "tf-mount-target"
+		virtual_network_interface {
+			subnet          = ibm_is_subnet.subnet.id
+			security_groups = [ibm_is_vpc.vpc.default_security_group]
+		}
+	}`, vpcName, subnetName, acc.ISZoneName, shareName, acc.ISZoneName)
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISShare_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	name2 := fmt.Sprintf("tf-share-upd-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareConfig(name, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share.share", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_share.share", "size", "200"),
+					resource.TestCheckResourceAttr("ibm_is_share.share", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr("ibm_is_share.share", "replication_role", "none"),
+					resource.TestCheckResourceAttrSet("ibm_is_share.share", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareConfig(name2, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share.share", "name", name2),
+					resource.TestCheckResourceAttr("ibm_is_share.share", "size", "300"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_share.share",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISShare_replica(t *testing.T) {
+	name := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareReplicaConfig(name, "0 */5 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share.replica", "replication_role", "replica"),
+					resource.TestCheckResourceAttr("ibm_is_share.replica", "replication_cron_spec", "0 */5 * * *"),
+					resource.TestCheckResourceAttrPair("ibm_is_share.replica", "source_share", "ibm_is_share.share", "id"),
+					resource.TestCheckResourceAttrPair("ibm_is_share.replica", "size", "ibm_is_share.share", "size"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareReplicaConfig(name, "0 */10 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share.replica", "replication_cron_spec", "0 */10 * * *"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISShare_sizeRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "ibm_is_share" "share" {
+					name    = "tf-share-no-size"
+					zone    = "%s"
+					profile = "dp2"
+				}`, acc.ISZoneName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("size is required unless source_share is set"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISShareConfig(name string, size int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_share" "share" {
+		name    = "%s"
+		zone    = "%s"
+		profile = "dp2"
+		size    = %d
+	}`, name, acc.ISZoneName, size)
+}
+
+func testAccCheckIBMISShareReplicaConfig(name, cronSpec string) string {
+	return testAccCheckIBMISShareConfig(name, 200) + fmt.Sprintf(`
+	resource "ibm_is_share" "replica" {
+		name                  = "%s-replica"
+		zone                  = "%s"
+		profile               = "dp2"
+		source_share          = ibm_is_share.share.id
+		replication_cron_spec = "%s"
+	}`, name, acc.ISZoneName, cronSpec)
+}
+
+func TestIBMISShareOffline(t *testing.T) {
+	acc.RunOfflineCRUDTest(t, acc.OfflineCRUDTest{
+		Resource: vpc.ResourceIBMIsShare(),
+		Fixtures: "testdata/ibm_is_share.json",
+		Attributes: map[string]interface{}{
+			"name":    "tf-offline-share",
+			"zone":    "us-south-1",
+			"profile": "dp2",
+			"size":    200,
+		},
+		ID: "r006-0fe9e5d8-0a4d-4818-96ec-e99708644a58",
+		Expected: map[string]string{
+			"lifecycle_state":      "stable",
+			"iops":                 "3000",
+			"encryption":           "provider_managed",
+			"access_control_mode":  "security_group",
+			"replication_role":     "none",
+			"resource_group":       "fake-resource-group",
+			"mount_targets.0.id":   "r006-mt",
+			"mount_targets.0.name": "tf-offline-mount-target",
+		},
+		Polls: true,
+	})
+}
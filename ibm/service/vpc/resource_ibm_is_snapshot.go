@@ -11,50 +11,54 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
-	isSnapshotName             = "name"
-	isSnapshotResourceGroup    = "resource_group"
-	isSnapshotSourceVolume     = "source_volume"
-	isSnapshotSourceImage      = "source_image"
-	isSnapshotUserTags         = "tags"
-	isSnapshotCRN              = "crn"
-	isSnapshotHref             = "href"
-	isSnapshotEncryption       = "encryption"
-	isSnapshotEncryptionKey    = "encryption_key"
-	isSnapshotOperatingSystem  = "operating_system"
-	isSnapshotLCState          = "lifecycle_state"
-	isSnapshotMinCapacity      = "minimum_capacity"
-	isSnapshotResourceType     = "resource_type"
-	isSnapshotSize             = "size"
-	isSnapshotBootable         = "bootable"
-	isSnapshotDeleting         = "deleting"
-	isSnapshotDeleted          = "deleted"
-	isSnapshotAvailable        = "stable"
-	isSnapshotFailed           = "failed"
-	isSnapshotPending          = "pending"
-	isSnapshotSuspended        = "suspended"
-	isSnapshotUpdating         = "updating"
-	isSnapshotWaiting          = "waiting"
-	isSnapshotCapturedAt       = "captured_at"
-	isSnapshotBackupPolicyPlan = "backup_policy_plan"
+	isSnapshotName              = "name"
+	isSnapshotResourceGroup     = "resource_group"
+	isSnapshotSourceVolume      = "source_volume"
+	isSnapshotSourceSnapshotCRN = "source_snapshot_crn"
+	isSnapshotSourceImage       = "source_image"
+	isSnapshotUserTags          = "tags"
+	isSnapshotCRN               = "crn"
+	isSnapshotHref              = "href"
+	isSnapshotEncryption        = "encryption"
+	isSnapshotEncryptionKey     = "encryption_key"
+	isSnapshotOperatingSystem   = "operating_system"
+	isSnapshotLCState           = "lifecycle_state"
+	isSnapshotMinCapacity       = "minimum_capacity"
+	isSnapshotResourceType      = "resource_type"
+	isSnapshotSize              = "size"
+	isSnapshotBootable          = "bootable"
+	isSnapshotDeleting          = "deleting"
+	isSnapshotDeleted           = "deleted"
+	isSnapshotAvailable         = "stable"
+	isSnapshotFailed            = "failed"
+	isSnapshotPending           = "pending"
+	isSnapshotSuspended         = "suspended"
+	isSnapshotUpdating          = "updating"
+	isSnapshotWaiting           = "waiting"
+	isSnapshotCapturedAt        = "captured_at"
+	isSnapshotBackupPolicyPlan  = "backup_policy_plan"
 )
 
 func ResourceIBMSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSnapshotCreate,
-		Read:     resourceIBMISSnapshotRead,
-		Update:   resourceIBMISSnapshotUpdate,
-		Delete:   resourceIBMISSnapshotDelete,
-		Exists:   resourceIBMISSnapshotExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSnapshotCreate,
+		ReadContext:   resourceIBMISSnapshotRead,
+		UpdateContext: resourceIBMISSnapshotUpdate,
+		DeleteContext: resourceIBMISSnapshotDelete,
+		Exists:        resourceIBMISSnapshotExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -65,6 +69,13 @@ func ResourceIBMSnapshot() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				config := diff.GetRawConfig()
+				if diff.Id() == "" && !config.IsNull() && !config.GetAttr(isSnapshotEncryptionKey).IsNull() && config.GetAttr(isSnapshotSourceSnapshotCRN).IsNull() {
+					return fmt.Errorf("[ERROR] %s can only be set with %s, the snapshot of a volume is encrypted with the key of the volume", isSnapshotEncryptionKey, isSnapshotSourceSnapshotCRN)
+				}
+				return nil
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -86,10 +97,19 @@ func ResourceIBMSnapshot() *schema.Resource {
 			},
 
 			isSnapshotSourceVolume: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Snapshot source volume",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isSnapshotSourceVolume, isSnapshotSourceSnapshotCRN},
+				Description:  "Snapshot source volume",
+			},
+
+			isSnapshotSourceSnapshotCRN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isSnapshotSourceVolume, isSnapshotSourceSnapshotCRN},
+				Description:  "The CRN of the snapshot to copy, from another region",
 			},
 
 			isSnapshotSourceImage: {
@@ -127,8 +147,10 @@ func ResourceIBMSnapshot() *schema.Resource {
 			},
 			isSnapshotEncryptionKey: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "A reference to the root key used to wrap the data encryption key for the source volume.",
+				ForceNew:    true,
+				Description: "A reference to the root key used to wrap the data encryption key for the source volume. It can only be set for a copy of a snapshot, the snapshot of a volume uses the key of the volume.",
 			},
 
 			isSnapshotHref: {
@@ -235,10 +257,17 @@ func ResourceIBMISSnapshotValidator() *validate.ResourceValidator {
 	return &ibmISSnapshotResourceValidator
 }
 
-func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if _, ok := d.GetOk(isSnapshotSourceSnapshotCRN); ok {
+		err = resourceIBMISSnapshotCopy(ctx, d, meta, sess)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceIBMISSnapshotRead(ctx, d, meta)
 	}
 	options := &vpcv1.CreateSnapshotOptions{}
 	snapshotprototypeoptions := &vpcv1.SnapshotPrototypeSnapshotBySourceVolume{}
@@ -280,44 +309,131 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Snapshot create")
 	options.SnapshotPrototype = snapshotprototypeoptions
-	snapshot, response, err := sess.CreateSnapshot(options)
+	snapshot, response, err := sess.CreateSnapshotWithContext(ctx, options)
 	if err != nil || snapshot == nil {
-		return fmt.Errorf("[ERROR] Error creating Snapshot %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Snapshot %s\n%s", err, response))
 	}
 
 	d.SetId(*snapshot.ID)
 	log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
 
-	_, err = isWaitForSnapshotAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSnapshotAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMISSnapshotRead(ctx, d, meta)
+}
 
+// resourceIBMISSnapshotCopy creates the snapshot as a copy of a snapshot of another region. The source snapshot is read
+// with a client of its region, the copy is created in the region of the provider.
+func resourceIBMISSnapshotCopy(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1) error {
+	sourceSnapshotCRN := d.Get(isSnapshotSourceSnapshotCRN).(string)
+	region, sourceSnapshotID, err := parseSnapshotCRN(sourceSnapshotCRN)
+	if err != nil {
+		return err
+	}
+	sourceSess, err := meta.(conns.ClientSession).VpcV1APIForRegion(region)
 	if err != nil {
 		return err
 	}
+	sourceSnapshot, response, err := sourceSess.GetSnapshotWithContext(ctx, &vpcv1.GetSnapshotOptions{
+		ID: &sourceSnapshotID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting source Snapshot (%s) in region %s: %s\n%s", sourceSnapshotID, region, err, response)
+	}
+	if sourceSnapshot.LifecycleState != nil && *sourceSnapshot.LifecycleState != isSnapshotAvailable {
+		return fmt.Errorf("[ERROR] Source Snapshot (%s) is %s, only %s snapshots can be copied", sourceSnapshotID, *sourceSnapshot.LifecycleState, isSnapshotAvailable)
+	}
 
-	return resourceIBMISSnapshotRead(d, meta)
+	prototype := map[string]interface{}{
+		"source_snapshot": map[string]interface{}{
+			"crn": sourceSnapshotCRN,
+		},
+	}
+	if snapshotName, ok := d.GetOk(isSnapshotName); ok {
+		prototype["name"] = snapshotName.(string)
+	}
+	if key, ok := d.GetOk(isSnapshotEncryptionKey); ok {
+		prototype["encryption_key"] = map[string]interface{}{
+			"crn": key.(string),
+		}
+	}
+	if grp, ok := d.GetOk(isSnapshotResourceGroup); ok {
+		prototype["resource_group"] = map[string]interface{}{
+			"id": grp.(string),
+		}
+	}
+	if v, ok := d.GetOk(isSnapshotUserTags); ok {
+		userTags := flex.ExpandStringList(v.(*schema.Set).List())
+		if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+			userTags = append(userTags, strings.Split(schematicTags, ",")...)
+		}
+		prototype["user_tags"] = userTags
+	}
+
+	log.Printf("[DEBUG] Snapshot copy of %s", sourceSnapshotCRN)
+	snapshot := &vpcv1.SnapshotReference{}
+	response, err = vpcAPIRequest(ctx, sess, core.POST, "/snapshots", nil, nil, nil, prototype, snapshot)
+	if err != nil || snapshot.ID == nil {
+		return fmt.Errorf("[ERROR] Error copying Snapshot %s: %s\n%s", sourceSnapshotCRN, err, response)
+	}
+
+	d.SetId(*snapshot.ID)
+	log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
+
+	_, err = isWaitForSnapshotAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	return err
+}
+
+// parseSnapshotCRN returns the region and the ID of the snapshot of the CRN
+func parseSnapshotCRN(snapshotCRN string) (string, string, error) {
+	parts := strings.Split(snapshotCRN, ":")
+	if len(parts) != 10 || parts[0] != "crn" || parts[8] != "snapshot" || parts[5] == "" || parts[9] == "" {
+		return "", "", fmt.Errorf("[ERROR] Invalid snapshot CRN %s", snapshotCRN)
+	}
+	return parts[5], parts[9], nil
 }
 
-func isWaitForSnapshotAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+// getSnapshotSourceSnapshotCRN returns the CRN of the snapshot the snapshot is a copy of, or an empty string, the source
+// snapshot is not part of the model of the SDK
+func getSnapshotSourceSnapshotCRN(ctx context.Context, sess *vpcv1.VpcV1, id string) (string, error) {
+	snapshot := &struct {
+		SourceSnapshot *vpcv1.SnapshotReference `json:"source_snapshot,omitempty"`
+	}{}
+	response, err := vpcAPIRequest(ctx, sess, core.GET, "/snapshots/{id}", map[string]string{"id": id}, nil, nil, nil, snapshot)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+	}
+	if snapshot.SourceSnapshot == nil || snapshot.SourceSnapshot.CRN == nil {
+		return "", nil
+	}
+	return *snapshot.SourceSnapshot.CRN, nil
+}
+
+func isWaitForSnapshotAvailable(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isSnapshotPending},
 		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotRefreshFunc(sess, id),
+		Refresh:    isSnapshotRefreshFunc(ctx, sess, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -332,16 +448,16 @@ func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFu
 	}
 }
 
-func resourceIBMISSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := snapshotGet(d, meta, id)
+	err := snapshotGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
+func snapshotGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -349,7 +465,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+	snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -376,9 +492,22 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if snapshot.ResourceGroup != nil && snapshot.ResourceGroup.ID != nil {
 		d.Set(isSnapshotResourceGroup, *snapshot.ResourceGroup.ID)
 	}
-	if snapshot.SourceVolume != nil && snapshot.SourceVolume.ID != nil {
+	sourceSnapshotCRN := d.Get(isSnapshotSourceSnapshotCRN).(string)
+	if sourceSnapshotCRN == "" && d.Get(isSnapshotSourceVolume).(string) == "" {
+		// The snapshot is imported, it can be a copy of a snapshot
+		sourceSnapshotCRN, err = getSnapshotSourceSnapshotCRN(ctx, sess, id)
+		if err != nil {
+			return err
+		}
+		d.Set(isSnapshotSourceSnapshotCRN, sourceSnapshotCRN)
+	}
+	// The source volume of a copy is the one of the source snapshot, in its region
+	if sourceSnapshotCRN == "" && snapshot.SourceVolume != nil && snapshot.SourceVolume.ID != nil {
 		d.Set(isSnapshotSourceVolume, *snapshot.SourceVolume.ID)
 	}
+	if snapshot.EncryptionKey != nil && snapshot.EncryptionKey.CRN != nil {
+		d.Set(isSnapshotEncryptionKey, *snapshot.EncryptionKey.CRN)
+	}
 
 	if snapshot.SourceImage != nil && snapshot.SourceImage.ID != nil {
 		d.Set(isSnapshotSourceImage, *snapshot.SourceImage.ID)
@@ -405,7 +534,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
 	name := ""
@@ -415,14 +544,14 @@ func resourceIBMISSnapshotUpdate(d *schema.ResourceData, meta interface{}) error
 		name = d.Get(isSnapshotName).(string)
 		hasChanged = true
 	}
-	err := snapshotUpdate(d, meta, id, name, hasChanged)
+	err := snapshotUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISSnapshotRead(d, meta)
+	return resourceIBMISSnapshotRead(ctx, d, meta)
 }
 
-func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func snapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -431,7 +560,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSnapshot(getSnapshotOptions)
+	_, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -471,11 +600,11 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 					return fmt.Errorf("Error calling asPatch for SnapshotPatch: %s", err)
 				}
 				updateSnapshotOptions.SnapshotPatch = snapshotPatch
-				_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
+				_, response, err := sess.UpdateSnapshotWithContext(ctx, updateSnapshotOptions)
 				if err != nil {
 					return fmt.Errorf("Error updating Snapshot : %s\n%s", err, response)
 				}
-				_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+				_, err = isWaitForSnapshotUpdate(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					return err
 				}
@@ -495,11 +624,11 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 			return fmt.Errorf("[ERROR] Error calling asPatch for SnapshotPatch: %s", err)
 		}
 		updateSnapshotOptions.SnapshotPatch = snapshotPatch
-		_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
+		_, response, err := sess.UpdateSnapshotWithContext(ctx, updateSnapshotOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Snapshot : %s\n%s", err, response)
 		}
-		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotUpdate(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -507,26 +636,26 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 	return nil
 }
 
-func isWaitForSnapshotUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotUpdate(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isSnapshotUpdating},
 		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotUpdateRefreshFunc(sess, id),
+		Refresh:    isSnapshotUpdateRefreshFunc(ctx, sess, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotUpdateRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -541,17 +670,17 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 	}
 }
 
-func resourceIBMISSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := snapshotDelete(d, meta, id)
+	err := snapshotDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func snapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -560,7 +689,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSnapshot(getSnapshotOptions)
+	_, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -572,11 +701,11 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteSnapshotOptions := &vpcv1.DeleteSnapshotOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSnapshot(deleteSnapshotOptions)
+	response, err = sess.DeleteSnapshotWithContext(ctx, deleteSnapshotOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting Snapshot : %s\n%s", err, response)
 	}
-	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSnapshotDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -584,28 +713,28 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForSnapshotDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isSnapshotDeleting},
 		Target:     []string{isSnapshotDeleted, isSnapshotFailed},
-		Refresh:    isSnapshotDeleteRefreshFunc(sess, id),
+		Refresh:    isSnapshotDeleteRefreshFunc(ctx, sess, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotDeleteRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Refresh function for Snapshot delete.")
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return snapshot, isSnapshotDeleted, nil
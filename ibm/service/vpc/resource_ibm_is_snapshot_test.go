@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -104,6 +105,63 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISSnapshotCopy_basic(t *testing.T) {
+	var snapshot string
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("tfsnapshotuat-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotCopyConfig(vpcname, subnetname, sshname, publicKey, volname, name, name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSnapshotExists("ibm_is_snapshot.testacc_snapshot_copy", snapshot),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot_copy", "name", name1+"-copy"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_snapshot.testacc_snapshot_copy", "source_snapshot_crn", "ibm_is_snapshot.testacc_snapshot", "crn"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot_copy", "source_volume", ""),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_snapshot.testacc_snapshot_copy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISSnapshot_encryptionKeyWithoutCopy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "ibm_is_snapshot" "testacc_snapshot" {
+					name           = "tf-snapshot-key"
+					source_volume  = "r006-00000000-0000-0000-0000-000000000000"
+					encryption_key = "crn:v1:bluemix:public:kms:us-south:a/fakeaccount:fakeinstance:key:fakekey"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("encryption_key can only be set with source_snapshot_crn"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISSnapshotDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -268,3 +326,11 @@ func testAccCheckIBMISSnapshotConfigUsertag(vpcname, subnetname, sshname, public
 	}`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, sname, usertag)
 
 }
+
+func testAccCheckIBMISSnapshotCopyConfig(vpcname, subnetname, sshname, publicKey, volname, name, sname string) string {
+	return testAccCheckIBMISSnapshotConfig(vpcname, subnetname, sshname, publicKey, volname, name, sname) + fmt.Sprintf(`
+	resource "ibm_is_snapshot" "testacc_snapshot_copy" {
+		name                = "%s-copy"
+		source_snapshot_crn = ibm_is_snapshot.testacc_snapshot.crn
+	}`, sname)
+}
@@ -42,7 +42,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -88,6 +88,13 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for SSH key",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isKeyResourceGroup: {
 				Type:        schema.TypeString,
@@ -195,8 +202,8 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
-		oldList, newList := d.GetChange(isKeyTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
@@ -243,7 +250,8 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc SSH Key (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isKeyTags, tags)
+	d.Set(isKeyTags, flex.RemoveDefaultTags(meta, tags, d.Get(isKeyTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -282,7 +290,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if d.HasChange(isKeyTags) || d.HasChange(flex.TagsAll) {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
@@ -290,7 +298,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting SSH Key : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isKeyTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
@@ -64,7 +64,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -118,6 +118,13 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isSubnetAccessTags: {
 				Type:        schema.TypeSet,
@@ -373,8 +380,8 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
-		oldList, newList := d.GetChange(isSubnetTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
@@ -493,7 +500,8 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource subnet (%s) access tags: %s", d.Id(), err)
 	}
 
-	d.Set(isSubnetTags, tags)
+	d.Set(isSubnetTags, flex.RemoveDefaultTags(meta, tags, d.Get(isSubnetTags)))
+	d.Set(flex.TagsAll, tags)
 	d.Set(isSubnetAccessTags, accesstags)
 	d.Set(isSubnetCRN, *subnet.CRN)
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/subnets")
@@ -510,8 +518,8 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
-	if d.HasChange(isSubnetTags) {
-		oldList, newList := d.GetChange(isSubnetTags)
+	if d.HasChange(isSubnetTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
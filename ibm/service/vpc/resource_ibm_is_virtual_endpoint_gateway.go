@@ -55,7 +55,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -202,6 +202,13 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for VPE",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 		},
 	}
 }
@@ -308,8 +315,8 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(*result.ID)
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
 		if err != nil {
 			log.Printf(
@@ -389,13 +396,13 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		}
 
 	}
-	if d.HasChange(isVirtualEndpointGatewayTags) {
+	if d.HasChange(isVirtualEndpointGatewayTags) || d.HasChange(flex.TagsAll) {
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
 		result, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting VPE: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
 		if err != nil {
 			log.Printf(
@@ -440,7 +447,8 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 		log.Printf(
 			"Error on get of VPE (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVirtualEndpointGatewayTags, tags)
+	d.Set(isVirtualEndpointGatewayTags, flex.RemoveDefaultTags(meta, tags, d.Get(isVirtualEndpointGatewayTags)))
+	d.Set(flex.TagsAll, tags)
 	return nil
 }
 
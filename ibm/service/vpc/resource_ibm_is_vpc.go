@@ -76,7 +76,7 @@ func ResourceIBMISVPC() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -182,6 +182,13 @@ func ResourceIBMISVPC() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			isVPCCRN: {
 				Type:        schema.TypeString,
@@ -502,8 +509,8 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVPCTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
 			log.Printf(
@@ -600,7 +607,8 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVPCTags, tags)
+	d.Set(isVPCTags, flex.RemoveDefaultTags(meta, tags, d.Get(isVPCTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -805,7 +813,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPCTags) {
+	if d.HasChange(isVPCTags) || d.HasChange(flex.TagsAll) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
@@ -813,7 +821,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isVPCTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
 			log.Printf(
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -33,7 +34,7 @@ const (
 )
 
 func ResourceIBMISVpcRoute() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		DeprecationMessage: "This resource is deprecated, use ibm_is_vpc_routing_table_route instead.",
 		Create:             resourceIBMISVpcRouteCreate,
 		Read:               resourceIBMISVpcRouteRead,
@@ -95,7 +96,12 @@ func ResourceIBMISVpcRoute() *schema.Resource {
 				Description: "The crn of the VPC resource",
 			},
 		},
-	}
+	}, resourceIBMISVpcRouteStateUpgradeV0)
+}
+
+// resourceIBMISVpcRouteStateUpgradeV0 prefixes the ID of routes stored with the route ID only with the VPC ID
+func resourceIBMISVpcRouteStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return flex.UpgradeIDWithParent(rawState, isVPCRouteVPCID)
 }
 
 func ResourceIBMISRouteValidator() *validate.ResourceValidator {
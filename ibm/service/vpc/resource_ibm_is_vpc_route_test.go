@@ -4,6 +4,8 @@
 package vpc_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -132,3 +135,27 @@ resource "ibm_is_vpc_route" "testacc_vpc_route" {
 	depends_on  = [ibm_is_subnet.testacc_subnet]
 }`, name, subnetName, acc.ISZoneName, acc.ISCIDR, routeName, acc.ISZoneName, acc.ISRouteDestination, acc.ISRouteNextHop)
 }
+
+func TestIBMISVpcRouteStateUpgradeV0(t *testing.T) {
+	r := vpc.ResourceIBMISVpcRoute()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("bad schema version %d with %d state upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	cases := map[string]string{
+		`{"id": "r006-route", "vpc": "r006-vpc", "name": "route"}`:          "r006-vpc/r006-route",
+		`{"id": "r006-vpc/r006-route", "vpc": "r006-vpc", "name": "route"}`: "r006-vpc/r006-route",
+	}
+	for raw, id := range cases {
+		var rawState map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &rawState); err != nil {
+			t.Fatalf("Error decoding the raw state: %s", err)
+		}
+		actual, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+		if err != nil {
+			t.Fatalf("Error upgrading the state %s: %s", raw, err)
+		}
+		if actual["id"] != id {
+			t.Fatalf("bad id %q, expected %q", actual["id"], id)
+		}
+	}
+}
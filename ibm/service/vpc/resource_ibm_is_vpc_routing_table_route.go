@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -26,7 +28,7 @@ const (
 )
 
 func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddStateUpgrader(&schema.Resource{
 		Create:   resourceIBMISVPCRoutingTableRouteCreate,
 		Read:     resourceIBMISVPCRoutingTableRouteRead,
 		Update:   resourceIBMISVPCRoutingTableRouteUpdate,
@@ -162,7 +164,37 @@ func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
 				Description: "The origin of this route.",
 			},
 		},
+	}, resourceIBMISVPCRoutingTableRouteStateUpgradeV0)
+}
+
+// resourceIBMISVPCRoutingTableRouteStateUpgradeV0 completes the ID of routes stored without the VPC or the routing
+// table ID, the upgraded ID is <vpc>/<routing_table>/<route_id>
+func resourceIBMISVPCRoutingTableRouteStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	id := flex.RawStateString(rawState, "id")
+	if id == "" {
+		return rawState, nil
+	}
+	vpcID := flex.RawStateString(rawState, rtVpcID)
+	tableID := flex.RawStateString(rawState, rtID)
+	parts := strings.Split(id, "/")
+	switch len(parts) {
+	case 3:
+		return rawState, nil
+	case 2:
+		if vpcID == "" {
+			return rawState, fmt.Errorf("[ERROR] Unable to upgrade the ID %s of the routing table route, %s is missing from the state", id, rtVpcID)
+		}
+		rawState["id"] = fmt.Sprintf("%s/%s", vpcID, id)
+	default:
+		if vpcID == "" || tableID == "" {
+			return rawState, fmt.Errorf("[ERROR] Unable to upgrade the ID %s of the routing table route, %s or %s is missing from the state", id, rtVpcID, rtID)
+		}
+		rawState["id"] = fmt.Sprintf("%s/%s/%s", vpcID, tableID, id)
+		if flex.RawStateString(rawState, rID) == "" {
+			rawState[rID] = id
+		}
 	}
+	return rawState, nil
 }
 
 func ResourceIBMISVPCRoutingTableRouteValidator() *validate.ResourceValidator {
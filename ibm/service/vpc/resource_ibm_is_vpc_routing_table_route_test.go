@@ -4,12 +4,15 @@
 package vpc_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -144,3 +147,55 @@ resource "ibm_is_vpc_routing_table_route" "test_custom_route1" {
 }
 `, name, rtName, subnetName, acc.ISZoneName, acc.ISCIDR, routeName, acc.ISZoneName, acc.ISRouteNextHop)
 }
+
+func TestIBMISVPCRoutingTableRouteStateUpgradeV0(t *testing.T) {
+	r := vpc.ResourceIBMISVPCRoutingTableRoute()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("bad schema version %d with %d state upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	cases := []struct {
+		rawState string
+		id       string
+		routeID  string
+	}{
+		{
+			rawState: `{"id": "r006-vpc/r006-table/r006-route", "vpc": "r006-vpc", "routing_table": "r006-table", "route_id": "r006-route"}`,
+			id:       "r006-vpc/r006-table/r006-route",
+			routeID:  "r006-route",
+		},
+		{
+			rawState: `{"id": "r006-table/r006-route", "vpc": "r006-vpc", "routing_table": "r006-table", "route_id": "r006-route"}`,
+			id:       "r006-vpc/r006-table/r006-route",
+			routeID:  "r006-route",
+		},
+		{
+			rawState: `{"id": "r006-route", "vpc": "r006-vpc", "routing_table": "r006-table"}`,
+			id:       "r006-vpc/r006-table/r006-route",
+			routeID:  "r006-route",
+		},
+	}
+	for _, c := range cases {
+		var rawState map[string]interface{}
+		if err := json.Unmarshal([]byte(c.rawState), &rawState); err != nil {
+			t.Fatalf("Error decoding the raw state: %s", err)
+		}
+		actual, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+		if err != nil {
+			t.Fatalf("Error upgrading the state %s: %s", c.rawState, err)
+		}
+		if actual["id"] != c.id {
+			t.Fatalf("bad id %q, expected %q", actual["id"], c.id)
+		}
+		if actual["route_id"] != c.routeID {
+			t.Fatalf("bad route_id %q, expected %q", actual["route_id"], c.routeID)
+		}
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"id": "r006-route", "vpc": "r006-vpc"}`), &rawState); err != nil {
+		t.Fatalf("Error decoding the raw state: %s", err)
+	}
+	if _, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil); err == nil {
+		t.Fatalf("expected an error when the routing table is missing from the state")
+	}
+}
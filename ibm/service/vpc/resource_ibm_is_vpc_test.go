@@ -10,10 +10,12 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -130,6 +132,38 @@ func TestAccIBMISVPC_securityGroups(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPC_defaultTags(t *testing.T) {
+	var vpc string
+	name := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultTagsConfig(name, "env:test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags_all.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCDefaultTagsConfig(name, "env:stage"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags_all.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVPCDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -185,6 +219,21 @@ func testAccCheckIBMISVPCConfig(name string) string {
 
 }
 
+func testAccCheckIBMISVPCDefaultTagsConfig(name, defaultTag string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		default_tags {
+			tags = ["%s"]
+		}
+	}
+
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+		tags = ["tag1"]
+	}`, defaultTag, name)
+
+}
+
 func testAccCheckIBMISVPCConfigUpdate(name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -234,3 +283,28 @@ func testAccCheckIBMISVPCSgConfig(vpcname string, sgname string) string {
 `, vpcname, sgname)
 
 }
+
+func TestIBMISVPCOffline(t *testing.T) {
+	acc.RunOfflineCRUDTest(t, acc.OfflineCRUDTest{
+		Resource:   vpc.ResourceIBMISVPC(),
+		Fixtures:   "testdata/ibm_is_vpc.json",
+		Attributes: map[string]interface{}{"name": "tf-offline-vpc"},
+		ID:         "r006-4727d842-f94f-4a2d-824a-9bc9b02c523b",
+		Expected: map[string]string{
+			"status":                            "available",
+			"default_security_group":            "r006-sg",
+			"default_network_acl_name":          "tf-offline-acl",
+			"default_routing_table_name":        "tf-offline-rt",
+			"resource_group":                    "fake-resource-group",
+			"subnets.0.id":                      "r006-subnet",
+			"security_group.0.rules.0.port_min": "22",
+			"cse_source_addresses.0.address":    "10.16.234.155",
+		},
+		Polls: true,
+		Check: func(t *testing.T, d *schema.ResourceData) {
+			if !d.Get("tags_all").(*schema.Set).Contains("env:offline") {
+				t.Fatalf("bad tags_all: %v", d.Get("tags_all"))
+			}
+		},
+	})
+}
@@ -54,7 +54,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsAllCustomizeDiff(diff, v)
 			},
 		),
 
@@ -121,6 +121,13 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "VPN Gateway tags list",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the provider default_tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -337,8 +344,8 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVPNGatewayTags)
+	if flex.ResourceTagsAll(d, meta).Len() > 0 || v != "" {
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
 			log.Printf(
@@ -435,7 +442,8 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVPNGatewayTags, tags)
+	d.Set(isVPNGatewayTags, flex.RemoveDefaultTags(meta, tags, d.Get(isVPNGatewayTags)))
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -502,7 +510,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPNGatewayTags) {
+	if d.HasChange(isVPNGatewayTags) || d.HasChange(flex.TagsAll) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
@@ -512,7 +520,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-		oldList, newList := d.GetChange(isVPNGatewayTags)
+		oldList, newList := flex.TagsAllChange(d, meta)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
 			log.Printf(
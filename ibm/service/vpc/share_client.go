@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// vpcShare is a file share, or a replica of a file share
+type vpcShare struct {
+	AccessControlMode   *string             `json:"access_control_mode,omitempty"`
+	CreatedAt           *string             `json:"created_at,omitempty"`
+	CRN                 *string             `json:"crn,omitempty"`
+	Encryption          *string             `json:"encryption,omitempty"`
+	EncryptionKey       *vpcShareReference  `json:"encryption_key,omitempty"`
+	Href                *string             `json:"href,omitempty"`
+	ID                  *string             `json:"id,omitempty"`
+	Iops                *int64              `json:"iops,omitempty"`
+	LifecycleState      *string             `json:"lifecycle_state,omitempty"`
+	MountTargets        []vpcShareReference `json:"mount_targets,omitempty"`
+	Name                *string             `json:"name,omitempty"`
+	Profile             *vpcShareReference  `json:"profile,omitempty"`
+	ReplicaShare        *vpcShareReference  `json:"replica_share,omitempty"`
+	ReplicationCronSpec *string             `json:"replication_cron_spec,omitempty"`
+	ReplicationRole     *string             `json:"replication_role,omitempty"`
+	ReplicationStatus   *string             `json:"replication_status,omitempty"`
+	ResourceGroup       *vpcShareReference  `json:"resource_group,omitempty"`
+	Size                *int64              `json:"size,omitempty"`
+	SourceShare         *vpcShareReference  `json:"source_share,omitempty"`
+	UserTags            []string            `json:"user_tags,omitempty"`
+	Zone                *vpcShareReference  `json:"zone,omitempty"`
+}
+
+func (share *vpcShare) lifecycleState() string {
+	if share.LifecycleState == nil {
+		return ""
+	}
+	return *share.LifecycleState
+}
+
+// vpcShareReference is a reference to, or the identity of, a resource in the requests and responses of the file
+// shares API
+type vpcShareReference struct {
+	Address *string `json:"address,omitempty"`
+	CRN     *string `json:"crn,omitempty"`
+	Href    *string `json:"href,omitempty"`
+	ID      *string `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+}
+
+// vpcShareMountTarget is a mount target of a file share
+type vpcShareMountTarget struct {
+	AccessControlMode       *string                              `json:"access_control_mode,omitempty"`
+	CreatedAt               *string                              `json:"created_at,omitempty"`
+	Href                    *string                              `json:"href,omitempty"`
+	ID                      *string                              `json:"id,omitempty"`
+	LifecycleState          *string                              `json:"lifecycle_state,omitempty"`
+	MountPath               *string                              `json:"mount_path,omitempty"`
+	Name                    *string                              `json:"name,omitempty"`
+	PrimaryIP               *vpcShareReference                   `json:"primary_ip,omitempty"`
+	ResourceType            *string                              `json:"resource_type,omitempty"`
+	Subnet                  *vpcShareReference                   `json:"subnet,omitempty"`
+	TransitEncryption       *string                              `json:"transit_encryption,omitempty"`
+	VirtualNetworkInterface *vpcShareMountTargetNetworkInterface `json:"virtual_network_interface,omitempty"`
+	VPC                     *vpcShareReference                   `json:"vpc,omitempty"`
+}
+
+func (mountTarget *vpcShareMountTarget) lifecycleState() string {
+	if mountTarget.LifecycleState == nil {
+		return ""
+	}
+	return *mountTarget.LifecycleState
+}
+
+// vpcShareMountTargetNetworkInterface is the virtual network interface of a mount target, or its prototype
+type vpcShareMountTargetNetworkInterface struct {
+	ID             *string             `json:"id,omitempty"`
+	Name           *string             `json:"name,omitempty"`
+	PrimaryIP      *vpcShareReference  `json:"primary_ip,omitempty"`
+	ResourceGroup  *vpcShareReference  `json:"resource_group,omitempty"`
+	SecurityGroups []vpcShareReference `json:"security_groups,omitempty"`
+	Subnet         *vpcShareReference  `json:"subnet,omitempty"`
+}
+
+// vpcShareProfile is a file share profile
+type vpcShareProfile struct {
+	Capacity     *vpcShareProfileRange `json:"capacity,omitempty"`
+	Family       *string               `json:"family,omitempty"`
+	Href         *string               `json:"href,omitempty"`
+	Iops         *vpcShareProfileRange `json:"iops,omitempty"`
+	Name         *string               `json:"name,omitempty"`
+	ResourceType *string               `json:"resource_type,omitempty"`
+}
+
+// vpcShareProfileRange is the capacity or IOPS of a profile, the fields set depend on its type
+type vpcShareProfileRange struct {
+	Default *int64  `json:"default,omitempty"`
+	Max     *int64  `json:"max,omitempty"`
+	Min     *int64  `json:"min,omitempty"`
+	Step    *int64  `json:"step,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Value   *int64  `json:"value,omitempty"`
+	Values  []int64 `json:"values,omitempty"`
+}
+
+type vpcShareCollection struct {
+	Next   *vpcShareReference `json:"next,omitempty"`
+	Shares []vpcShare         `json:"shares"`
+}
+
+type vpcShareProfileCollection struct {
+	Next     *vpcShareReference `json:"next,omitempty"`
+	Profiles []vpcShareProfile  `json:"profiles"`
+}
+
+func getShare(ctx context.Context, sess *vpcv1.VpcV1, id string) (*vpcShare, *core.DetailedResponse, error) {
+	share := &vpcShare{}
+	response, err := vpcAPIRequest(ctx, sess, core.GET, "/shares/{id}", map[string]string{"id": id}, nil, nil, nil, share)
+	if err != nil {
+		return nil, response, err
+	}
+	return share, response, nil
+}
+
+func createShare(ctx context.Context, sess *vpcv1.VpcV1, prototype *vpcShare) (*vpcShare, *core.DetailedResponse, error) {
+	share := &vpcShare{}
+	response, err := vpcAPIRequest(ctx, sess, core.POST, "/shares", nil, nil, nil, prototype, share)
+	if err != nil {
+		return nil, response, err
+	}
+	return share, response, nil
+}
+
+func updateShare(ctx context.Context, sess *vpcv1.VpcV1, id, etag string, patch map[string]interface{}) (*vpcShare, *core.DetailedResponse, error) {
+	share := &vpcShare{}
+	response, err := vpcAPIRequest(ctx, sess, core.PATCH, "/shares/{id}", map[string]string{"id": id}, nil, map[string]string{"If-Match": etag}, patch, share)
+	if err != nil {
+		return nil, response, err
+	}
+	return share, response, nil
+}
+
+func deleteShare(ctx context.Context, sess *vpcv1.VpcV1, id, etag string) (*core.DetailedResponse, error) {
+	return vpcAPIRequest(ctx, sess, core.DELETE, "/shares/{id}", map[string]string{"id": id}, nil, map[string]string{"If-Match": etag}, nil, &vpcShare{})
+}
+
+// listShares lists all the shares matching the filters, page by page
+func listShares(ctx context.Context, sess *vpcv1.VpcV1, resourceGroupID, name string) ([]vpcShare, error) {
+	start := ""
+	allrecs := []vpcShare{}
+	for {
+		shares := &vpcShareCollection{}
+		query := map[string]string{
+			"resource_group.id": resourceGroupID,
+			"name":              name,
+			"start":             start,
+		}
+		response, err := vpcAPIRequest(ctx, sess, core.GET, "/shares", nil, query, nil, nil, shares)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing shares: %s\n%s", err, response)
+		}
+		allrecs = append(allrecs, shares.Shares...)
+		start = flex.GetNext(shares.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func listShareProfiles(ctx context.Context, sess *vpcv1.VpcV1) ([]vpcShareProfile, error) {
+	start := ""
+	allrecs := []vpcShareProfile{}
+	for {
+		profiles := &vpcShareProfileCollection{}
+		response, err := vpcAPIRequest(ctx, sess, core.GET, "/share/profiles", nil, map[string]string{"start": start}, nil, nil, profiles)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing share profiles: %s\n%s", err, response)
+		}
+		allrecs = append(allrecs, profiles.Profiles...)
+		start = flex.GetNext(profiles.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func getShareMountTarget(ctx context.Context, sess *vpcv1.VpcV1, shareID, id string) (*vpcShareMountTarget, *core.DetailedResponse, error) {
+	mountTarget := &vpcShareMountTarget{}
+	response, err := vpcAPIRequest(ctx, sess, core.GET, "/shares/{share_id}/mount_targets/{id}", map[string]string{"share_id": shareID, "id": id}, nil, nil, nil, mountTarget)
+	if err != nil {
+		return nil, response, err
+	}
+	return mountTarget, response, nil
+}
+
+func createShareMountTarget(ctx context.Context, sess *vpcv1.VpcV1, shareID string, prototype *vpcShareMountTarget) (*vpcShareMountTarget, *core.DetailedResponse, error) {
+	mountTarget := &vpcShareMountTarget{}
+	response, err := vpcAPIRequest(ctx, sess, core.POST, "/shares/{share_id}/mount_targets", map[string]string{"share_id": shareID}, nil, nil, prototype, mountTarget)
+	if err != nil {
+		return nil, response, err
+	}
+	return mountTarget, response, nil
+}
+
+func updateShareMountTarget(ctx context.Context, sess *vpcv1.VpcV1, shareID, id string, patch map[string]interface{}) (*vpcShareMountTarget, *core.DetailedResponse, error) {
+	mountTarget := &vpcShareMountTarget{}
+	response, err := vpcAPIRequest(ctx, sess, core.PATCH, "/shares/{share_id}/mount_targets/{id}", map[string]string{"share_id": shareID, "id": id}, nil, nil, patch, mountTarget)
+	if err != nil {
+		return nil, response, err
+	}
+	return mountTarget, response, nil
+}
+
+func deleteShareMountTarget(ctx context.Context, sess *vpcv1.VpcV1, shareID, id string) (*core.DetailedResponse, error) {
+	return vpcAPIRequest(ctx, sess, core.DELETE, "/shares/{share_id}/mount_targets/{id}", map[string]string{"share_id": shareID, "id": id}, nil, nil, nil, &vpcShareMountTarget{})
+}
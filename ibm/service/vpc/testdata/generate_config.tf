@@ -0,0 +1,167 @@
+provider "ibm" {
+  region = "us-south"
+}
+
+import {
+  to = ibm_is_vpc.app-vpc
+  id = "vpc-1"
+}
+
+resource "ibm_is_vpc" "app-vpc" {
+  name           = "app-vpc"
+  resource_group = "rg-1"
+}
+
+import {
+  to = ibm_is_vpc_routing_table_route.to-onprem
+  id = "vpc-1/rt-default/route-1"
+}
+
+resource "ibm_is_vpc_routing_table_route" "to-onprem" {
+  vpc           = ibm_is_vpc.app-vpc.id
+  routing_table = ibm_is_vpc.app-vpc.default_routing_table
+  name          = "to-onprem"
+  zone          = "us-south-1"
+  destination   = "10.0.0.0/8"
+  next_hop      = "10.240.0.4"
+}
+
+import {
+  to = ibm_is_vpc_routing_table.egress-rt
+  id = "vpc-1/rt-1"
+}
+
+resource "ibm_is_vpc_routing_table" "egress-rt" {
+  vpc                    = ibm_is_vpc.app-vpc.id
+  name                   = "egress-rt"
+  route_vpc_zone_ingress = true
+}
+
+import {
+  to = ibm_is_vpc_routing_table_route.blackhole
+  id = "vpc-1/rt-1/route-3"
+}
+
+resource "ibm_is_vpc_routing_table_route" "blackhole" {
+  vpc           = ibm_is_vpc.app-vpc.id
+  routing_table = ibm_is_vpc_routing_table.egress-rt.routing_table
+  name          = "blackhole"
+  zone          = "us-south-1"
+  destination   = "192.168.0.0/16"
+  action        = "drop"
+  next_hop      = "0.0.0.0"
+}
+
+import {
+  to = ibm_is_subnet.web_subnet
+  id = "subnet-1"
+}
+
+resource "ibm_is_subnet" "web_subnet" {
+  name            = "Web Subnet"
+  vpc             = ibm_is_vpc.app-vpc.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.240.0.0/24"
+  resource_group  = "rg-1"
+}
+
+import {
+  to = ibm_is_subnet.db
+  id = "subnet-2"
+}
+
+resource "ibm_is_subnet" "db" {
+  name            = "db"
+  vpc             = ibm_is_vpc.app-vpc.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.240.1.0/24"
+  resource_group  = "rg-1"
+  routing_table   = ibm_is_vpc_routing_table.egress-rt.routing_table
+  public_gateway  = "pgw-1"
+}
+
+import {
+  to = ibm_is_security_group.web
+  id = "sg-web"
+}
+
+resource "ibm_is_security_group" "web" {
+  name           = "web"
+  vpc            = ibm_is_vpc.app-vpc.id
+  resource_group = "rg-1"
+}
+
+import {
+  to = ibm_is_security_group_rule.app-vpc-default_inbound
+  id = "sg-default.rule-1"
+}
+
+resource "ibm_is_security_group_rule" "app-vpc-default_inbound" {
+  group      = ibm_is_vpc.app-vpc.default_security_group
+  direction  = "inbound"
+  ip_version = "ipv4"
+  remote     = ibm_is_vpc.app-vpc.default_security_group
+}
+
+import {
+  to = ibm_is_security_group_rule.web_inbound
+  id = "sg-web.rule-2"
+}
+
+resource "ibm_is_security_group_rule" "web_inbound" {
+  group      = ibm_is_security_group.web.id
+  direction  = "inbound"
+  ip_version = "ipv4"
+  remote     = "0.0.0.0/0"
+  tcp {
+    port_min = 443
+    port_max = 443
+  }
+}
+
+import {
+  to = ibm_is_security_group_rule.web_inbound_2
+  id = "sg-web.rule-3"
+}
+
+resource "ibm_is_security_group_rule" "web_inbound_2" {
+  group      = ibm_is_security_group.web.id
+  direction  = "inbound"
+  ip_version = "ipv4"
+  remote     = ibm_is_vpc.app-vpc.default_security_group
+  icmp {
+    type = 8
+  }
+}
+
+import {
+  to = ibm_is_instance.web-1
+  id = "instance-1"
+}
+
+resource "ibm_is_instance" "web-1" {
+  name           = "web-1"
+  vpc            = ibm_is_vpc.app-vpc.id
+  zone           = "us-south-1"
+  profile        = "bx2-2x8"
+  image          = "image-1"
+  keys           = ["key-1"]
+  resource_group = "rg-1"
+  primary_network_interface {
+    subnet          = ibm_is_subnet.web_subnet.id
+    security_groups = [ibm_is_security_group.web.id]
+  }
+}
+
+import {
+  to = ibm_is_lb.web-lb
+  id = "lb-1"
+}
+
+resource "ibm_is_lb" "web-lb" {
+  name            = "web-lb"
+  subnets         = [ibm_is_subnet.web_subnet.id]
+  type            = "private"
+  security_groups = [ibm_is_security_group.web.id]
+  resource_group  = "rg-1"
+}
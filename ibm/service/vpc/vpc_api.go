@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/common"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// isVPCAPIVersion is the version of the requests made with vpcAPIRequest, the first one with file share mount targets
+// with a virtual network interface
+const isVPCAPIVersion = "2023-12-12"
+
+// vpcAPIRequest makes a request of the VPC API with the service of the VPC client and unmarshals the response into
+// result, for the APIs which are not part of the version of the VPC SDK the provider uses
+func vpcAPIRequest(ctx context.Context, sess *vpcv1.VpcV1, method, path string, pathParams map[string]string, query map[string]string, headers map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = sess.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(sess.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	for headerName, headerValue := range common.GetSdkHeaders("vpc", "V1", "Request") {
+		builder.AddHeader(headerName, headerValue)
+	}
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", isVPCAPIVersion)
+	builder.AddQuery("generation", "2")
+	for name, value := range query {
+		if value != "" {
+			builder.AddQuery(name, value)
+		}
+	}
+	if body != nil {
+		contentType := "application/json"
+		if method == core.PATCH {
+			contentType = "application/merge-patch+json"
+		}
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Content-Type", contentType)
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return sess.Service.Request(request, result)
+}
@@ -1,16 +1,34 @@
 package main
 
 import (
+	"flag"
 	"log"
+	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
+// providerAddr is the registry address Terraform uses for the provider, it is required to reattach in debug mode
+const providerAddr = "registry.terraform.io/IBM-Cloud/ibm"
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == generateConfigCommand {
+		if err := generateConfig(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve, the TF_REATTACH_PROVIDERS value to use is printed on start")
+	flag.Parse()
+
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: provider.Provider,
+		Debug:        debug,
+		ProviderAddr: providerAddr,
 	})
 }